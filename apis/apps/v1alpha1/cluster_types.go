@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
@@ -179,6 +180,28 @@ type ClusterSpec struct {
 	// +optional
 	Backup *ClusterBackup `json:"backup,omitempty"`
 
+	// Specifies a Backup to take before the Cluster is deleted.
+	// If set, the deletion of the Cluster creates the Backup first and waits for it to finish before any resource
+	// is deleted. The Backup isn't owned by the Cluster, it's kept after the Cluster is deleted, even with
+	// the `WipeOut` termination policy, until its own retention period expires.
+	//
+	// +optional
+	FinalBackup *ClusterFinalBackup `json:"finalBackup,omitempty"`
+
+	// Specifies the recurring maintenance window of the Cluster.
+	// If set, the disruptive OpsRequests, such as "Restart", "VerticalScaling", "Upgrade", "Switchover"
+	// and "Reconfiguring", wait for the window before they start.
+	//
+	// +optional
+	MaintenanceWindow *ClusterMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Declares the Cluster as a disaster-recovery standby of another Cluster.
+	// A standby Cluster is bootstrapped from a Backup of the primary Cluster and keeps replicating from it
+	// through the native replication of the engine, it can be promoted by the "PromoteStandby" OpsRequest.
+	//
+	// +optional
+	Standby *ClusterStandby `json:"standby,omitempty"`
+
 	// !!!!! The following fields may be deprecated in subsequent versions, please DO NOT rely on them for new requirements.
 
 	// Describes how Pods are distributed across node.
@@ -301,6 +324,135 @@ type ClusterBackup struct {
 	PITREnabled *bool `json:"pitrEnabled,omitempty"`
 }
 
+// FinalBackupFailurePolicy defines how the deletion of a Cluster proceeds if its final Backup fails.
+// +enum
+// +kubebuilder:validation:Enum={Block,Proceed}
+type FinalBackupFailurePolicy string
+
+const (
+	// FinalBackupFailureBlock blocks the deletion of the Cluster, until the final Backup is removed from the Cluster spec
+	// or the failure policy is changed to `Proceed`.
+	FinalBackupFailureBlock FinalBackupFailurePolicy = "Block"
+
+	// FinalBackupFailureProceed deletes the Cluster anyway.
+	FinalBackupFailureProceed FinalBackupFailurePolicy = "Proceed"
+)
+
+// ClusterFinalBackup defines the Backup taken before a Cluster is deleted.
+type ClusterFinalBackup struct {
+	// Specifies the name of the BackupPolicy to use.
+	// If not set, the default BackupPolicy of the Cluster will be used.
+	//
+	// +optional
+	BackupPolicyName string `json:"backupPolicyName,omitempty"`
+
+	// Specifies the backup method to use, as defined in the BackupPolicy.
+	//
+	// +kubebuilder:validation:Required
+	Method string `json:"method"`
+
+	// Specifies the name of the BackupRepo to store the Backup.
+	// If not set, the BackupRepo of the BackupPolicy will be used.
+	//
+	// +optional
+	RepoName string `json:"repoName,omitempty"`
+
+	// Determines the duration to retain the Backup after it's taken.
+	// If not set, the Backup will be kept until it's deleted manually.
+	//
+	// For example, RetentionPeriod of `30d` will keep the Backup for 30 days.
+	// Sample duration format:
+	//
+	// - years: 	2y
+	// - months: 	6mo
+	// - days: 		30d
+	// - hours: 	12h
+	// - minutes: 	30m
+	//
+	// +optional
+	RetentionPeriod dpv1alpha1.RetentionPeriod `json:"retentionPeriod,omitempty"`
+
+	// Specifies how long to wait for the Backup to complete, the Backup is treated as failed after the timeout.
+	// Defaults to 1h.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Specifies how the deletion proceeds if the Backup fails:
+	//
+	// - `Block`: the deletion is blocked, until `finalBackup` is removed or the failure policy is changed to `Proceed`.
+	// - `Proceed`: the Cluster is deleted anyway.
+	//
+	// +kubebuilder:default=Block
+	// +optional
+	FailurePolicy FinalBackupFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// ClusterMaintenanceWindow defines a recurring time window, the disruptive operations are allowed to start
+// only within the window.
+type ClusterMaintenanceWindow struct {
+	// Specifies the start time of the window in cron expression, e.g. "0 2 * * 6" means 2 AM every Saturday.
+	// See https://en.wikipedia.org/wiki/Cron.
+	//
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+
+	// Specifies how long the window lasts after it starts, e.g. "4h".
+	//
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// Specifies the time zone of the schedule in IANA format, e.g. "Asia/Shanghai".
+	// Defaults to UTC.
+	//
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// ClusterStandby defines the primary Cluster that a standby Cluster replicates from.
+type ClusterStandby struct {
+	// Specifies the name of the primary Cluster.
+	//
+	// +kubebuilder:validation:Required
+	PrimaryCluster string `json:"primaryCluster"`
+
+	// Specifies the namespace of the primary Cluster. Defaults to the namespace of the standby Cluster.
+	//
+	// +optional
+	PrimaryClusterNamespace string `json:"primaryClusterNamespace,omitempty"`
+
+	// Specifies the name of the Backup of the primary Cluster to bootstrap the standby Cluster from.
+	// Defaults to the latest completed non-continuous Backup of each Component of the primary Cluster.
+	//
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// Specifies the replication endpoints of the primary Components explicitly.
+	// It is required if the primary Component is not reachable through its default Service,
+	// for example, the primary Cluster is placed in another Kubernetes cluster.
+	//
+	// +optional
+	Endpoints []StandbyEndpoint `json:"endpoints,omitempty"`
+}
+
+// StandbyEndpoint defines the replication endpoint of a primary Component.
+type StandbyEndpoint struct {
+	// Specifies the name of the Component.
+	//
+	// +kubebuilder:validation:Required
+	ComponentName string `json:"componentName"`
+
+	// Specifies the host of the primary Component.
+	//
+	// +kubebuilder:validation:Required
+	Host string `json:"host"`
+
+	// Specifies the port of the primary Component.
+	//
+	// +kubebuilder:validation:Required
+	Port int32 `json:"port"`
+}
+
 // ClusterResources is deprecated since v0.9.
 type ClusterResources struct {
 	// Specifies the amount of CPU resource the Cluster needs.
@@ -502,6 +654,88 @@ type ClusterStatus struct {
 	//
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Records the replication status if the Cluster is a standby of another Cluster.
+	//
+	// +optional
+	Standby *ClusterStandbyStatus `json:"standby,omitempty"`
+
+	// Records the final Backup taken before the Cluster is deleted.
+	//
+	// +optional
+	FinalBackup *ClusterFinalBackupStatus `json:"finalBackup,omitempty"`
+}
+
+// ClusterFinalBackupStatus records the final Backup of a Cluster being deleted.
+type ClusterFinalBackupStatus struct {
+	// The name of the Backup.
+	//
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// The phase of the Backup.
+	//
+	// +optional
+	Phase dpv1alpha1.BackupPhase `json:"phase,omitempty"`
+
+	// Provides additional information about the Backup.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterStandbyPhase defines the phase of a standby Cluster.
+// +enum
+// +kubebuilder:validation:Enum={Bootstrapping,Replicating}
+type ClusterStandbyPhase string
+
+const (
+	// StandbyBootstrappingPhase indicates the standby Cluster is restoring from the Backup of the primary Cluster.
+	StandbyBootstrappingPhase ClusterStandbyPhase = "Bootstrapping"
+
+	// StandbyReplicatingPhase indicates the standby Cluster is replicating from the primary Cluster.
+	StandbyReplicatingPhase ClusterStandbyPhase = "Replicating"
+)
+
+// ClusterStandbyStatus records the replication status of a standby Cluster.
+type ClusterStandbyStatus struct {
+	// The current phase of the standby Cluster.
+	//
+	// +optional
+	Phase ClusterStandbyPhase `json:"phase,omitempty"`
+
+	// Records the names of the Backups which the standby Cluster is bootstrapped from, keyed by Component name.
+	//
+	// +optional
+	Backups map[string]string `json:"backups,omitempty"`
+
+	// Records the replication status of each Component.
+	//
+	// +optional
+	Components map[string]StandbyComponentStatus `json:"components,omitempty"`
+
+	// Provides additional information about the current phase.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// StandbyComponentStatus records the replication status of a standby Component.
+type StandbyComponentStatus struct {
+	// The endpoint of the primary Component being replicated from, in the format of "host:port".
+	//
+	// +optional
+	PrimaryEndpoint string `json:"primaryEndpoint,omitempty"`
+
+	// The maximum replication lag of the replicas in seconds.
+	//
+	// +optional
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+
+	// The last time the replication lag was probed.
+	//
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
 }
 
 // ShardingSpec defines how KubeBlocks manage dynamic provisioned shards.
@@ -865,6 +1099,12 @@ type ClusterComponentSpec struct {
 	// +optional
 	DisableExporter *bool `json:"disableExporter,omitempty"`
 
+	// Specifies the disruption budget of the Component's Pods, which overrides the budget derived from
+	// the roles and replicas of the Component.
+	//
+	// +optional
+	DisruptionBudget *ComponentDisruptionBudget `json:"disruptionBudget,omitempty"`
+
 	// Deprecated since v0.9
 	// Determines whether metrics exporter information is annotated on the Component's headless Service.
 	//
@@ -881,6 +1121,39 @@ type ClusterComponentSpec struct {
 	Monitor *bool `json:"monitor,omitempty"`
 }
 
+// ComponentDisruptionBudget defines the PodDisruptionBudget of a Component.
+//
+// By default, the budget is derived from the roles and replicas of the Component:
+//
+// - For the consensus Components, whose roles are votable, a quorum of the replicas is kept available.
+// - For the other Components, such as primary and secondary, only one replica can be disrupted at a time.
+// - The leader, whose role is serviceable and writable, is left out of the budget and guarded by a budget of its own
+// if the Component defines the switchover action, it can't be disrupted until it's switched over.
+// Otherwise, the leader stays in the budget as the other replicas.
+// - No PodDisruptionBudget is created for the Component with less than two replicas.
+//
+// The overridden budget applies to the replicas other than the guarded leader, with one replica less available.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.maxUnavailable) && has(self.minAvailable))",message="maxUnavailable and minAvailable are mutually exclusive"
+type ComponentDisruptionBudget struct {
+	// Specifies whether to disable the PodDisruptionBudget of the Component.
+	//
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Specifies the maximum number or percentage of the replicas that can be unavailable during voluntary disruptions,
+	// a percentage is rounded down.
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Specifies the minimum number or percentage of the replicas that must be available during voluntary disruptions,
+	// a percentage is rounded up.
+	//
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
 type ComponentMessageMap map[string]string
 
 // ClusterComponentStatus records Component status.
@@ -942,6 +1215,45 @@ type ClusterComponentVolumeClaimTemplate struct {
 	//
 	// +optional
 	Spec PersistentVolumeClaimSpec `json:"spec,omitempty"`
+
+	// Specifies the policy to expand the volume automatically when its space usage crosses the threshold.
+	// The volume is expanded by creating a `VolumeExpansion` OpsRequest, and it requires the lorry of the
+	// Component to monitor the space usage of the volume.
+	//
+	// Once the volume has been expanded to the max size, the volume protection, such as locking the instance
+	// as read-only, takes over if the high watermark is configured for the volume.
+	//
+	// +optional
+	Autoscaling *VolumeAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// VolumeAutoscaling defines the policy to expand a volume automatically.
+type VolumeAutoscaling struct {
+	// Specifies the threshold of the volume space usage in percentage.
+	// The volume is expanded once its space usage is over the threshold.
+	//
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=80
+	// +optional
+	Threshold int `json:"threshold,omitempty"`
+
+	// Specifies the size to increase the volume by for each expansion.
+	//
+	// +kubebuilder:validation:Required
+	Step resource.Quantity `json:"step"`
+
+	// Specifies the maximum size the volume can be expanded to.
+	//
+	// +kubebuilder:validation:Required
+	MaxSize resource.Quantity `json:"maxSize"`
+
+	// Specifies the minimum interval in seconds between two consecutive expansions of the volume.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=600
+	// +optional
+	CooldownSeconds int32 `json:"cooldownSeconds,omitempty"`
 }
 
 func (r *ClusterComponentVolumeClaimTemplate) toVolumeClaimTemplate() corev1.PersistentVolumeClaimTemplate {
@@ -1149,14 +1461,17 @@ type TLSConfig struct {
 // Issuer defines the TLS certificates issuer for the Cluster.
 type Issuer struct {
 	// The issuer for TLS certificates.
-	// It only allows two enum values: `KubeBlocks` and `UserProvided`.
+	// It only allows three enum values: `KubeBlocks`, `UserProvided` and `CertManager`.
 	//
 	// - `KubeBlocks` indicates that the self-signed TLS certificates generated by the KubeBlocks Operator will be used.
+	//   The certificates are renewed by the KubeBlocks Operator before they expire.
 	// - `UserProvided` means that the user is responsible for providing their own CA, Cert, and Key.
 	//   In this case, the user-provided CA certificate, server certificate, and private key will be used
 	//   for TLS communication.
+	// - `CertManager` means that the certificates are issued and renewed by cert-manager,
+	//   through a `Certificate` object that references the Issuer or ClusterIssuer specified in `issuerRef`.
 	//
-	// +kubebuilder:validation:Enum={KubeBlocks, UserProvided}
+	// +kubebuilder:validation:Enum={KubeBlocks, UserProvided, CertManager}
 	// +kubebuilder:default=KubeBlocks
 	// +kubebuilder:validation:Required
 	Name IssuerName `json:"name"`
@@ -1166,6 +1481,50 @@ type Issuer struct {
 	//
 	// +optional
 	SecretRef *TLSSecretRef `json:"secretRef,omitempty"`
+
+	// IssuerRef is the reference to the cert-manager Issuer or ClusterIssuer that signs the certificates.
+	// It is required when the issuer is set to `CertManager`.
+	//
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+
+	// Specifies the lifetime of the certificates issued by `KubeBlocks` or `CertManager`.
+	// Defaults to 8760h (one year) if not specified.
+	//
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Specifies how long before the expiry the certificates are renewed.
+	// It must be shorter than the `duration`. Defaults to 720h (30 days) if not specified.
+	//
+	// Renewed certificates are rolled out by restarting the Pods of the Component,
+	// following the update strategy of the Component.
+	//
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertManagerIssuerRef references a cert-manager Issuer or ClusterIssuer.
+type CertManagerIssuerRef struct {
+	// Name of the Issuer or ClusterIssuer.
+	// An Issuer must be in the same namespace as the Cluster.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Kind of the issuer, either `Issuer` or `ClusterIssuer`.
+	//
+	// +kubebuilder:validation:Enum={Issuer,ClusterIssuer}
+	// +kubebuilder:default=Issuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// API group of the issuer. Defaults to `cert-manager.io`.
+	// Set it for external issuers that are implemented outside of cert-manager.
+	//
+	// +kubebuilder:default=cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
 }
 
 // TLSSecretRef defines Secret contains Tls certs
@@ -1246,6 +1605,30 @@ type ComponentSystemAccount struct {
 	//
 	// +optional
 	SecretRef *ProvisionSecretRef `json:"secretRef,omitempty"`
+
+	// Specifies the policy for rotating the account's password periodically.
+	//
+	// The new password is set in the database engine first, then the account Secret is re-created with it,
+	// and the pods that consume the Secret are restarted to pick it up.
+	//
+	// +optional
+	RotationPolicy *PasswordRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+// PasswordRotationPolicy defines how often the password of a system account is rotated.
+type PasswordRotationPolicy struct {
+	// Specifies the interval between two rotations, e.g. "2160h" for 90 days.
+	// The interval is counted from the last rotation, or from the creation of the account Secret.
+	//
+	// +kubebuilder:validation:Required
+	Interval metav1.Duration `json:"interval"`
+
+	// Specifies how long the old password remains valid after a rotation, for engines supporting dual passwords
+	// (e.g. MySQL 8.0 "RETAIN CURRENT PASSWORD"). The old password is discarded when the period expires.
+	// It can't be set for the engines without dual password support, e.g. PostgreSQL.
+	//
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
 }
 
 // ClusterComponentConfig represents a config with its source bound.
@@ -255,6 +255,12 @@ type ComponentSpec struct {
 	//
 	// +optional
 	DisableExporter *bool `json:"disableExporter,omitempty"`
+
+	// Specifies the disruption budget of the Component's Pods, which overrides the budget derived from
+	// the roles and replicas of the Component.
+	//
+	// +optional
+	DisruptionBudget *ComponentDisruptionBudget `json:"disruptionBudget,omitempty"`
 }
 
 // ComponentStatus represents the observed state of a Component within the Cluster.
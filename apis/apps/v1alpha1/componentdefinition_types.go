@@ -1336,9 +1336,11 @@ type ComponentLifecycleActions struct {
 
 	// Defines the procedure that update a replica with new configuration.
 	//
-	// Note: This field is immutable once it has been set.
+	// Use Case:
+	// This action is executed on each replica to reload the TLS certificates renewed by KubeBlocks,
+	// the replicas are restarted to load them if it isn't defined.
 	//
-	// This Action is reserved for future versions.
+	// Note: This field is immutable once it has been set.
 	//
 	// +optional
 	Reconfigure *LifecycleActionHandler `json:"reconfigure,omitempty"`
@@ -80,6 +80,16 @@ func (status *ConfigurationStatus) GetItemStatus(name string) *ConfigurationItem
 	return nil
 }
 
+func (status *ConfigurationItemDetailStatus) GetRevisionHistory(revision string) *ConfigurationRevisionHistory {
+	for i := range status.RevisionHistory {
+		history := &status.RevisionHistory[i]
+		if history.Revision == revision {
+			return history
+		}
+	}
+	return nil
+}
+
 func (configSpec *ComponentConfigSpec) InjectEnvEnabled() bool {
 	return len(configSpec.AsEnvFrom) > 0 || len(configSpec.InjectEnvTo) > 0
 }
@@ -194,6 +194,72 @@ type ConfigurationItemDetailStatus struct {
 	//
 	// +optional
 	ReconcileDetail *ReconcileDetail `json:"reconcileDetail,omitempty"`
+
+	// Records the parameter sets applied to the configuration template, ordered from the oldest to the newest.
+	// Only the most recent revisions are kept.
+	//
+	// A ConfigurationRollback OpsRequest can roll the configuration back to any revision listed here.
+	//
+	// +optional
+	RevisionHistory []ConfigurationRevisionHistory `json:"revisionHistory,omitempty"`
+}
+
+// ConfigurationRevisionHistory records a parameter set applied to a configuration template.
+type ConfigurationRevisionHistory struct {
+	// Specifies the revision of the Configuration in which the parameter set was applied.
+	//
+	// +kubebuilder:validation:Required
+	Revision string `json:"revision"`
+
+	// Specifies the time when the parameter set was merged into the configuration template.
+	//
+	// +optional
+	AppliedTime metav1.Time `json:"appliedTime,omitempty"`
+
+	// Specifies the name of the OpsRequest that made the change.
+	// It is empty if the change was not made through an OpsRequest.
+	//
+	// +optional
+	OpsRequest string `json:"opsRequest,omitempty"`
+
+	// Specifies who made the change, i.e., the field manager that created the OpsRequest.
+	//
+	// +optional
+	Operator string `json:"operator,omitempty"`
+
+	// Lists the changes compared to the previous revision.
+	//
+	// +optional
+	Changes []ConfigurationParameterChange `json:"changes,omitempty"`
+
+	// Specifies the user-defined configuration parameters applied in this revision.
+	//
+	// +optional
+	ConfigFileParams map[string]ConfigParams `json:"configFileParams,omitempty"`
+}
+
+// ConfigurationParameterChange describes a change to a configuration file.
+type ConfigurationParameterChange struct {
+	// Specifies the name of the configuration file.
+	//
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+
+	// Specifies the name of the changed parameter.
+	// It is empty if the content of the whole file was changed.
+	//
+	// +optional
+	Parameter string `json:"parameter,omitempty"`
+
+	// Specifies the value before the change. It is not set if the parameter was added.
+	//
+	// +optional
+	OldValue *string `json:"oldValue,omitempty"`
+
+	// Specifies the value after the change. It is not set if the parameter was removed.
+	//
+	// +optional
+	NewValue *string `json:"newValue,omitempty"`
 }
 
 // ConfigurationStatus represents the observed state of a Configuration resource.
@@ -25,26 +25,29 @@ import (
 
 const (
 	// condition types
-	ConditionTypeCancelled          = "Cancelled"
-	ConditionTypeWaitForProgressing = "WaitForProgressing"
-	ConditionTypeValidated          = "Validated"
-	ConditionTypeSucceed            = "Succeed"
-	ConditionTypeFailed             = "Failed"
-	ConditionTypeAborted            = "Aborted"
-	ConditionTypeRestarting         = "Restarting"
-	ConditionTypeVerticalScaling    = "VerticalScaling"
-	ConditionTypeHorizontalScaling  = "HorizontalScaling"
-	ConditionTypeVolumeExpanding    = "VolumeExpanding"
-	ConditionTypeReconfigure        = "Reconfigure"
-	ConditionTypeSwitchover         = "Switchover"
-	ConditionTypeStop               = "Stopping"
-	ConditionTypeStart              = "Starting"
-	ConditionTypeVersionUpgrading   = "VersionUpgrading"
-	ConditionTypeExpose             = "Exposing"
-	ConditionTypeDataScript         = "ExecuteDataScript"
-	ConditionTypeBackup             = "Backup"
-	ConditionTypeInstanceRebuilding = "InstancesRebuilding"
-	ConditionTypeCustomOperation    = "CustomOperation"
+	ConditionTypeCancelled             = "Cancelled"
+	ConditionTypeWaitForProgressing    = "WaitForProgressing"
+	ConditionTypeValidated             = "Validated"
+	ConditionTypeSucceed               = "Succeed"
+	ConditionTypeFailed                = "Failed"
+	ConditionTypeAborted               = "Aborted"
+	ConditionTypeRestarting            = "Restarting"
+	ConditionTypeVerticalScaling       = "VerticalScaling"
+	ConditionTypeHorizontalScaling     = "HorizontalScaling"
+	ConditionTypeVolumeExpanding       = "VolumeExpanding"
+	ConditionTypeReconfigure           = "Reconfigure"
+	ConditionTypeSwitchover            = "Switchover"
+	ConditionTypeStop                  = "Stopping"
+	ConditionTypeStart                 = "Starting"
+	ConditionTypeVersionUpgrading      = "VersionUpgrading"
+	ConditionTypeExpose                = "Exposing"
+	ConditionTypeDataScript            = "ExecuteDataScript"
+	ConditionTypeBackup                = "Backup"
+	ConditionTypeInstanceRebuilding    = "InstancesRebuilding"
+	ConditionTypeCustomOperation       = "CustomOperation"
+	ConditionTypePromoteStandby        = "PromotingStandby"
+	ConditionTypeRotatePassword        = "RotatingPassword"
+	ConditionTypeConfigurationRollback = "RollingBackConfiguration"
 
 	// condition and event reasons
 
@@ -280,6 +283,39 @@ func NewStopCondition(ops *OpsRequest) *metav1.Condition {
 	}
 }
 
+// NewPromoteStandbyCondition creates a condition that the OpsRequest promotes the standby cluster.
+func NewPromoteStandbyCondition(ops *OpsRequest) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypePromoteStandby,
+		Status:             metav1.ConditionTrue,
+		Reason:             "PromoteStandbyStarted",
+		LastTransitionTime: metav1.Now(),
+		Message:            fmt.Sprintf("Start to promote the standby Cluster: %s", ops.Spec.GetClusterName()),
+	}
+}
+
+// NewRotatePasswordCondition creates a condition that the OpsRequest rotates the passwords of system accounts.
+func NewRotatePasswordCondition(ops *OpsRequest) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypeRotatePassword,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RotatePasswordStarted",
+		LastTransitionTime: metav1.Now(),
+		Message:            fmt.Sprintf("Start to rotate the passwords of system accounts in Cluster: %s", ops.Spec.GetClusterName()),
+	}
+}
+
+// NewConfigurationRollbackCondition creates a condition that the OpsRequest rolls back the configurations of components.
+func NewConfigurationRollbackCondition(ops *OpsRequest) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ConditionTypeConfigurationRollback,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ConfigurationRollbackStarted",
+		LastTransitionTime: metav1.Now(),
+		Message:            fmt.Sprintf("Start to roll back the configurations in Cluster: %s", ops.Spec.GetClusterName()),
+	}
+}
+
 // NewStartCondition creates a condition that the OpsRequest starts the cluster.
 func NewStartCondition(ops *OpsRequest) *metav1.Condition {
 	return &metav1.Condition{
@@ -66,7 +66,7 @@ type OpsRequestSpec struct {
 
 	// Specifies the type of this operation. Supported types include "Start", "Stop", "Restart", "Switchover",
 	// "VerticalScaling", "HorizontalScaling", "VolumeExpansion", "Reconfiguring", "Upgrade", "Backup", "Restore",
-	// "Expose", "DataScript", "RebuildInstance", "Custom".
+	// "Expose", "DataScript", "RebuildInstance", "PromoteStandby", "RotatePassword", "ConfigurationRollback", "Custom".
 	//
 	// Note: This field is immutable once set.
 	//
@@ -88,10 +88,38 @@ type OpsRequestSpec struct {
 	// +optional
 	PreConditionDeadlineSeconds *int32 `json:"preConditionDeadlineSeconds,omitempty"`
 
+	// Specifies when the OpsRequest is allowed to start.
+	// If not set, the OpsRequest starts as soon as its pre-conditions are met, except that the disruptive
+	// operations wait for the maintenance window of the Cluster if it is defined.
+	//
+	// +optional
+	Schedule *OpsSchedule `json:"schedule,omitempty"`
+
 	// Exactly one of its members must be set.
 	SpecificOpsRequest `json:",inline"`
 }
 
+// OpsSchedule defines when the OpsRequest is allowed to start.
+type OpsSchedule struct {
+	// Specifies the earliest time to start the OpsRequest.
+	//
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Specifies how the OpsRequest respects the maintenance window of the Cluster.
+	//
+	// - `Disruptive`: the OpsRequest waits for the maintenance window only if it is a disruptive operation,
+	//   e.g. a Reconfiguring waits only if the updated parameters can't be reloaded without restarting the pods.
+	// - `Always`: the OpsRequest always waits for the next maintenance window.
+	// - `Ignore`: the OpsRequest starts without waiting for the maintenance window.
+	//
+	// The OpsRequest starts immediately if no maintenance window is defined in the Cluster.
+	//
+	// +kubebuilder:default=Disruptive
+	// +optional
+	MaintenanceWindowPolicy MaintenanceWindowPolicy `json:"maintenanceWindowPolicy,omitempty"`
+}
+
 type SpecificOpsRequest struct {
 	// Specifies the desired new version of the Cluster.
 	//
@@ -222,6 +250,32 @@ type SpecificOpsRequest struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.rebuildFrom"
 	RebuildFrom []RebuildInstance `json:"rebuildFrom,omitempty"  patchStrategy:"merge,retainKeys" patchMergeKey:"componentName"`
 
+	// Specifies the parameters to promote a standby Cluster.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.promoteStandby"
+	PromoteStandby *PromoteStandby `json:"promoteStandby,omitempty"`
+
+	// Lists the Components and their system accounts whose passwords need to be rotated immediately.
+	//
+	// +optional
+	// +patchMergeKey=componentName
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=componentName
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.rotatePassword"
+	RotatePasswordList []RotatePassword `json:"rotatePassword,omitempty"  patchStrategy:"merge,retainKeys" patchMergeKey:"componentName"`
+
+	// Lists the Components whose configurations need to be rolled back to a previous revision.
+	//
+	// +optional
+	// +patchMergeKey=componentName
+	// +patchStrategy=merge,retainKeys
+	// +listType=map
+	// +listMapKey=componentName
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.configurationRollback"
+	ConfigurationRollbackList []ConfigurationRollback `json:"configurationRollback,omitempty"  patchStrategy:"merge,retainKeys" patchMergeKey:"componentName"`
+
 	// Specifies a custom operation defined by OpsDefinition.
 	//
 	// +optional
@@ -997,6 +1051,66 @@ type Restore struct {
 	DeferPostReadyUntilClusterRunning bool `json:"deferPostReadyUntilClusterRunning,omitempty"`
 }
 
+// OldPrimaryPolicy defines how to handle the old primary Cluster when a standby Cluster is promoted.
+// +enum
+// +kubebuilder:validation:Enum={Demote,Fence,None}
+type OldPrimaryPolicy string
+
+const (
+	// OldPrimaryDemote turns the old primary Cluster into a standby of the promoted Cluster.
+	OldPrimaryDemote OldPrimaryPolicy = "Demote"
+
+	// OldPrimaryFence stops the old primary Cluster to prevent it from accepting writes.
+	OldPrimaryFence OldPrimaryPolicy = "Fence"
+
+	// OldPrimaryNone leaves the old primary Cluster untouched, e.g. it is already lost.
+	OldPrimaryNone OldPrimaryPolicy = "None"
+)
+
+// PromoteStandby defines the parameters to promote a standby Cluster.
+type PromoteStandby struct {
+	// Specifies how to handle the old primary Cluster.
+	//
+	// - `Demote`: turns the old primary Cluster into a standby of the promoted Cluster.
+	// - `Fence`: stops the old primary Cluster.
+	// - `None`: leaves the old primary Cluster untouched.
+	//
+	// +kubebuilder:default=Demote
+	// +optional
+	OldPrimaryPolicy OldPrimaryPolicy `json:"oldPrimaryPolicy,omitempty"`
+}
+
+// RotatePassword defines the system accounts of a Component whose passwords need to be rotated.
+type RotatePassword struct {
+	// Specifies the name of the Component.
+	ComponentOps `json:",inline"`
+
+	// Specifies the names of the system accounts to rotate.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	AccountNames []string `json:"accountNames"`
+}
+
+// ConfigurationRollback defines the revision to which the configuration of a Component rolls back.
+type ConfigurationRollback struct {
+	// Specifies the name of the Component.
+	ComponentOps `json:",inline"`
+
+	// Specifies the name of the configuration template.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9\.\-]*[a-z0-9])?$`
+	ConfigurationName string `json:"configurationName"`
+
+	// Specifies the revision to roll back to.
+	// It must be one of the revisions recorded in the revision history of the configuration item.
+	//
+	// +kubebuilder:validation:Required
+	Revision string `json:"revision"`
+}
+
 // ScriptSecret represents the secret that is used to execute the script.
 type ScriptSecret struct {
 	// Specifies the name of the secret.
@@ -1059,6 +1173,11 @@ type OpsRequestStatus struct {
 	// A collection of additional key-value pairs that provide supplementary information for the OpsRequest.
 	Extras []map[string]string `json:"extras,omitempty"`
 
+	// Records the time when the OpsRequest is scheduled to start, it is set when the OpsRequest waits
+	// for `spec.schedule.startTime` or the maintenance window of the Cluster.
+	// +optional
+	ScheduledStartTime *metav1.Time `json:"scheduledStartTime,omitempty"`
+
 	// Records the time when the OpsRequest started processing.
 	// +optional
 	StartTimestamp metav1.Time `json:"startTimestamp,omitempty"`
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 )
 
@@ -145,6 +147,12 @@ func (r *OpsRequest) validateOps(ctx context.Context,
 		return r.validateExpose(ctx, cluster)
 	case RebuildInstanceType:
 		return r.validateRebuildInstance(cluster)
+	case RestoreType:
+		return r.validateRestore(ctx, k8sClient)
+	case RotatePasswordType:
+		return r.validateRotatePassword(cluster)
+	case ConfigurationRollbackType:
+		return r.validateConfigurationRollback(ctx, k8sClient, cluster)
 	}
 	return nil
 }
@@ -191,6 +199,96 @@ func (r *OpsRequest) validateRebuildInstance(cluster *Cluster) error {
 	return r.checkComponentExistence(cluster, compOpsList)
 }
 
+func (r *OpsRequest) validateRotatePassword(cluster *Cluster) error {
+	rotatePasswordList := r.Spec.RotatePasswordList
+	if len(rotatePasswordList) == 0 {
+		return notEmptyError("spec.rotatePassword")
+	}
+	var compOpsList []ComponentOps
+	for _, v := range rotatePasswordList {
+		compOpsList = append(compOpsList, v.ComponentOps)
+	}
+	return r.checkComponentExistence(cluster, compOpsList)
+}
+
+// validateConfigurationRollback validates spec.configurationRollback, the revision must be recorded
+// in the revision history of the configuration item.
+func (r *OpsRequest) validateConfigurationRollback(ctx context.Context, k8sClient client.Client, cluster *Cluster) error {
+	rollbackList := r.Spec.ConfigurationRollbackList
+	if len(rollbackList) == 0 {
+		return notEmptyError("spec.configurationRollback")
+	}
+	for _, rollback := range rollbackList {
+		if cluster.Spec.GetComponentByName(rollback.ComponentName) == nil {
+			return fmt.Errorf("component %s not found", rollback.ComponentName)
+		}
+		configuration := &Configuration{}
+		configKey := client.ObjectKey{
+			Namespace: r.Namespace,
+			Name:      fmt.Sprintf("%s-%s", r.Spec.GetClusterName(), rollback.ComponentName),
+		}
+		if err := k8sClient.Get(ctx, configKey, configuration); err != nil {
+			return err
+		}
+		itemStatus := configuration.Status.GetItemStatus(rollback.ConfigurationName)
+		if itemStatus == nil || itemStatus.GetRevisionHistory(rollback.Revision) == nil {
+			return fmt.Errorf(`revision "%s" of configuration %s not found in the revision history of component %s`,
+				rollback.Revision, rollback.ConfigurationName, rollback.ComponentName)
+		}
+	}
+	return nil
+}
+
+// validateRestore validates spec.restore, the restorePointInTime must be in one of the recoverable windows
+// of the BackupPolicy which the continuous backup belongs to.
+func (r *OpsRequest) validateRestore(ctx context.Context, k8sClient client.Client) error {
+	restoreSpec := r.Spec.GetRestore()
+	if restoreSpec == nil || restoreSpec.RestorePointInTime == "" {
+		return nil
+	}
+	restoreTime, err := dpv1alpha1.ParseRestoreTime(restoreSpec.RestorePointInTime)
+	if err != nil {
+		return fmt.Errorf(`invalid restorePointInTime "%s": %s`, restoreSpec.RestorePointInTime, err.Error())
+	}
+	backup := &dpv1alpha1.Backup{}
+	if err = k8sClient.Get(ctx, types.NamespacedName{Namespace: r.Namespace, Name: restoreSpec.BackupName}, backup); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	backupPolicy := &dpv1alpha1.BackupPolicy{}
+	if err = k8sClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.BackupPolicyName}, backupPolicy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// only the recoverable windows covered by the chosen backup can be restored from it.
+	var windows []dpv1alpha1.RecoverableWindow
+	for _, w := range backupPolicy.Status.RecoverableWindows {
+		if slices.Contains(w.BackupNames, backup.Name) {
+			windows = append(windows, w)
+		}
+	}
+	// the backup is not recorded in the recoverable windows yet, check the time range of the backup like the restore does.
+	if len(windows) == 0 {
+		timeRange := backup.Status.TimeRange
+		if timeRange == nil || timeRange.Start == nil || timeRange.End == nil || timeRange.Contains(restoreTime) {
+			return nil
+		}
+		return fmt.Errorf(`restorePointInTime "%s" is out of the time range [%s, %s] of Backup "%s"`,
+			restoreTime.UTC().Format(time.RFC3339), timeRange.Start.UTC().Format(time.RFC3339),
+			timeRange.End.UTC().Format(time.RFC3339), backup.Name)
+	}
+	var windowStrs []string
+	for _, w := range windows {
+		if w.Contains(restoreTime) {
+			return nil
+		}
+		windowStrs = append(windowStrs, w.String())
+	}
+	return fmt.Errorf(`restorePointInTime "%s" is not in any recoverable window of Backup "%s", the recoverable windows are: %s`,
+		restoreTime.UTC().Format(time.RFC3339), backup.Name, strings.Join(windowStrs, ", "))
+}
+
 // validateUpgrade validates spec.restart
 func (r *OpsRequest) validateRestart(cluster *Cluster) error {
 	restartList := r.Spec.RestartList
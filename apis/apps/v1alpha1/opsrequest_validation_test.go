@@ -0,0 +1,114 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+)
+
+func TestValidateRestore(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *metav1.Time {
+		return &metav1.Time{Time: base.Add(time.Duration(hours) * time.Hour)}
+	}
+	newBackup := func(name string, start, end *metav1.Time) *dpv1alpha1.Backup {
+		backup := &dpv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       dpv1alpha1.BackupSpec{BackupPolicyName: "policy"},
+		}
+		if start != nil {
+			backup.Status.TimeRange = &dpv1alpha1.BackupTimeRange{Start: start, End: end}
+		}
+		return backup
+	}
+	newPolicy := func(name string, windows ...dpv1alpha1.RecoverableWindow) *dpv1alpha1.BackupPolicy {
+		return &dpv1alpha1.BackupPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Status:     dpv1alpha1.BackupPolicyStatus{RecoverableWindows: windows},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	if err := dpv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newBackup("full", at(0), at(1)),
+		newBackup("continuous", at(0), at(10)),
+		newBackup("continuous-2", at(0), at(14)),
+		newPolicy("policy",
+			dpv1alpha1.RecoverableWindow{Start: *at(0), End: *at(2), BackupNames: []string{"continuous"}},
+			dpv1alpha1.RecoverableWindow{Start: *at(4), End: *at(10), BackupNames: []string{"continuous", "continuous-2"}},
+			dpv1alpha1.RecoverableWindow{Start: *at(12), End: *at(14), BackupNames: []string{"continuous-2"}}),
+	).Build()
+	cliWithoutWindows := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newBackup("continuous", at(0), at(10)),
+		newPolicy("policy"),
+	).Build()
+
+	tests := []struct {
+		name        string
+		backupName  string
+		restoreTime string
+		noWindows   bool
+		errContains string
+	}{
+		{name: "no restore time", backupName: "continuous"},
+		{name: "invalid restore time", backupName: "continuous", restoreTime: "2024-01-01", errContains: "invalid restorePointInTime"},
+		{name: "backup not found", backupName: "not-found", restoreTime: "2024-01-01T01:00:00Z"},
+		{name: "in the time range of a backup without windows", backupName: "full", restoreTime: "2024-01-01T00:30:00Z"},
+		{name: "out of the time range of a backup without windows", backupName: "full", restoreTime: "2024-01-01T03:00:00Z",
+			errContains: `out of the time range [2024-01-01T00:00:00Z, 2024-01-01T01:00:00Z] of Backup "full"`},
+		{name: "in a recoverable window", backupName: "continuous", restoreTime: "2024-01-01T05:00:00Z"},
+		{name: "in the human-readable layout", backupName: "continuous", restoreTime: "Jan 01,2024 09:00:00 UTC+0800"},
+		{name: "in the gap of windows", backupName: "continuous", restoreTime: "2024-01-01T03:00:00Z",
+			errContains: "the recoverable windows are: [2024-01-01T00:00:00Z, 2024-01-01T02:00:00Z], [2024-01-01T04:00:00Z, 2024-01-01T10:00:00Z]"},
+		{name: "in the window of another backup", backupName: "continuous", restoreTime: "2024-01-01T13:00:00Z",
+			errContains: `not in any recoverable window of Backup "continuous"`},
+		{name: "in a window of the backup", backupName: "continuous-2", restoreTime: "2024-01-01T13:00:00Z"},
+		{name: "in the time range of the backup without windows", backupName: "continuous", restoreTime: "2024-01-01T03:00:00Z", noWindows: true},
+		{name: "out of the time range of the backup without windows", backupName: "continuous", restoreTime: "2024-01-01T11:00:00Z", noWindows: true,
+			errContains: `out of the time range [2024-01-01T00:00:00Z, 2024-01-01T10:00:00Z] of Backup "continuous"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &OpsRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
+			ops.Spec.Type = RestoreType
+			ops.Spec.Restore = &Restore{BackupName: tt.backupName, RestorePointInTime: tt.restoreTime}
+			k8sClient := cli
+			if tt.noWindows {
+				k8sClient = cliWithoutWindows
+			}
+			err := ops.validateRestore(context.Background(), k8sClient)
+			switch {
+			case tt.errContains == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.errContains != "" && (err == nil || !strings.Contains(err.Error(), tt.errContains)):
+				t.Errorf("expected error containing %q, got %v", tt.errContains, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+// SetupWebhookWithManager registers the webhook of OpsRequest with the manager.
+func (r *OpsRequest) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(&opsRequestCreatorRecorder{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-apps-kubeblocks-io-v1alpha1-opsrequest,mutating=true,failurePolicy=fail,sideEffects=None,groups=apps.kubeblocks.io,resources=opsrequests,verbs=create;update,versions=v1alpha1,name=mopsrequest.kb.io,admissionReviewVersions=v1
+
+// opsRequestCreatorRecorder records the user who created the OpsRequest in the annotation,
+// the annotation cannot be changed after the OpsRequest is created.
+type opsRequestCreatorRecorder struct{}
+
+var _ webhook.CustomDefaulter = &opsRequestCreatorRecorder{}
+
+func (d *opsRequestCreatorRecorder) Default(ctx context.Context, obj runtime.Object) error {
+	opsRequest, ok := obj.(*OpsRequest)
+	if !ok {
+		return fmt.Errorf("expected an OpsRequest but got a %T", obj)
+	}
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	creator := req.UserInfo.Username
+	if req.Operation == admissionv1.Update {
+		oldOpsRequest := &OpsRequest{}
+		if err = json.Unmarshal(req.OldObject.Raw, oldOpsRequest); err != nil {
+			return err
+		}
+		creator = oldOpsRequest.Annotations[constant.OpsRequestCreatorAnnotationKey]
+	}
+	if creator == "" {
+		delete(opsRequest.Annotations, constant.OpsRequestCreatorAnnotationKey)
+		return nil
+	}
+	if opsRequest.Annotations == nil {
+		opsRequest.Annotations = map[string]string{}
+	}
+	opsRequest.Annotations[constant.OpsRequestCreatorAnnotationKey] = creator
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+func TestRecordOpsRequestCreator(t *testing.T) {
+	recorder := &opsRequestCreatorRecorder{}
+	newRequestContext := func(operation admissionv1.Operation, oldObj *OpsRequest) context.Context {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			UserInfo:  authenticationv1.UserInfo{Username: "bob"},
+		}}
+		if oldObj != nil {
+			req.OldObject.Raw, _ = json.Marshal(oldObj)
+		}
+		return admission.NewContextWithRequest(context.Background(), req)
+	}
+
+	ops := &OpsRequest{}
+	ops.Annotations = map[string]string{constant.OpsRequestCreatorAnnotationKey: "alice"}
+	if err := recorder.Default(newRequestContext(admissionv1.Create, nil), ops); err != nil {
+		t.Fatal(err)
+	}
+	if creator := ops.Annotations[constant.OpsRequestCreatorAnnotationKey]; creator != "bob" {
+		t.Errorf(`Expected the creator is "bob", but got %q`, creator)
+	}
+
+	oldOps := ops.DeepCopy()
+	oldOps.Annotations[constant.OpsRequestCreatorAnnotationKey] = "alice"
+	if err := recorder.Default(newRequestContext(admissionv1.Update, oldOps), ops); err != nil {
+		t.Fatal(err)
+	}
+	if creator := ops.Annotations[constant.OpsRequestCreatorAnnotationKey]; creator != "alice" {
+		t.Errorf(`Expected the creator is kept as "alice", but got %q`, creator)
+	}
+
+	if err := recorder.Default(context.Background(), ops); err == nil {
+		t.Error("Expected an error without the admission request")
+	}
+	if err := recorder.Default(newRequestContext(admissionv1.Create, nil), &Cluster{}); err == nil {
+		t.Error("Expected an error for the object other than OpsRequest")
+	}
+}
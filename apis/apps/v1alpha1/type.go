@@ -370,25 +370,39 @@ const (
 
 // OpsType defines operation types.
 // +enum
-// +kubebuilder:validation:Enum={Upgrade,VerticalScaling,VolumeExpansion,HorizontalScaling,Restart,Reconfiguring,Start,Stop,Expose,Switchover,DataScript,Backup,Restore,RebuildInstance,Custom}
+// +kubebuilder:validation:Enum={Upgrade,VerticalScaling,VolumeExpansion,HorizontalScaling,Restart,Reconfiguring,Start,Stop,Expose,Switchover,DataScript,Backup,Restore,RebuildInstance,PromoteStandby,RotatePassword,ConfigurationRollback,Custom}
 type OpsType string
 
 const (
-	VerticalScalingType   OpsType = "VerticalScaling"
-	HorizontalScalingType OpsType = "HorizontalScaling"
-	VolumeExpansionType   OpsType = "VolumeExpansion"
-	UpgradeType           OpsType = "Upgrade"
-	ReconfiguringType     OpsType = "Reconfiguring"
-	SwitchoverType        OpsType = "Switchover"
-	RestartType           OpsType = "Restart" // RestartType the restart operation is a special case of the rolling update operation.
-	StopType              OpsType = "Stop"    // StopType the stop operation will delete all pods in a cluster concurrently.
-	StartType             OpsType = "Start"   // StartType the start operation will start the pods which is deleted in stop operation.
-	ExposeType            OpsType = "Expose"
-	DataScriptType        OpsType = "DataScript" // DataScriptType the data script operation will execute the data script against the cluster.
-	BackupType            OpsType = "Backup"
-	RestoreType           OpsType = "Restore"
-	RebuildInstanceType   OpsType = "RebuildInstance" // RebuildInstance rebuilding an instance is very useful when a node is offline or an instance is unrecoverable.
-	CustomType            OpsType = "Custom"          // use opsDefinition
+	VerticalScalingType       OpsType = "VerticalScaling"
+	HorizontalScalingType     OpsType = "HorizontalScaling"
+	VolumeExpansionType       OpsType = "VolumeExpansion"
+	UpgradeType               OpsType = "Upgrade"
+	ReconfiguringType         OpsType = "Reconfiguring"
+	SwitchoverType            OpsType = "Switchover"
+	RestartType               OpsType = "Restart" // RestartType the restart operation is a special case of the rolling update operation.
+	StopType                  OpsType = "Stop"    // StopType the stop operation will delete all pods in a cluster concurrently.
+	StartType                 OpsType = "Start"   // StartType the start operation will start the pods which is deleted in stop operation.
+	ExposeType                OpsType = "Expose"
+	DataScriptType            OpsType = "DataScript" // DataScriptType the data script operation will execute the data script against the cluster.
+	BackupType                OpsType = "Backup"
+	RestoreType               OpsType = "Restore"
+	RebuildInstanceType       OpsType = "RebuildInstance"       // RebuildInstance rebuilding an instance is very useful when a node is offline or an instance is unrecoverable.
+	PromoteStandbyType        OpsType = "PromoteStandby"        // PromoteStandby promotes a standby cluster to be a primary cluster.
+	RotatePasswordType        OpsType = "RotatePassword"        // RotatePassword rotates the passwords of component system accounts.
+	ConfigurationRollbackType OpsType = "ConfigurationRollback" // ConfigurationRollback rolls the configuration of a component back to a previous revision.
+	CustomType                OpsType = "Custom"                // use opsDefinition
+)
+
+// MaintenanceWindowPolicy defines how the OpsRequest respects the maintenance window of the cluster.
+// +enum
+// +kubebuilder:validation:Enum={Disruptive,Always,Ignore}
+type MaintenanceWindowPolicy string
+
+const (
+	MaintenanceWindowPolicyDisruptive MaintenanceWindowPolicy = "Disruptive"
+	MaintenanceWindowPolicyAlways     MaintenanceWindowPolicy = "Always"
+	MaintenanceWindowPolicyIgnore     MaintenanceWindowPolicy = "Ignore"
 )
 
 // ComponentResourceKey defines the resource key of component, such as pod/pvc.
@@ -689,7 +703,7 @@ const (
 
 // IssuerName defines the name of the TLS certificates issuer.
 // +enum
-// +kubebuilder:validation:Enum={KubeBlocks,UserProvided}
+// +kubebuilder:validation:Enum={KubeBlocks,UserProvided,CertManager}
 type IssuerName string
 
 const (
@@ -698,6 +712,9 @@ const (
 
 	// IssuerUserProvided indicates that the user has provided their own CA-signed certificates.
 	IssuerUserProvided IssuerName = "UserProvided"
+
+	// IssuerCertManager indicates that the certificates are issued by cert-manager.
+	IssuerCertManager IssuerName = "CertManager"
 )
 
 // SwitchPolicyType defines the types of switch policies that can be applied to a cluster.
@@ -204,6 +204,16 @@ type ReloadAction struct {
 	// +optional
 	TPLScriptTrigger *TPLScriptTrigger `json:"tplScriptTrigger"`
 
+	// Allows to execute SQL statements to reload the updated parameters, e.g. "SET GLOBAL" or "ALTER SYSTEM".
+	//
+	// +optional
+	SQLTrigger *SQLTrigger `json:"sqlTrigger,omitempty"`
+
+	// Allows to send the updated parameters to an HTTP endpoint of the engine to reload them.
+	//
+	// +optional
+	HTTPTrigger *HTTPTrigger `json:"httpTrigger,omitempty"`
+
 	// Automatically perform the reload when specified conditions are met.
 	//
 	// +optional
@@ -486,6 +496,139 @@ type TPLScriptTrigger struct {
 	Sync *bool `json:"sync,omitempty"`
 }
 
+// SQLTrigger allows to execute SQL statements to reload the updated parameters.
+//
+// Example:
+// ```yaml
+// dataType: mysql
+// dsn: "{{ .username }}:{{ .password }}@tcp(127.0.0.1:3306)/"
+// credential: {systemAccount: root}
+// statementTemplate: "SET GLOBAL {{ .name }} = {{ .value }}"
+// ```
+type SQLTrigger struct {
+	// Specifies the type of the database engine. Supported types include "mysql" and "postgresql".
+	//
+	// +kubebuilder:validation:Enum={mysql,postgresql}
+	// +kubebuilder:validation:Required
+	DataType string `json:"dataType"`
+
+	// Specifies the Go template of the data source name to connect to the engine.
+	// The template accesses the credential via `.username` and `.password`, and the environment variables via `env`.
+	//
+	// +kubebuilder:validation:Required
+	DSN string `json:"dsn"`
+
+	// Specifies the credential to connect to the engine.
+	//
+	// +optional
+	Credential *ReloadCredential `json:"credential,omitempty"`
+
+	// Specifies the Go template of the statement executed for each updated parameter.
+	// The template accesses the parameter via `.name` and `.value`.
+	//
+	// +kubebuilder:validation:Required
+	StatementTemplate string `json:"statementTemplate"`
+
+	// Specifies the statements executed after all the parameters are updated, e.g. "SELECT pg_reload_conf()".
+	//
+	// +optional
+	PostStatements []string `json:"postStatements,omitempty"`
+
+	// Determines whether parameter updates should be synchronized with the "config-manager".
+	//
+	// - If set to 'True', the controller executes the reload action in synchronous mode,
+	//   pausing execution until the reload completes.
+	// - If set to 'False', the controller executes the reload action in asynchronous mode,
+	//   updating the ConfigMap without waiting for the reload process to finish.
+	//
+	// +optional
+	Sync *bool `json:"sync,omitempty"`
+}
+
+// HTTPTrigger allows to send the updated parameters to an HTTP endpoint of the engine to reload them.
+//
+// Example:
+// ```yaml
+// url: http://127.0.0.1:9200/_cluster/settings
+// method: PUT
+// headers: {Content-Type: application/json}
+// bodyTemplate: '{"persistent": {{ toJson $ }}}'
+// credential: {systemAccount: elastic}
+// ```
+type HTTPTrigger struct {
+	// Specifies the URL of the endpoint. It is rendered as a Go template accessing the environment variables via `env`.
+	//
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// Specifies the HTTP method of the request.
+	//
+	// +kubebuilder:validation:Enum={POST,PUT,PATCH}
+	// +kubebuilder:default=POST
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Specifies the headers of the request.
+	//
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Specifies the Go template of the request body.
+	// The template accesses key-value pairs of updated parameters via the '$' variable.
+	// If not specified, the updated parameters are sent as a JSON object.
+	//
+	// +optional
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+
+	// Specifies the credential sent as the basic authentication of the request.
+	//
+	// +optional
+	Credential *ReloadCredential `json:"credential,omitempty"`
+
+	// Specifies the number of retries when the request fails or the response status is not 2xx.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+
+	// Determines whether parameter updates should be synchronized with the "config-manager".
+	//
+	// - If set to 'True', the controller executes the reload action in synchronous mode,
+	//   pausing execution until the reload completes.
+	// - If set to 'False', the controller executes the reload action in asynchronous mode,
+	//   updating the ConfigMap without waiting for the reload process to finish.
+	//
+	// +optional
+	Sync *bool `json:"sync,omitempty"`
+}
+
+// ReloadCredential specifies the credential used by the reload action, which is read from a Secret.
+// Only one of the `systemAccount` and `secretName` can be specified.
+type ReloadCredential struct {
+	// Specifies the system account of the Component, whose Secret holds the credential.
+	//
+	// +optional
+	SystemAccount string `json:"systemAccount,omitempty"`
+
+	// Specifies the name of the Secret holding the credential, in the namespace of the Cluster.
+	//
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Specifies the key of the username in the Secret.
+	//
+	// +kubebuilder:default="username"
+	// +optional
+	UsernameKey string `json:"usernameKey,omitempty"`
+
+	// Specifies the key of the password in the Secret.
+	//
+	// +kubebuilder:default="password"
+	// +optional
+	PasswordKey string `json:"passwordKey,omitempty"`
+}
+
 // AutoTrigger automatically perform the reload when specified conditions are met.
 type AutoTrigger struct {
 	// The name of the process.
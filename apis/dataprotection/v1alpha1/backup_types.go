@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -187,6 +189,63 @@ type BackupStatus struct {
 	//
 	// +optional
 	Extras []map[string]string `json:"extras,omitempty"`
+
+	// Records the parent backup of the incremental or differential backup.
+	// It is `spec.parentBackupName` if specified, otherwise it is selected by the controller
+	// from the completed backups of the same backup policy.
+	//
+	// +optional
+	ParentBackupName string `json:"parentBackupName,omitempty"`
+
+	// Records the full backup that the incremental or differential backup chain is based on.
+	//
+	// +optional
+	BaseBackupName string `json:"baseBackupName,omitempty"`
+
+	// Records the ancestor backups of the incremental or differential backup,
+	// ordered from the base backup to the parent backup.
+	// Restoring the backup replays the ancestors in this order before the backup itself.
+	//
+	// +optional
+	AncestorBackupNames []string `json:"ancestorBackupNames,omitempty"`
+
+	// Records the result of the latest BackupVerification of this backup.
+	//
+	// +optional
+	Verification *BackupVerificationResult `json:"verification,omitempty"`
+}
+
+// BackupVerificationResult records the result of a BackupVerification.
+type BackupVerificationResult struct {
+	// The name of the BackupVerification.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Indicates whether the backup has been restored and passed all checks.
+	//
+	// +kubebuilder:validation:Required
+	Passed bool `json:"passed"`
+
+	// Records the date/time when the verification started.
+	//
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// Records the date/time when the verification was completed.
+	//
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Records the duration of the verification.
+	//
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The reason why the verification failed.
+	//
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // BackupTimeRange records the time range of backed up data, for PITR, this is the
@@ -209,6 +268,14 @@ type BackupTimeRange struct {
 	End *metav1.Time `json:"end,omitempty"`
 }
 
+// Contains checks if the time is in the time range, false if the start or end time is not recorded.
+func (r *BackupTimeRange) Contains(t time.Time) bool {
+	if r == nil || r.Start == nil || r.End == nil {
+		return false
+	}
+	return !t.Before(r.Start.Time) && !t.After(r.End.Time)
+}
+
 // BackupDeletionPolicy describes the policy for end-of-life maintenance of backup content.
 // +enum
 // +kubebuilder:validation:Enum={Delete,Retain}
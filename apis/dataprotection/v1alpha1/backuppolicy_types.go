@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -213,6 +216,18 @@ type BackupMethod struct {
 	// +optional
 	ActionSetName string `json:"actionSetName,omitempty"`
 
+	// Specifies the full backup method whose backups can be the base of the backup chain.
+	// It only takes effect for the incremental or differential backup methods, which select
+	// the parent backup automatically if `spec.parentBackupName` of the backup is not specified:
+	//
+	// - Differential backups are based on the latest completed backup of the compatible method.
+	// - Incremental backups are based on the latest completed backup of either this method or the compatible method.
+	//
+	// If not set, any full backup of the backup policy that does not take volume snapshots is compatible.
+	//
+	// +optional
+	CompatibleMethod string `json:"compatibleMethod,omitempty"`
+
 	// Specifies which volumes from the target should be mounted in the backup workload.
 	//
 	// +optional
@@ -280,8 +295,54 @@ type BackupPolicyStatus struct {
 	//
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Records the time windows that the data can be restored to with point-in-time recovery.
+	// They are computed from the full backups and the continuous backups of this BackupPolicy,
+	// and sorted by the start time.
+	//
+	// +optional
+	RecoverableWindows []RecoverableWindow `json:"recoverableWindows,omitempty"`
+
+	// Describes the current state of the BackupPolicy, such as the gaps between the recoverable windows.
+	//
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// RecoverableWindow describes a continuous time range that the data can be restored to.
+type RecoverableWindow struct {
+	// Records the start time of the window, in Coordinated Universal Time (UTC).
+	// It is the completion time of the earliest full backup which the continuous backups can be based on.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start"`
+
+	// Records the end time of the window, in Coordinated Universal Time (UTC).
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end"`
+
+	// Records the names of the continuous backups which cover this window.
+	//
+	// +optional
+	BackupNames []string `json:"backupNames,omitempty"`
+}
+
+// Contains checks if the time is in the window.
+func (w RecoverableWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start.Time) && !t.After(w.End.Time)
+}
+
+func (w RecoverableWindow) String() string {
+	return fmt.Sprintf("[%s, %s]", w.Start.UTC().Format(time.RFC3339), w.End.UTC().Format(time.RFC3339))
+}
+
+const (
+	// ConditionTypeRecoverableWindowsContinuous is the name of the condition that
+	// indicates whether the recoverable windows of the BackupPolicy have no gaps.
+	ConditionTypeRecoverableWindowsContinuous = "RecoverableWindowsContinuous"
+)
+
 // BackupPolicyPhase defines phases for BackupPolicy.
 // +enum
 // +kubebuilder:validation:Enum={Available,Failed}
@@ -0,0 +1,183 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupVerificationSpec defines the desired state of BackupVerification.
+// +kubebuilder:validation:XValidation:rule="has(self.backupName) || has(self.backupPolicyName)",message="either spec.backupName or spec.backupPolicyName is required"
+type BackupVerificationSpec struct {
+	// Specifies the name of the Backup to verify.
+	// If not specified, the latest completed full Backup of the BackupPolicy is verified.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.backupName"
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// Specifies the name of the BackupPolicy to choose the latest completed full Backup from,
+	// it is ignored if `backupName` is specified.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="forbidden to update spec.backupPolicyName"
+	// +optional
+	BackupPolicyName string `json:"backupPolicyName,omitempty"`
+
+	// Specifies the checks to run against the scratch Cluster restored from the Backup.
+	// The checks are run one by one in order, and the verification fails once a check fails.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Checks []VerificationCheck `json:"checks"`
+
+	// Specifies the maximum duration to wait for the scratch Cluster to be running
+	// and all checks to complete before considering the verification a failure.
+	//
+	// +kubebuilder:default="1h"
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// VerificationCheck defines a check to run against the scratch Cluster.
+// Either `exec` or `job` should be specified.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.exec) != has(self.job)",message="either exec or job is required"
+type VerificationCheck struct {
+	// Specifies the name of the check.
+	//
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the command to execute in the target Pod of the scratch Cluster.
+	// The target Pod is a Pod of the Component which the Backup is taken from.
+	//
+	// +optional
+	Exec *ExecActionSpec `json:"exec,omitempty"`
+
+	// Specifies a Job to run the check. The connection information of the target Pod is
+	// provided by the environment variables `DP_DB_HOST`, `DP_DB_PORT`, `DP_DB_USER` and `DP_DB_PASSWORD`.
+	//
+	// +optional
+	Job *JobActionSpec `json:"job,omitempty"`
+}
+
+// BackupVerificationPhase defines the phase of the BackupVerification.
+// +enum
+// +kubebuilder:validation:Enum={Restoring,Verifying,Passed,Failed}
+type BackupVerificationPhase string
+
+const (
+	// BackupVerificationPhaseRestoring means the Backup is being restored into the scratch Cluster.
+	BackupVerificationPhaseRestoring BackupVerificationPhase = "Restoring"
+
+	// BackupVerificationPhaseVerifying means the checks are running against the scratch Cluster.
+	BackupVerificationPhaseVerifying BackupVerificationPhase = "Verifying"
+
+	// BackupVerificationPhasePassed means all checks have passed.
+	BackupVerificationPhasePassed BackupVerificationPhase = "Passed"
+
+	// BackupVerificationPhaseFailed means the Backup can't be restored or a check has failed.
+	BackupVerificationPhaseFailed BackupVerificationPhase = "Failed"
+)
+
+// BackupVerificationStatus defines the observed state of BackupVerification.
+type BackupVerificationStatus struct {
+	// Represents the current phase of the verification.
+	//
+	// +optional
+	Phase BackupVerificationPhase `json:"phase,omitempty"`
+
+	// Records the name of the Backup being verified.
+	//
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// Records the name of the scratch Cluster restored from the Backup.
+	// The Cluster is deleted once the verification is finished.
+	//
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Records the date/time when the verification started being processed.
+	//
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// Records the date/time when the verification finished being processed.
+	//
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Records the duration of the verification.
+	// When converted to a string, the form is "1h2m0.5s".
+	//
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Records the status of the checks.
+	//
+	// +optional
+	Checks []ActionStatus `json:"checks,omitempty"`
+
+	// Provides a human-readable message indicating details about the verification.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kubeblocks},shortName=bv
+// +kubebuilder:printcolumn:name="BACKUP",type="string",JSONPath=".status.backupName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="DURATION",type=string,JSONPath=".status.duration"
+// +kubebuilder:printcolumn:name="CREATION-TIME",type=string,JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="COMPLETION-TIME",type=string,JSONPath=".status.completionTimestamp"
+
+// BackupVerification is the Schema for the backupverifications API.
+// It restores a Backup into a scratch Cluster, runs the checks against it and tears the Cluster down,
+// to prove that the Backup can be restored.
+type BackupVerification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupVerificationSpec   `json:"spec,omitempty"`
+	Status BackupVerificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupVerificationList contains a list of BackupVerification
+type BackupVerificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupVerification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackupVerification{}, &BackupVerificationList{})
+}
+
+// IsFinished checks if the verification is finished.
+func (r *BackupVerification) IsFinished() bool {
+	return r.Status.Phase == BackupVerificationPhasePassed || r.Status.Phase == BackupVerificationPhaseFailed
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -520,3 +522,19 @@ func (p *PrepareDataConfig) IsSerialPolicy() bool {
 	}
 	return p.VolumeClaimRestorePolicy == VolumeClaimRestorePolicySerial
 }
+
+// RestoreTimeLayout is the human-readable layout of the time to restore to.
+const RestoreTimeLayout = "Jan 02,2006 15:04:05 UTC-0700"
+
+// ParseRestoreTime parses the time to restore to in the RestoreTimeLayout or the RFC3339 layout.
+func ParseRestoreTime(restoreTimeStr string) (time.Time, error) {
+	restoreTime, err := time.Parse(RestoreTimeLayout, restoreTimeStr)
+	if err == nil {
+		return restoreTime, nil
+	}
+	if restoreTime, errRFC := time.Parse(time.RFC3339, restoreTimeStr); errRFC == nil {
+		return restoreTime, nil
+	}
+	// report the error of the human-readable layout
+	return restoreTime, err
+}
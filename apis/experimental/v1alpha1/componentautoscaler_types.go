@@ -0,0 +1,299 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentAutoscalerSpec defines the desired state of ComponentAutoscaler
+type ComponentAutoscalerSpec struct {
+	// Specified the target Cluster name this autoscaler applies to.
+	TargetClusterName string `json:"targetClusterName"`
+
+	// Specified the target Component name this autoscaler applies to, the shardings are not supported.
+	TargetComponentName string `json:"targetComponentName"`
+
+	// The lower limit for the number of replicas to which the autoscaler can scale down.
+	// It is further bounded by the ReplicasLimit of the ComponentDefinition.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+
+	// The upper limit for the number of replicas to which the autoscaler can scale up.
+	// It is further bounded by the ReplicasLimit of the ComponentDefinition.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Specifies the metrics used to calculate the desired number of replicas.
+	// The desired number of replicas is the maximum of the numbers calculated by each metric.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Metrics []MetricSpec `json:"metrics"`
+
+	// Configures the scaling behavior of the target in both up and down directions.
+	//
+	// +optional
+	Behavior *ComponentAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// MetricSourceType indicates the type of metric.
+//
+// +enum
+// +kubebuilder:validation:Enum={Resource,Pods}
+type MetricSourceType string
+
+const (
+	// ResourceMetricSourceType is a resource metric (such as cpu or memory) known to Kubernetes,
+	// as specified in requests and limits, describing each pod of the target component,
+	// which is served by the metrics.k8s.io API.
+	ResourceMetricSourceType MetricSourceType = "Resource"
+
+	// PodsMetricSourceType is a custom metric describing each pod of the target component (e.g. connections or
+	// replication lag exposed by lorry), which is served by the custom.metrics.k8s.io API.
+	PodsMetricSourceType MetricSourceType = "Pods"
+)
+
+// MetricSpec specifies a metric the autoscaler scales on.
+type MetricSpec struct {
+	// The type of metric source, it should be one of "Resource" or "Pods",
+	// and the corresponding field must be set.
+	Type MetricSourceType `json:"type"`
+
+	// Refers to a resource metric of each pod, such as cpu or memory.
+	//
+	// +optional
+	Resource *ResourceMetricSource `json:"resource,omitempty"`
+
+	// Refers to a custom metric of each pod.
+	//
+	// +optional
+	Pods *PodsMetricSource `json:"pods,omitempty"`
+}
+
+// ResourceMetricSource indicates how to scale on a resource metric of each pod.
+// Exactly one of TargetAverageUtilization and TargetAverageValue should be set.
+type ResourceMetricSource struct {
+	// The name of the resource, it should be one of "cpu" or "memory".
+	//
+	// +kubebuilder:validation:Enum={cpu,memory}
+	Name corev1.ResourceName `json:"name"`
+
+	// The target value of the average of the resource metric across all relevant pods,
+	// represented as a percentage of the requested value of the resource for the pods.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TargetAverageUtilization *int32 `json:"targetAverageUtilization,omitempty"`
+
+	// The target value of the average of the resource metric across all relevant pods, as a raw value.
+	//
+	// +optional
+	TargetAverageValue *resource.Quantity `json:"targetAverageValue,omitempty"`
+}
+
+// PodsMetricSource indicates how to scale on a custom metric of each pod.
+type PodsMetricSource struct {
+	// The name of the custom metric.
+	MetricName string `json:"metricName"`
+
+	// The target value of the average of the metric across all relevant pods.
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// ComponentAutoscalerBehavior configures the scaling behavior in both up and down directions.
+type ComponentAutoscalerBehavior struct {
+	// The scaling policy for scaling up.
+	// No stabilization is used for scaling up by default.
+	//
+	// +optional
+	ScaleUp *ScalingRules `json:"scaleUp,omitempty"`
+
+	// The scaling policy for scaling down.
+	// The replicas are stabilized over the past 300 seconds for scaling down by default.
+	//
+	// +optional
+	ScaleDown *ScalingRules `json:"scaleDown,omitempty"`
+}
+
+// ScalingRules configures the scaling behavior for one direction.
+type ScalingRules struct {
+	// The number of seconds for which past recommendations should be considered while scaling up or scaling down.
+	// For scaling up, the lowest recommendation within the window is used;
+	// for scaling down, the highest recommendation within the window is used.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+}
+
+// ComponentAutoscalerStatus defines the observed state of ComponentAutoscaler
+type ComponentAutoscalerStatus struct {
+	// The current number of replicas of the target component.
+	//
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+
+	// The desired number of replicas of the target component, as last calculated by the autoscaler.
+	//
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// The last read state of the metrics used by this autoscaler.
+	//
+	// +optional
+	CurrentMetrics []MetricStatus `json:"currentMetrics,omitempty"`
+
+	// The recommendations calculated within the stabilization windows.
+	//
+	// +optional
+	Recommendations []Recommendation `json:"recommendations,omitempty"`
+
+	// The name of the last HorizontalScaling OpsRequest created by this autoscaler.
+	//
+	// +optional
+	LastOpsRequestName string `json:"lastOpsRequestName,omitempty"`
+
+	// LastScaleTime is the last time the ComponentAutoscaler scaled the number of replicas.
+	//
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// Represents the latest available observations of a componentautoscaler's current state.
+	// Known .status.conditions.type are: "ScalingActive" and "AbleToScale".
+	// ScalingActive - The metrics can be fetched and the desired number of replicas can be calculated.
+	// AbleToScale - The autoscaler is able to create OpsRequest to scale the target component.
+	//
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// MetricStatus describes the last read state of a single metric.
+type MetricStatus struct {
+	// The type of metric source.
+	Type MetricSourceType `json:"type"`
+
+	// The name of the resource or the custom metric.
+	Name string `json:"name"`
+
+	// The current value of the average of the metric across all relevant pods.
+	//
+	// +optional
+	CurrentAverageValue *resource.Quantity `json:"currentAverageValue,omitempty"`
+
+	// The current value of the average of the resource metric across all relevant pods,
+	// represented as a percentage of the requested value of the resource for the pods.
+	// It is only set for the resource metrics with the TargetAverageUtilization.
+	//
+	// +optional
+	CurrentAverageUtilization *int32 `json:"currentAverageUtilization,omitempty"`
+}
+
+// Recommendation is a number of replicas recommended by the autoscaler at a point of time.
+type Recommendation struct {
+	// The time the recommendation is made.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// The recommended number of replicas.
+	Replicas int32 `json:"replicas"`
+}
+
+const (
+	// ScalingActive is added to a componentautoscaler when the metrics can be fetched
+	// and the desired number of replicas can be calculated.
+	ScalingActive ConditionType = "ScalingActive"
+
+	// AbleToScale is added to a componentautoscaler to indicate whether it's able to scale the target component.
+	AbleToScale ConditionType = "AbleToScale"
+)
+
+const (
+	// ReasonValidMetricFound is a reason for condition ScalingActive.
+	ReasonValidMetricFound = "ValidMetricFound"
+
+	// ReasonFailedGetMetrics is a reason for condition ScalingActive.
+	ReasonFailedGetMetrics = "FailedGetMetrics"
+
+	// ReasonInvalidBounds is a reason for condition ScalingActive, the min and max replicas don't intersect
+	// with the ReplicasLimit of the ComponentDefinition.
+	ReasonInvalidBounds = "InvalidBounds"
+
+	// ReasonTargetNotFound is a reason for condition ScalingActive.
+	ReasonTargetNotFound = "TargetNotFound"
+
+	// ReasonShardingNotSupported is a reason for condition ScalingActive, the target is a sharding of the cluster.
+	ReasonShardingNotSupported = "ShardingNotSupported"
+
+	// ReasonReadyForNewScale is a reason for condition AbleToScale.
+	ReasonReadyForNewScale = "ReadyForNewScale"
+
+	// ReasonOpsRequestCreated is a reason for condition AbleToScale.
+	ReasonOpsRequestCreated = "OpsRequestCreated"
+
+	// ReasonTargetNotReady is a reason for condition AbleToScale, the target cluster is not running.
+	ReasonTargetNotReady = "TargetNotReady"
+
+	// ReasonOpsRequestInProgress is a reason for condition AbleToScale, the OpsRequest created before is not finished.
+	ReasonOpsRequestInProgress = "OpsRequestInProgress"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={kubeblocks,all},shortName=cas
+// +kubebuilder:printcolumn:name="TARGET-CLUSTER-NAME",type="string",JSONPath=".spec.targetClusterName",description="target cluster name."
+// +kubebuilder:printcolumn:name="TARGET-COMPONENT-NAME",type="string",JSONPath=".spec.targetComponentName",description="target component name."
+// +kubebuilder:printcolumn:name="MIN",type="integer",JSONPath=".spec.minReplicas",description="min replicas."
+// +kubebuilder:printcolumn:name="MAX",type="integer",JSONPath=".spec.maxReplicas",description="max replicas."
+// +kubebuilder:printcolumn:name="CURRENT",type="integer",JSONPath=".status.currentReplicas",description="current replicas."
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.desiredReplicas",description="desired replicas."
+// +kubebuilder:printcolumn:name="LAST-SCALE-TIME",type="date",JSONPath=".status.lastScaleTime"
+
+// ComponentAutoscaler is the Schema for the componentautoscalers API
+type ComponentAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentAutoscalerSpec   `json:"spec,omitempty"`
+	Status ComponentAutoscalerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentAutoscalerList contains a list of ComponentAutoscaler
+type ComponentAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComponentAutoscaler{}, &ComponentAutoscalerList{})
+}
@@ -76,8 +76,26 @@ type ComponentStatus struct {
 	AvailableReplicas int32 `json:"availableReplicas"`
 
 	// The desired number of instances of this component.
-	// Usually, it should be the number of nodes.
+	// Usually, it should be the number of nodes the instances can be scheduled on,
+	// bounded by the ReplicasLimit of the ComponentDefinition.
 	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// The number of nodes the instances of this component can be scheduled on.
+	// The nodes are matched by the node selector, the required node affinity and the tolerations of the pod template,
+	// the cordoned and not ready nodes are excluded.
+	//
+	// +optional
+	MatchedNodes int32 `json:"matchedNodes,omitempty"`
+
+	// A brief CamelCase reason for how the desired number of instances is determined.
+	//
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// A human-readable message explaining the desired number of instances, e.g. the nodes excluded and why.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type ConditionType string
@@ -93,6 +111,16 @@ const (
 
 	// ReasonReady is a reason for condition ScaleReady.
 	ReasonReady = "Ready"
+
+	// ReasonNodesMatched is a reason for the component status, the desired replicas is the number of matched nodes.
+	ReasonNodesMatched = "NodesMatched"
+
+	// ReasonReplicasLimited is a reason for the component status, the desired replicas is bounded by
+	// the ReplicasLimit of the ComponentDefinition.
+	ReasonReplicasLimited = "ReplicasLimited"
+
+	// ReasonNoNodesMatched is a reason for the component status, no node is matched and the replicas are kept unchanged.
+	ReasonNoNodesMatched = "NoNodesMatched"
 )
 
 // +genclient
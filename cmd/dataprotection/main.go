@@ -295,6 +295,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&dpcontrollers.BackupVerificationReconciler{
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("backup-verification-controller"),
+		RestConfig: mgr.GetConfig(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BackupVerification")
+		os.Exit(1)
+	}
+
 	if err = (&dpcontrollers.VolumePopulatorReconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -129,6 +129,7 @@ func init() {
 	viper.SetDefault(constant.KBEnvLorryHTTPPort, 3501)
 	viper.SetDefault(constant.KBEnvLorryGRPCPort, 50001)
 	viper.SetDefault(constant.KBEnvLorryLogLevel, "info")
+	viper.SetDefault(constant.KBEnvLorrySecureAccessEnabled, false)
 	viper.SetDefault("KUBEBLOCKS_SERVICEACCOUNT_NAME", "kubeblocks")
 	viper.SetDefault(constant.ConfigManagerGPRCPortEnv, 9901)
 	viper.SetDefault("CONFIG_MANAGER_LOG_LEVEL", "info")
@@ -483,6 +484,13 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "BackupPolicyTemplate")
 			os.Exit(1)
 		}
+
+		if viper.GetBool("ENABLE_WEBHOOKS") {
+			if err = (&appsv1alpha1.OpsRequest{}).SetupWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "OpsRequest")
+				os.Exit(1)
+			}
+		}
 	}
 
 	if viper.GetBool(extensionsFlagKey.viperName()) {
@@ -517,6 +525,15 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "NodeCountScaler")
 			os.Exit(1)
 		}
+		if err = (&experimentalcontrollers.ComponentAutoscalerReconciler{
+			Client:     mgr.GetClient(),
+			Scheme:     mgr.GetScheme(),
+			Recorder:   mgr.GetEventRecorderFor("component-autoscaler-controller"),
+			RestConfig: mgr.GetConfig(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ComponentAutoscaler")
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -35,6 +35,7 @@ import (
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
 	"github.com/apecloud/kubeblocks/pkg/controller/multicluster"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
@@ -68,7 +69,7 @@ import (
 // dataprotection get list and delete
 // +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=backuppolicytemplates,verbs=get;list
 // +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backuppolicies,verbs=get;list;create;update;patch;delete;deletecollection
-// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;delete;deletecollection
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backups,verbs=get;list;create;delete;deletecollection
 
 // ClusterReconciler reconciles a Cluster object
 type ClusterReconciler struct {
@@ -76,6 +77,8 @@ type ClusterReconciler struct {
 	Scheme          *runtime.Scheme
 	Recorder        record.EventRecorder
 	MultiClusterMgr multicluster.Manager
+
+	lagProber *standbyLagProber
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -126,43 +129,14 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	//
 	// TODO: transformers are vertices, theirs' dependencies are edges, make plan Build stage a DAG.
 	plan, errBuild := planBuilder.
-		AddTransformer(
-			// handle cluster halt first
-			&clusterHaltTransformer{},
-			// handle cluster deletion
-			&clusterDeletionTransformer{},
-			// check is recovering from halted cluster
-			&clusterHaltRecoveryTransformer{},
-			// update finalizer and cd&cv labels
-			&clusterAssureMetaTransformer{},
-			// validate cd & cv's existence and availability
-			&clusterLoadRefResourcesTransformer{},
-			// normalize the cluster and component API
-			&ClusterAPINormalizationTransformer{},
-			// placement replicas across data-plane k8s clusters
-			&clusterPlacementTransformer{multiClusterMgr: r.MultiClusterMgr},
-			// handle cluster services
-			&clusterServiceTransformer{},
-			// handle the restore for cluster
-			&clusterRestoreTransformer{},
-			// create all cluster components objects
-			&clusterComponentTransformer{},
-			// update cluster components' status
-			&clusterComponentStatusTransformer{},
-			// create default cluster connection credential secret object
-			&clusterConnCredentialTransformer{},
-			// build backuppolicy and backupschedule from backupPolicyTemplate
-			&clusterBackupPolicyTransformer{},
-			// add our finalizer to all objects
-			&clusterOwnershipTransformer{},
-			// make all workload objects depending on credential secret
-			&clusterSecretTransformer{},
-			// update cluster status
-			&clusterStatusTransformer{},
-			// always safe to put your transformer below
-		).
+		AddTransformer(newClusterTransformers(r.MultiClusterMgr, r.lagProber)...).
 		Build()
 
+	// a dry-run cluster renders the plan for preview instead of executing it
+	if isDryRun(planBuilder.(*clusterPlanBuilder).transCtx.OrigCluster) {
+		return r.previewPlan(reqCtx, plan, errBuild)
+	}
+
 	// Execute stage
 	// errBuild not nil means build stage partial success or validation error
 	// execute the plan first, delay error handling
@@ -175,8 +149,64 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	return intctrlutil.Reconciled()
 }
 
+// previewPlan renders the cluster plan into a ConfigMap without executing it.
+func (r *ClusterReconciler) previewPlan(reqCtx intctrlutil.RequestCtx, plan graph.Plan, errBuild error) (ctrl.Result, error) {
+	dryRunPlan, err := previewClusterPlan(reqCtx, r.Client, plan, errBuild)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if err = writeDryRunPlan(reqCtx, r.Client, plan.(*clusterPlan).transCtx.OrigCluster, dryRunPlan); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// newClusterTransformers returns the transformers which build the cluster plan.
+func newClusterTransformers(multiClusterMgr multicluster.Manager, lagProber *standbyLagProber) []graph.Transformer {
+	return []graph.Transformer{
+		// handle cluster halt first
+		&clusterHaltTransformer{},
+		// take the final backup before deleting the cluster
+		&clusterFinalBackupTransformer{},
+		// handle cluster deletion
+		&clusterDeletionTransformer{},
+		// check is recovering from halted cluster
+		&clusterHaltRecoveryTransformer{},
+		// update finalizer and cd&cv labels
+		&clusterAssureMetaTransformer{},
+		// validate cd & cv's existence and availability
+		&clusterLoadRefResourcesTransformer{},
+		// normalize the cluster and component API
+		&ClusterAPINormalizationTransformer{},
+		// placement replicas across data-plane k8s clusters
+		&clusterPlacementTransformer{multiClusterMgr: multiClusterMgr},
+		// handle cluster services
+		&clusterServiceTransformer{},
+		// bootstrap and replicate the standby cluster from its primary cluster
+		&clusterStandbyTransformer{lagProber: lagProber},
+		// handle the restore for cluster
+		&clusterRestoreTransformer{},
+		// create all cluster components objects
+		&clusterComponentTransformer{},
+		// update cluster components' status
+		&clusterComponentStatusTransformer{},
+		// create default cluster connection credential secret object
+		&clusterConnCredentialTransformer{},
+		// build backuppolicy and backupschedule from backupPolicyTemplate
+		&clusterBackupPolicyTransformer{},
+		// add our finalizer to all objects
+		&clusterOwnershipTransformer{},
+		// make all workload objects depending on credential secret
+		&clusterSecretTransformer{},
+		// update cluster status
+		&clusterStatusTransformer{},
+		// always safe to put your transformer below
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.lagProber = newStandbyLagProber()
 	return intctrlutil.NewNamespacedControllerManagedBy(mgr).
 		For(&appsv1alpha1.Cluster{}).
 		WithOptions(controller.Options{
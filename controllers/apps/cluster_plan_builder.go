@@ -47,6 +47,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/controller/graph"
 	"github.com/apecloud/kubeblocks/pkg/controller/model"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/metrics"
 )
 
 const (
@@ -130,8 +131,13 @@ func init() {
 func (c *clusterPlanBuilder) Init() error {
 	cluster := &appsv1alpha1.Cluster{}
 	if err := c.cli.Get(c.transCtx.Context, c.req.NamespacedName, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.ForgetCluster(c.req.Namespace, c.req.Name)
+		}
 		return err
 	}
+	// record the observed phase on every reconciliation, so the phase metrics are rebuilt after a restart
+	metrics.RecordClusterPhase(cluster.Namespace, cluster.Name, cluster.Spec.ClusterDefRef, string(cluster.Status.Phase))
 	c.AddTransformer(&clusterInitTransformer{cluster: cluster})
 	return nil
 }
@@ -171,7 +177,7 @@ func (c *clusterPlanBuilder) Build() (graph.Plan, error) {
 
 	// new a DAG and apply chain on it
 	dag := graph.NewDAG()
-	err = c.transformers.ApplyTo(c.transCtx, dag)
+	err = newTimedTransformerChain(clusterControllerName, c.transformers).ApplyTo(c.transCtx, dag)
 	c.transCtx.Logger.V(1).Info(fmt.Sprintf("DAG: %s", dag))
 
 	// construct execution plan
@@ -340,6 +346,9 @@ func (c *clusterPlanBuilder) reconcileDeleteObject(ctx context.Context, node *mo
 		if err != nil && !apierrors.IsNotFound(err) {
 			return err
 		}
+		if cluster, ok := node.Obj.(*appsv1alpha1.Cluster); ok {
+			metrics.ForgetCluster(cluster.Namespace, cluster.Name)
+		}
 	}
 	backgroundDeleteObject := func() error {
 		deletePropagation := metav1.DeletePropagationBackground
@@ -371,6 +380,7 @@ func (c *clusterPlanBuilder) reconcileStatusObject(ctx context.Context, node *mo
 		oldCluster, _ := node.OriObj.(*appsv1alpha1.Cluster)
 		c.emitConditionUpdatingEvent(oldCluster.Status.Conditions, newCluster.Status.Conditions)
 		c.emitStatusUpdatingEvent(oldCluster.Status, newCluster.Status)
+		metrics.RecordClusterPhase(newCluster.Namespace, newCluster.Name, newCluster.Spec.ClusterDefRef, string(newCluster.Status.Phase))
 	}
 	return nil
 }
@@ -25,6 +25,7 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +42,7 @@ import (
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
 	"github.com/apecloud/kubeblocks/pkg/controller/multicluster"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
@@ -114,6 +116,8 @@ type ComponentReconciler struct {
 
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 //
@@ -146,51 +150,20 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
 	}
 
+	// a dry-run component renders the plan for preview instead of executing it,
+	// the transformers which write objects directly are given a dry-run client.
+	cli := r.Client
+	dryRun := isDryRun(planBuilder.(*componentPlanBuilder).transCtx.ComponentOrig)
+	if dryRun {
+		planBuilder.(*componentPlanBuilder).transCtx.DryRun = true
+		cli = client.NewDryRunClient(r.Client)
+	}
 	plan, errBuild := planBuilder.
-		AddTransformer(
-			// handle component deletion and pre-terminate
-			&componentDeletionTransformer{},
-			// handle finalizers and referenced definition labels
-			&componentMetaTransformer{},
-			// validate referenced componentDefinition objects, and build synthesized component
-			&componentLoadResourcesTransformer{},
-			// do validation for the spec & definition consistency
-			&componentValidationTransformer{},
-			// handle sidecar container
-			&componentMonitorContainerTransformer{},
-			// allocate ports for host-network component
-			&componentHostNetworkTransformer{},
-			// handle component services
-			&componentServiceTransformer{},
-			// handle component system accounts
-			&componentAccountTransformer{},
-			// provision component system accounts
-			&componentAccountProvisionTransformer{},
-			// handle tls volume and cert
-			&componentTLSTransformer{Client: r.Client},
-			// rerender parameters after v-scale and h-scale
-			&componentRelatedParametersTransformer{Client: r.Client},
-			// handle component custom volumes
-			&componentCustomVolumesTransformer{},
-			// resolve and build vars for template and Env
-			&componentVarsTransformer{},
-			// render component configurations
-			&componentConfigurationTransformer{Client: r.Client},
-			// handle restore before workloads transform
-			&componentRestoreTransformer{Client: r.Client},
-			// handle upgrade from the legacy RSM API to the InstanceSet API
-			&componentWorkloadUpgradeTransformer{},
-			// handle the component workload
-			&componentWorkloadTransformer{Client: r.Client},
-			// handle RBAC for component workloads
-			&componentRBACTransformer{},
-			// add our finalizer to all objects
-			&componentOwnershipTransformer{},
-			// handle component postProvision lifecycle action
-			&componentPostProvisionTransformer{},
-			// update component status
-			&componentStatusTransformer{Client: r.Client},
-		).Build()
+		AddTransformer(newComponentTransformers(cli)...).
+		Build()
+	if dryRun {
+		return r.previewPlan(reqCtx, plan, errBuild)
+	}
 
 	// Execute stage
 	// errBuild not nil means build stage partial success or validation error
@@ -204,6 +177,74 @@ func (r *ComponentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	return intctrlutil.Reconciled()
 }
 
+// previewPlan renders the component plan into a ConfigMap without executing it.
+func (r *ComponentReconciler) previewPlan(reqCtx intctrlutil.RequestCtx, plan graph.Plan, errBuild error) (ctrl.Result, error) {
+	p := plan.(*componentPlan)
+	dryRunPlan, err := renderDryRunPlan(reqCtx.Ctx, r.Client, p.dag, errBuild)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if err = writeDryRunPlan(reqCtx, r.Client, p.transCtx.ComponentOrig, dryRunPlan); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// newComponentTransformers returns the transformers which build the component plan,
+// cli is used by the transformers which write objects directly.
+func newComponentTransformers(cli client.Client) []graph.Transformer {
+	return []graph.Transformer{
+		// handle component deletion and pre-terminate
+		&componentDeletionTransformer{},
+		// handle finalizers and referenced definition labels
+		&componentMetaTransformer{},
+		// validate referenced componentDefinition objects, and build synthesized component
+		&componentLoadResourcesTransformer{},
+		// do validation for the spec & definition consistency
+		&componentValidationTransformer{},
+		// handle sidecar container
+		&componentMonitorContainerTransformer{},
+		// allocate ports for host-network component
+		&componentHostNetworkTransformer{},
+		// handle component services
+		&componentServiceTransformer{},
+		// handle component system accounts
+		&componentAccountTransformer{},
+		// provision component system accounts
+		&componentAccountProvisionTransformer{},
+		// handle tls volume and cert
+		&componentTLSTransformer{Client: cli},
+		// issue the certificates and token to access lorry
+		&componentLorrySecureAccessTransformer{},
+		// rerender parameters after v-scale and h-scale
+		&componentRelatedParametersTransformer{Client: cli},
+		// handle component custom volumes
+		&componentCustomVolumesTransformer{},
+		// resolve and build vars for template and Env
+		&componentVarsTransformer{},
+		// rotate passwords of system accounts and restart the pods consuming them
+		&componentAccountRotationTransformer{},
+		// render component configurations
+		&componentConfigurationTransformer{Client: cli},
+		// handle restore before workloads transform
+		&componentRestoreTransformer{Client: cli},
+		// handle upgrade from the legacy RSM API to the InstanceSet API
+		&componentWorkloadUpgradeTransformer{},
+		// handle the component workload
+		&componentWorkloadTransformer{Client: cli},
+		// maintain the pod disruption budget of the component workload
+		&componentPDBTransformer{},
+		// handle RBAC for component workloads
+		&componentRBACTransformer{},
+		// add our finalizer to all objects
+		&componentOwnershipTransformer{},
+		// handle component postProvision lifecycle action
+		&componentPostProvisionTransformer{},
+		// update component status
+		&componentStatusTransformer{Client: cli},
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ComponentReconciler) SetupWithManager(mgr ctrl.Manager, multiClusterMgr multicluster.Manager) error {
 	retryDurationMS := viper.GetInt(constant.CfgKeyCtrlrReconcileRetryDurationMS)
@@ -226,10 +267,12 @@ func (r *ComponentReconciler) setupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.Service{}).
 		Owns(&corev1.Secret{}).
 		Owns(&corev1.ConfigMap{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
 		Owns(&dpv1alpha1.Backup{}).
 		Owns(&dpv1alpha1.Restore{}).
 		Watches(&corev1.PersistentVolumeClaim{}, handler.EnqueueRequestsFromMapFunc(r.filterComponentResources)).
 		Owns(&batchv1.Job{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.accountSecretEventHandler)).
 		Watches(&appsv1alpha1.Configuration{}, handler.EnqueueRequestsFromMapFunc(r.configurationEventHandler))
 
 	if viper.GetBool(constant.EnableRBACManager) {
@@ -254,6 +297,7 @@ func (r *ComponentReconciler) setupWithMultiClusterManager(mgr ctrl.Manager, mul
 		Owns(&workloads.InstanceSet{}).
 		Owns(&dpv1alpha1.Backup{}).
 		Owns(&dpv1alpha1.Restore{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.accountSecretEventHandler)).
 		Watches(&appsv1alpha1.Configuration{}, handler.EnqueueRequestsFromMapFunc(r.configurationEventHandler))
 
 	eventHandler := handler.EnqueueRequestsFromMapFunc(r.filterComponentResources)
@@ -261,6 +305,7 @@ func (r *ComponentReconciler) setupWithMultiClusterManager(mgr ctrl.Manager, mul
 		Watch(b, &corev1.Secret{}, eventHandler).
 		Watch(b, &corev1.ConfigMap{}, eventHandler).
 		Watch(b, &corev1.PersistentVolumeClaim{}, eventHandler).
+		Watch(b, &policyv1.PodDisruptionBudget{}, eventHandler).
 		Watch(b, &batchv1.Job{}, eventHandler).
 		Watch(b, &corev1.ServiceAccount{}, eventHandler).
 		Watch(b, &rbacv1.RoleBinding{}, eventHandler).
@@ -291,6 +336,23 @@ func (r *ComponentReconciler) filterComponentResources(ctx context.Context, obj
 	}
 }
 
+// accountSecretEventHandler enqueues all components in the namespace when an account secret changes,
+// the components consuming it are restarted once its password is rotated.
+func (r *ComponentReconciler) accountSecretEventHandler(ctx context.Context, obj client.Object) []reconcile.Request {
+	if _, ok := obj.GetLabels()[constant.ClusterAccountLabelKey]; !ok {
+		return []reconcile.Request{}
+	}
+	compList := &appsv1alpha1.ComponentList{}
+	if err := r.Client.List(ctx, compList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return []reconcile.Request{}
+	}
+	requests := make([]reconcile.Request, 0, len(compList.Items))
+	for _, comp := range compList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&comp)})
+	}
+	return requests
+}
+
 func (r *ComponentReconciler) configurationEventHandler(_ context.Context, obj client.Object) []reconcile.Request {
 	cr, ok := obj.(*appsv1alpha1.Configuration)
 	if !ok {
@@ -37,6 +37,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/controller/instanceset"
 	"github.com/apecloud/kubeblocks/pkg/controller/model"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/metrics"
 )
 
 // componentTransformContext a graph.TransformContext implementation for Component reconciliation
@@ -52,6 +53,8 @@ type componentTransformContext struct {
 	SynthesizeComponent *component.SynthesizedComponent
 	RunningWorkload     client.Object
 	ProtoWorkload       client.Object
+	// DryRun indicates the plan is built for preview only, the side effects out of the plan should be skipped.
+	DryRun bool
 }
 
 func (c *componentTransformContext) GetContext() context.Context {
@@ -92,8 +95,13 @@ var _ graph.Plan = &componentPlan{}
 func (c *componentPlanBuilder) Init() error {
 	comp := &appsv1alpha1.Component{}
 	if err := c.cli.Get(c.transCtx.Context, c.req.NamespacedName, comp); err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.ForgetComponent(c.req.Namespace, c.req.Name)
+		}
 		return err
 	}
+	// record the observed phase on every reconciliation, so the phase metrics are rebuilt after a restart
+	metrics.RecordComponentPhase(comp.Namespace, comp.Name, comp.Spec.CompDef, string(comp.Status.Phase))
 
 	c.transCtx.Component = comp
 	c.transCtx.ComponentOrig = comp.DeepCopy()
@@ -114,7 +122,7 @@ func (c *componentPlanBuilder) AddParallelTransformer(transformer ...graph.Trans
 // Build runs all transformers to generate a plan
 func (c *componentPlanBuilder) Build() (graph.Plan, error) {
 	dag := graph.NewDAG()
-	err := c.transformers.ApplyTo(c.transCtx, dag)
+	err := newTimedTransformerChain(componentControllerName, c.transformers).ApplyTo(c.transCtx, dag)
 	if err != nil {
 		c.transCtx.Logger.V(1).Info(fmt.Sprintf("build error: %s", err.Error()))
 	}
@@ -240,6 +248,9 @@ func (c *componentPlanBuilder) reconcileDeleteObject(ctx context.Context, vertex
 			}
 		}
 	}
+	if comp, ok := vertex.Obj.(*appsv1alpha1.Component); ok {
+		metrics.ForgetComponent(comp.Namespace, comp.Name)
+	}
 
 	if !model.IsObjectDeleting(vertex.Obj) {
 		var opts []client.DeleteOption
@@ -256,5 +267,11 @@ func (c *componentPlanBuilder) reconcileDeleteObject(ctx context.Context, vertex
 }
 
 func (c *componentPlanBuilder) reconcileStatusObject(ctx context.Context, vertex *model.ObjectVertex) error {
-	return c.cli.Status().Update(ctx, vertex.Obj, clientOption(vertex))
+	if err := c.cli.Status().Update(ctx, vertex.Obj, clientOption(vertex)); err != nil {
+		return err
+	}
+	if comp, ok := vertex.Obj.(*appsv1alpha1.Component); ok {
+		metrics.RecordComponentPhase(comp.Namespace, comp.Name, comp.Spec.CompDef, string(comp.Status.Phase))
+	}
+	return nil
 }
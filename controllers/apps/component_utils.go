@@ -20,12 +20,14 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package apps
 
 import (
+	"maps"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
 	"github.com/apecloud/kubeblocks/pkg/controllerutil"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
@@ -113,3 +115,14 @@ func getImageName(image string) string {
 		return ""
 	}
 }
+
+// setPodTemplateAnnotation sets an annotation to the pod template of the component workload.
+func setPodTemplateAnnotation(synthesizedComp *component.SynthesizedComponent, key, value string) {
+	// the user-defined annotations are shared with the component spec, don't modify them in place.
+	annotations := maps.Clone(synthesizedComp.UserDefinedAnnotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	synthesizedComp.UserDefinedAnnotations = annotations
+}
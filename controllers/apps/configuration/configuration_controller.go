@@ -177,6 +177,9 @@ func (r *ConfigurationReconciler) runTasks(taskCtx TaskContext, tasks []Task) (e
 			errs = append(errs, err)
 			continue
 		}
+		if item := configuration.Spec.GetConfigurationItem(task.Name); item != nil {
+			recordRevisionHistory(configuration, *item, task.Status, revision)
+		}
 	}
 
 	configuration.Status.Message = ""
@@ -21,15 +21,18 @@ package configuration
 
 import (
 	"encoding/json"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/configuration/core"
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	configctrl "github.com/apecloud/kubeblocks/pkg/controller/configuration"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
@@ -126,3 +129,92 @@ func GetCurrentRevision(annotations map[string]string) string {
 	}
 	return annotations[constant.ConfigurationRevision]
 }
+
+// recordRevisionHistory appends the parameter set merged in the revision to the revision history of the configuration item.
+func recordRevisionHistory(configuration *appsv1alpha1.Configuration,
+	item appsv1alpha1.ConfigurationItemDetail,
+	status *appsv1alpha1.ConfigurationItemDetailStatus,
+	revision string) {
+	if status.Phase != appsv1alpha1.CMergedPhase || status.UpdateRevision != revision {
+		return
+	}
+
+	var lastParams map[string]appsv1alpha1.ConfigParams
+	if len(status.RevisionHistory) > 0 {
+		last := status.RevisionHistory[len(status.RevisionHistory)-1]
+		if last.Revision == revision || equalConfigFileParams(last.ConfigFileParams, item.ConfigFileParams) {
+			return
+		}
+		lastParams = last.ConfigFileParams
+	}
+
+	history := appsv1alpha1.ConfigurationRevisionHistory{
+		Revision:    revision,
+		AppliedTime: metav1.Now(),
+		Changes:     diffConfigFileParams(lastParams, item.ConfigFileParams),
+	}
+	if len(item.ConfigFileParams) > 0 {
+		history.ConfigFileParams = make(map[string]appsv1alpha1.ConfigParams, len(item.ConfigFileParams))
+		for key, params := range item.ConfigFileParams {
+			history.ConfigFileParams[key] = *params.DeepCopy()
+		}
+	}
+	if source := configctrl.GetRevisionSource(configuration, item.Name, revision); source != nil {
+		history.OpsRequest = source.OpsRequest
+		history.Operator = source.Operator
+	}
+	status.RevisionHistory = append(status.RevisionHistory, history)
+	if len(status.RevisionHistory) > revisionHistoryLimit {
+		status.RevisionHistory = status.RevisionHistory[len(status.RevisionHistory)-revisionHistoryLimit:]
+	}
+}
+
+func equalConfigFileParams(x, y map[string]appsv1alpha1.ConfigParams) bool {
+	if len(x) == 0 && len(y) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func diffConfigFileParams(oldParams, newParams map[string]appsv1alpha1.ConfigParams) []appsv1alpha1.ConfigurationParameterChange {
+	var changes []appsv1alpha1.ConfigurationParameterChange
+	for _, key := range sortedUnionKeys(oldParams, newParams) {
+		oldParam, newParam := oldParams[key], newParams[key]
+		if !reflect.DeepEqual(oldParam.Content, newParam.Content) {
+			changes = append(changes, appsv1alpha1.ConfigurationParameterChange{Key: key})
+		}
+		for _, name := range sortedUnionKeys(oldParam.Parameters, newParam.Parameters) {
+			oldValue, oldExists := oldParam.Parameters[name]
+			newValue, newExists := newParam.Parameters[name]
+			if oldExists && newExists && reflect.DeepEqual(oldValue, newValue) {
+				continue
+			}
+			change := appsv1alpha1.ConfigurationParameterChange{
+				Key:       key,
+				Parameter: name,
+			}
+			if oldExists {
+				change.OldValue = oldValue
+			}
+			if newExists {
+				change.NewValue = newValue
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+func sortedUnionKeys[T any](x, y map[string]T) []string {
+	keys := make([]string, 0, len(x)+len(y))
+	for key := range x {
+		keys = append(keys, key)
+	}
+	for key := range y {
+		if _, ok := x[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
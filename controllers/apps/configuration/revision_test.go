@@ -21,14 +21,17 @@ package configuration
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/configuration/core"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	configctrl "github.com/apecloud/kubeblocks/pkg/controller/configuration"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
@@ -205,3 +208,64 @@ func TestGetLastRevision(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordRevisionHistory(t *testing.T) {
+	newItem := func(maxConnections string) appsv1alpha1.ConfigurationItemDetail {
+		return appsv1alpha1.ConfigurationItemDetail{
+			Name: "mysql-config",
+			ConfigFileParams: map[string]appsv1alpha1.ConfigParams{
+				"my.cnf": {
+					Parameters: map[string]*string{
+						"max_connections": &maxConnections,
+					},
+				},
+			},
+		}
+	}
+	configuration := &appsv1alpha1.Configuration{}
+	status := &appsv1alpha1.ConfigurationItemDetailStatus{
+		Name:           "mysql-config",
+		Phase:          appsv1alpha1.CMergedPhase,
+		UpdateRevision: "1",
+	}
+
+	recordRevisionHistory(configuration, appsv1alpha1.ConfigurationItemDetail{Name: "mysql-config"}, status, "1")
+	assert.Equal(t, 1, len(status.RevisionHistory))
+	assert.Equal(t, 0, len(status.RevisionHistory[0].Changes))
+
+	configuration.Generation = 1
+	assert.Nil(t, configctrl.SetRevisionSource(configuration, "mysql-config", &appsv1alpha1.OpsRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "reconfigure-ops"},
+	}))
+	status.UpdateRevision = "2"
+	recordRevisionHistory(configuration, newItem("1000"), status, "2")
+	assert.Equal(t, 2, len(status.RevisionHistory))
+	history := status.RevisionHistory[1]
+	assert.Equal(t, "2", history.Revision)
+	assert.Equal(t, "reconfigure-ops", history.OpsRequest)
+	assert.Equal(t, 1, len(history.Changes))
+	assert.Equal(t, "max_connections", history.Changes[0].Parameter)
+	assert.Nil(t, history.Changes[0].OldValue)
+	assert.Equal(t, "1000", *history.Changes[0].NewValue)
+
+	// the same revision and unchanged parameters are not recorded again
+	recordRevisionHistory(configuration, newItem("1000"), status, "2")
+	status.UpdateRevision = "3"
+	recordRevisionHistory(configuration, newItem("1000"), status, "3")
+	assert.Equal(t, 2, len(status.RevisionHistory))
+
+	// the revision not made by the OpsRequest
+	recordRevisionHistory(configuration, newItem("2000"), status, "3")
+	assert.Equal(t, 3, len(status.RevisionHistory))
+	history = status.RevisionHistory[2]
+	assert.Equal(t, "", history.OpsRequest)
+	assert.Equal(t, "1000", *history.Changes[0].OldValue)
+	assert.Equal(t, "2000", *history.Changes[0].NewValue)
+
+	for i := 4; i < 20; i++ {
+		status.UpdateRevision = strconv.Itoa(i)
+		recordRevisionHistory(configuration, newItem(strconv.Itoa(i)), status, status.UpdateRevision)
+	}
+	assert.Equal(t, revisionHistoryLimit, len(status.RevisionHistory))
+	assert.Equal(t, "19", status.RevisionHistory[revisionHistoryLimit-1].Revision)
+}
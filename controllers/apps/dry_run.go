@@ -0,0 +1,337 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/controllers/apps/operations"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+const (
+	dryRunPlanSuffix     = "-dry-run-plan"
+	dryRunPlanSummaryKey = "plan.yaml"
+	dryRunPlanDiffKey    = "plan.diff"
+	// the size of a ConfigMap is limited to 1MiB, the diff beyond the limit is truncated.
+	maxDryRunPlanDiffSize = 512 * 1024
+
+	reasonDryRunPlanRendered = "DryRunPlanRendered"
+)
+
+// dryRunPlan is the preview of the changes a reconciliation would make.
+type dryRunPlan struct {
+	Changes []model.ObjectChange `json:"changes,omitempty"`
+	// Errors stop the building of the plan, the changes are incomplete if any.
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (p *dryRunPlan) merge(other *dryRunPlan) {
+	p.Changes = append(p.Changes, other.Changes...)
+	p.Errors = append(p.Errors, other.Errors...)
+	model.SortObjectChanges(p.Changes)
+}
+
+func (p *dryRunPlan) exclude(kind, name string) {
+	changes := make([]model.ObjectChange, 0, len(p.Changes))
+	for _, change := range p.Changes {
+		if change.Kind != kind || change.Name != name {
+			changes = append(changes, change)
+		}
+	}
+	p.Changes = changes
+}
+
+// isDryRun checks if the object asks to preview the reconciliation plan instead of executing it.
+// An object being deleted is always reconciled, otherwise its finalizer is never removed.
+func isDryRun(obj client.Object) bool {
+	if obj == nil || !obj.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+	return strings.EqualFold(obj.GetAnnotations()[constant.DryRunAnnotationKey], "true")
+}
+
+// renderDryRunPlan renders the changes of the DAG, errBuild is recorded in the plan if it's not a requeue error.
+func renderDryRunPlan(ctx context.Context, cli client.Reader, dag *graph.DAG, errBuild error) (*dryRunPlan, error) {
+	changes, err := model.PreviewPlan(ctx, cli, dag)
+	if err != nil {
+		return nil, err
+	}
+	plan := &dryRunPlan{Changes: changes}
+	if errBuild != nil {
+		plan.Errors = append(plan.Errors, errBuild.Error())
+	}
+	return plan, nil
+}
+
+// previewClusterPlan renders the cluster plan, as well as the plans of the components to be created or updated,
+// so that the changes of the workloads, services, secrets and PVCs are visible too.
+func previewClusterPlan(reqCtx intctrlutil.RequestCtx, cli client.Client, plan graph.Plan, errBuild error) (*dryRunPlan, error) {
+	p, ok := plan.(*clusterPlan)
+	if !ok {
+		return nil, fmt.Errorf("unexpected plan type %T", plan)
+	}
+	dryRunPlan, err := renderDryRunPlan(reqCtx.Ctx, cli, p.dag, errBuild)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range p.dag.Vertices() {
+		vertex, _ := v.(*model.ObjectVertex)
+		comp, ok := vertex.Obj.(*appsv1alpha1.Component)
+		if !ok || vertex.Action == nil || model.IsObjectDeleting(comp) {
+			continue
+		}
+		if action := *vertex.Action; action != model.CREATE && action != model.UPDATE && action != model.PATCH {
+			continue
+		}
+		compPlan, err := previewComponentPlan(reqCtx, cli, comp)
+		if err != nil {
+			return nil, err
+		}
+		dryRunPlan.merge(compPlan)
+	}
+	return dryRunPlan, nil
+}
+
+// previewClusterPlanFor builds and renders the plan of the cluster in memory.
+func previewClusterPlanFor(reqCtx intctrlutil.RequestCtx, cli client.Client, cluster *appsv1alpha1.Cluster) (*dryRunPlan, error) {
+	plan, errBuild := newClusterPlanBuilder(reqCtx, cli).
+		AddTransformer(&clusterInitTransformer{cluster: cluster}).
+		AddTransformer(newClusterTransformers(nil, nil)...).
+		Build()
+	return previewClusterPlan(reqCtx, cli, plan, errBuild)
+}
+
+// previewComponentPlan builds and renders the plan of the expected component in memory.
+func previewComponentPlan(reqCtx intctrlutil.RequestCtx, cli client.Client, comp *appsv1alpha1.Component) (*dryRunPlan, error) {
+	comp = comp.DeepCopy()
+	// the finalizer and the definition label are added by the component controller at its first reconciliation,
+	// set them in advance, otherwise the plan stops prematurely.
+	if comp.Labels == nil {
+		comp.Labels = map[string]string{}
+	}
+	comp.Labels[constant.ComponentDefinitionLabelKey] = comp.Spec.CompDef
+	controllerutil.AddFinalizer(comp, constant.DBComponentFinalizerName)
+
+	dryRunCli := client.NewDryRunClient(cli)
+	planBuilder := newComponentPlanBuilder(reqCtx, dryRunCli).(*componentPlanBuilder)
+	planBuilder.transCtx.DryRun = true
+	planBuilder.transCtx.Component = comp
+	planBuilder.transCtx.ComponentOrig = comp.DeepCopy()
+	plan, errBuild := planBuilder.
+		AddTransformer(&componentInitTransformer{}).
+		AddTransformer(newComponentTransformers(dryRunCli)...).
+		Build()
+	dryRunPlan, err := renderDryRunPlan(reqCtx.Ctx, cli, plan.(*componentPlan).dag, errBuild)
+	if err != nil {
+		return nil, err
+	}
+	// the changes of the component itself are rendered by the cluster plan
+	dryRunPlan.exclude(appsv1alpha1.ComponentKind, comp.Name)
+	for i, msg := range dryRunPlan.Errors {
+		dryRunPlan.Errors[i] = fmt.Sprintf("component %s: %s", comp.Name, msg)
+	}
+	return dryRunPlan, nil
+}
+
+// previewOpsRequestPlan performs the OpsRequest with a dry-run client, and renders the objects it writes.
+// If the cluster is updated by the OpsRequest, the plan of the updated cluster is rendered as well.
+func previewOpsRequestPlan(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *operations.OpsResource) (*dryRunPlan, error) {
+	recordCli := newDryRunRecordClient(cli)
+	dryRunRes := &operations.OpsResource{
+		OpsRequest: opsRes.OpsRequest.DeepCopy(),
+		Cluster:    opsRes.Cluster.DeepCopy(),
+		// the events of the dry-run are discarded
+		Recorder: &record.FakeRecorder{},
+	}
+	err := operations.GetOpsManager().DryRun(reqCtx, recordCli, dryRunRes)
+	if err != nil && !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) &&
+		!intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeNeedWaiting) {
+		return nil, err
+	}
+	dryRunPlan, errRender := renderDryRunPlan(reqCtx.Ctx, cli, recordCli.dag, err)
+	if errRender != nil {
+		return nil, errRender
+	}
+	if err != nil || !recordCli.written(dryRunRes.Cluster) {
+		return dryRunPlan, nil
+	}
+	clusterPlan, err := previewClusterPlanFor(reqCtx, cli, dryRunRes.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	// the changes of the cluster are rendered from the OpsRequest
+	clusterPlan.exclude(appsv1alpha1.ClusterKind, dryRunRes.Cluster.Name)
+	dryRunPlan.merge(clusterPlan)
+	return dryRunPlan, nil
+}
+
+// writeDryRunPlan saves the plan into the ConfigMap owned by the object.
+func writeDryRunPlan(reqCtx intctrlutil.RequestCtx, cli client.Client, owner client.Object, plan *dryRunPlan) error {
+	summary, err := yaml.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	var diff strings.Builder
+	for _, change := range plan.Changes {
+		diff.WriteString(change.Diff)
+	}
+	diffStr := diff.String()
+	if len(diffStr) > maxDryRunPlanDiffSize {
+		diffStr = diffStr[:maxDryRunPlanDiffSize] + "\n... (truncated)\n"
+	}
+	data := map[string]string{
+		dryRunPlanSummaryKey: string(summary),
+		dryRunPlanDiffKey:    diffStr,
+	}
+
+	cm := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Namespace: owner.GetNamespace(), Name: owner.GetName() + dryRunPlanSuffix}
+	if err = cli.Get(reqCtx.Ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = builder.NewConfigMapBuilder(cmKey.Namespace, cmKey.Name).
+			AddLabels(constant.AppManagedByLabelKey, constant.AppName).
+			SetData(data).
+			GetObject()
+		if err = intctrlutil.SetOwnerReference(owner, cm); err != nil {
+			return err
+		}
+		if err = cli.Create(reqCtx.Ctx, cm); err != nil {
+			return err
+		}
+	} else {
+		if reflect.DeepEqual(cm.Data, data) {
+			return nil
+		}
+		cm.Data = data
+		if err = cli.Update(reqCtx.Ctx, cm); err != nil {
+			return err
+		}
+	}
+	reqCtx.Recorder.Eventf(owner, corev1.EventTypeNormal, reasonDryRunPlanRendered,
+		"the plan is rendered into ConfigMap %s with %d changes", cmKey.Name, len(plan.Changes))
+	return nil
+}
+
+// dryRunRecordClient is a dry-run client which records the objects written through it.
+type dryRunRecordClient struct {
+	client.Client
+	dag *graph.DAG
+}
+
+var _ client.Client = &dryRunRecordClient{}
+
+func newDryRunRecordClient(cli client.Client) *dryRunRecordClient {
+	return &dryRunRecordClient{
+		Client: client.NewDryRunClient(cli),
+		dag:    graph.NewDAG(),
+	}
+}
+
+func (c *dryRunRecordClient) record(obj client.Object, action *model.Action) {
+	objCopy, _ := obj.DeepCopyObject().(client.Object)
+	c.dag.AddVertex(&model.ObjectVertex{Obj: objCopy, Action: action})
+}
+
+func (c *dryRunRecordClient) written(obj client.Object) bool {
+	for _, v := range c.dag.Vertices() {
+		vertex, _ := v.(*model.ObjectVertex)
+		if reflect.TypeOf(vertex.Obj) == reflect.TypeOf(obj) && client.ObjectKeyFromObject(vertex.Obj) == client.ObjectKeyFromObject(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *dryRunRecordClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, model.ActionCreatePtr())
+	return nil
+}
+
+func (c *dryRunRecordClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, model.ActionUpdatePtr())
+	return nil
+}
+
+func (c *dryRunRecordClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	c.record(obj, model.ActionPatchPtr())
+	return nil
+}
+
+func (c *dryRunRecordClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, model.ActionDeletePtr())
+	return nil
+}
+
+func (c *dryRunRecordClient) Status() client.SubResourceWriter {
+	return &dryRunRecordStatusWriter{SubResourceWriter: c.Client.Status(), cli: c}
+}
+
+// dryRunRecordStatusWriter records the objects whose status are written through it.
+type dryRunRecordStatusWriter struct {
+	client.SubResourceWriter
+	cli *dryRunRecordClient
+}
+
+func (w *dryRunRecordStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if err := w.SubResourceWriter.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	w.cli.record(obj, model.ActionStatusPtr())
+	return nil
+}
+
+func (w *dryRunRecordStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if err := w.SubResourceWriter.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	w.cli.record(obj, model.ActionStatusPtr())
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
+	"github.com/apecloud/kubeblocks/pkg/configuration/core"
+	configctrl "github.com/apecloud/kubeblocks/pkg/controller/configuration"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+// configurationRollbackAction rolls the configurations back to the revisions recorded in the revision history,
+// the restored parameters are applied through the reconfigure policies in the same way as the Reconfiguring OpsRequest.
+type configurationRollbackAction struct {
+	reconfigureAction
+}
+
+func init() {
+	rollbackAction := configurationRollbackAction{}
+	opsManager := GetOpsManager()
+	rollbackBehaviour := OpsBehaviour{
+		FromClusterPhases: appsv1alpha1.GetReconfiguringRunningPhases(),
+		ToClusterPhase:    appsv1alpha1.UpdatingClusterPhase,
+		QueueByCluster:    true,
+		Disruptive:        true,
+		IsDisruptive:      isConfigurationRollbackDisruptive,
+		OpsHandler:        &rollbackAction,
+	}
+	opsManager.RegisterOps(appsv1alpha1.ConfigurationRollbackType, rollbackBehaviour)
+}
+
+// ActionStartedCondition the started condition when handling the configurationRollback request.
+func (r *configurationRollbackAction) ActionStartedCondition(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (*metav1.Condition, error) {
+	return appsv1alpha1.NewConfigurationRollbackCondition(opsRes.OpsRequest), nil
+}
+
+// Action restores the parameters of the configuration items to the ones of the revisions.
+func (r *configurationRollbackAction) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource) error {
+	rollbackList := resource.OpsRequest.Spec.ConfigurationRollbackList
+	for i, params := range fromConfigurationRollbacks(reqCtx, cli, resource) {
+		if err := r.doRollback(params, rollbackList[i].Revision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileAction syncs the progress of the reconfiguring triggered by the restored parameters.
+func (r *configurationRollbackAction) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource) (appsv1alpha1.OpsPhase, time.Duration, error) {
+	return r.syncReconfigureStatus(reqCtx, cli, resource, fromConfigurationRollbacks(reqCtx, cli, resource))
+}
+
+func (r *configurationRollbackAction) doRollback(params reconfigureParams, revision string) error {
+	configuration := &appsv1alpha1.Configuration{}
+	configKey := client.ObjectKey{
+		Namespace: params.resource.Cluster.Namespace,
+		Name:      core.GenerateComponentConfigurationName(params.clusterName, params.componentName),
+	}
+	if err := params.cli.Get(params.reqCtx.Ctx, configKey, configuration); err != nil {
+		return err
+	}
+	configName := params.configurationItem.Name
+	item := configuration.Spec.GetConfigurationItem(configName)
+	itemStatus := configuration.Status.GetItemStatus(configName)
+	if item == nil || itemStatus == nil {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`configuration "%s" of component "%s" is not found`, configName, params.componentName))
+	}
+	history := itemStatus.GetRevisionHistory(revision)
+	if history == nil {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`revision "%s" is not found in the revision history of configuration "%s" of component "%s"`,
+			revision, configName, params.componentName))
+	}
+
+	if len(item.ConfigFileParams) != 0 || len(history.ConfigFileParams) != 0 {
+		if !reflect.DeepEqual(item.ConfigFileParams, history.ConfigFileParams) {
+			patch := client.MergeFromWithOptions(configuration.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			item.ConfigFileParams = make(map[string]appsv1alpha1.ConfigParams, len(history.ConfigFileParams))
+			for key, configParams := range history.ConfigFileParams {
+				item.ConfigFileParams[key] = *configParams.DeepCopy()
+			}
+			if err := configctrl.SetRevisionSource(configuration, configName, params.opsRequest); err != nil {
+				return err
+			}
+			if err := params.cli.Patch(params.reqCtx.Ctx, configuration, patch); err != nil {
+				return err
+			}
+		}
+	}
+
+	if params.reqCtx.Recorder != nil {
+		params.reqCtx.Recorder.Eventf(params.opsRequest, corev1.EventTypeNormal, appsv1alpha1.ReasonReconfigurePersisted,
+			"the configuration %s of component[%s] in cluster[%s] is rolled back to revision %s",
+			configName, params.componentName, params.clusterName, revision)
+	}
+	return updateReconfigureStatusByCM(params.configurationStatus, configName, handleNewReconfigureRequest(nil, nil))
+}
+
+// isConfigurationRollbackDisruptive checks whether restoring any of the revisions requires restarting the pods,
+// the restored parameters are compared with the current ones in the same way as the Reconfiguring OpsRequest.
+func isConfigurationRollbackDisruptive(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (bool, error) {
+	for _, rollback := range opsRes.OpsRequest.Spec.ConfigurationRollbackList {
+		fetcher := configctrl.NewResourceFetcher(&configctrl.ResourceCtx{
+			Context:       reqCtx.Ctx,
+			Client:        cli,
+			Namespace:     opsRes.Cluster.Namespace,
+			ClusterName:   opsRes.Cluster.Name,
+			ComponentName: rollback.ComponentName,
+		})
+		if err := fetcher.Configuration().Complete(); err != nil {
+			return false, err
+		}
+		item := fetcher.ConfigurationObj.Spec.GetConfigurationItem(rollback.ConfigurationName)
+		itemStatus := fetcher.ConfigurationObj.Status.GetItemStatus(rollback.ConfigurationName)
+		if item == nil || itemStatus == nil {
+			// the rollback fails in the action.
+			continue
+		}
+		history := itemStatus.GetRevisionHistory(rollback.Revision)
+		if history == nil {
+			continue
+		}
+		var cc *appsv1beta1.ConfigConstraintSpec
+		if ccName := getConfigConstraintName(fetcher.ConfigurationObj, rollback.ConfigurationName); ccName != "" {
+			if err := fetcher.ConfigConstraints(ccName).Complete(); client.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+			if fetcher.ConfigConstraintObj != nil {
+				cc = &fetcher.ConfigConstraintObj.Spec
+			}
+		}
+		if isConfigurationRollbackItemDisruptive(item.ConfigFileParams, history.ConfigFileParams, cc) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isConfigurationRollbackItemDisruptive diffs the parameters of the revision against the current ones,
+// and decides whether the diff is reloaded without restarting as the reconfigure policy does.
+func isConfigurationRollbackItemDisruptive(current, history map[string]appsv1alpha1.ConfigParams, cc *appsv1beta1.ConfigConstraintSpec) bool {
+	item := appsv1alpha1.ConfigurationItem{}
+	for _, key := range sets.KeySet(current).Union(sets.KeySet(history)).UnsortedList() {
+		currentParams, historyParams := current[key], history[key]
+		// the whole file is replaced, and the parameters can't be told apart.
+		if !reflect.DeepEqual(currentParams.Content, historyParams.Content) {
+			return true
+		}
+		var parameters []appsv1alpha1.ParameterPair
+		for name, value := range historyParams.Parameters {
+			if !reflect.DeepEqual(value, currentParams.Parameters[name]) {
+				parameters = append(parameters, appsv1alpha1.ParameterPair{Key: name, Value: value})
+			}
+		}
+		for name := range currentParams.Parameters {
+			if _, ok := historyParams.Parameters[name]; !ok {
+				parameters = append(parameters, appsv1alpha1.ParameterPair{Key: name})
+			}
+		}
+		if len(parameters) != 0 {
+			item.Keys = append(item.Keys, appsv1alpha1.ParameterConfig{Key: key, Parameters: parameters})
+		}
+	}
+	if len(item.Keys) == 0 {
+		return false
+	}
+	return isReconfigureItemDisruptive(item, cc)
+}
+
+func fromConfigurationRollbacks(reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource) (reconfigures []reconfigureParams) {
+	for _, rollback := range resource.OpsRequest.Spec.ConfigurationRollbackList {
+		configurationStatus := initReconfigureStatus(resource.OpsRequest, rollback.ComponentName)
+		// makes sure the status of the configuration item exists before syncing the progress
+		_ = updateReconfigureStatusByCM(configurationStatus, rollback.ConfigurationName, func(*appsv1alpha1.ConfigurationItemStatus) error {
+			return nil
+		})
+		reconfigures = append(reconfigures, reconfigureParams{
+			resource:            resource,
+			reqCtx:              reqCtx,
+			cli:                 cli,
+			clusterName:         resource.Cluster.Name,
+			componentName:       rollback.ComponentName,
+			opsRequest:          resource.OpsRequest,
+			configurationItem:   appsv1alpha1.ConfigurationItem{Name: rollback.ConfigurationName},
+			configurationStatus: configurationStatus,
+		})
+	}
+	return reconfigures
+}
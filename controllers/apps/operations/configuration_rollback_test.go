@@ -0,0 +1,190 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
+	"github.com/apecloud/kubeblocks/pkg/configuration/core"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/generics"
+	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
+)
+
+var _ = Describe("ConfigurationRollback OpsRequest", func() {
+
+	var (
+		randomStr             = testCtx.GetRandomStr()
+		clusterDefinitionName = "cluster-definition-for-ops-" + randomStr
+		clusterVersionName    = "clusterversion-for-ops-" + randomStr
+		clusterName           = "cluster-for-ops-" + randomStr
+		configSpecName        = "mysql-config"
+	)
+
+	cleanEnv := func() {
+		// must wait till resources deleted and no longer existed before the testcases start,
+		// otherwise if later it needs to create some new resource objects with the same name,
+		// in race conditions, it will find the existence of old objects, resulting failure to
+		// create the new objects.
+		By("clean resources")
+
+		// delete cluster(and all dependent sub-resources), clusterversion and clusterdef
+		testapps.ClearClusterResources(&testCtx)
+
+		// delete rest resources
+		inNS := client.InNamespace(testCtx.DefaultNamespace)
+		ml := client.HasLabels{testCtx.TestObjLabelKey}
+		// namespaced
+		testapps.ClearResources(&testCtx, generics.OpsRequestSignature, inNS, ml)
+		testapps.ClearResources(&testCtx, generics.ConfigurationSignature, inNS, ml)
+	}
+
+	BeforeEach(cleanEnv)
+
+	AfterEach(cleanEnv)
+
+	mockConfigurationWithHistory := func() *appsv1alpha1.Configuration {
+		configuration := builder.NewConfigurationBuilder(testCtx.DefaultNamespace, core.GenerateComponentConfigurationName(clusterName, consensusComp)).
+			ClusterRef(clusterName).
+			Component(consensusComp).
+			AddConfigurationItem(appsv1alpha1.ComponentConfigSpec{
+				ComponentTemplateSpec: appsv1alpha1.ComponentTemplateSpec{
+					Name:        configSpecName,
+					TemplateRef: "mysql-config-template",
+					VolumeName:  configSpecName,
+				},
+			}).
+			AddLabels(testCtx.TestObjLabelKey, "true").
+			GetObject()
+		maxConnections := "2000"
+		configuration.Spec.ConfigItemDetails[0].ConfigFileParams = map[string]appsv1alpha1.ConfigParams{
+			"my.cnf": {Parameters: map[string]*string{"max_connections": &maxConnections}},
+		}
+		testapps.CreateK8sResource(&testCtx, configuration)
+
+		previous := "1000"
+		Eventually(testapps.GetAndChangeObjStatus(&testCtx, client.ObjectKeyFromObject(configuration), func(config *appsv1alpha1.Configuration) {
+			config.Status.ConfigurationItemStatus = []appsv1alpha1.ConfigurationItemDetailStatus{{
+				Name:  configSpecName,
+				Phase: appsv1alpha1.CFinishedPhase,
+				RevisionHistory: []appsv1alpha1.ConfigurationRevisionHistory{
+					{
+						Revision: "1",
+						ConfigFileParams: map[string]appsv1alpha1.ConfigParams{
+							"my.cnf": {Parameters: map[string]*string{"max_connections": &previous}},
+						},
+					},
+					{
+						Revision:         "2",
+						ConfigFileParams: configuration.Spec.ConfigItemDetails[0].ConfigFileParams,
+					},
+				},
+			}}
+		})).Should(Succeed())
+		return configuration
+	}
+
+	newRollbackOps := func(revision string) *appsv1alpha1.OpsRequest {
+		ops := testapps.NewOpsRequestObj("configuration-rollback-ops-"+randomStr, testCtx.DefaultNamespace,
+			clusterName, appsv1alpha1.ConfigurationRollbackType)
+		ops.Spec.ConfigurationRollbackList = []appsv1alpha1.ConfigurationRollback{
+			{
+				ComponentOps:      appsv1alpha1.ComponentOps{ComponentName: consensusComp},
+				ConfigurationName: configSpecName,
+				Revision:          revision,
+			},
+		}
+		return ops
+	}
+
+	Context("Test OpsRequest", func() {
+		It("Test configurationRollback OpsRequest", func() {
+			reqCtx := intctrlutil.RequestCtx{Ctx: ctx}
+			opsRes, _, _ := initOperationsResources(clusterDefinitionName, clusterVersionName, clusterName)
+			configuration := mockConfigurationWithHistory()
+
+			By("create ConfigurationRollback opsRequest")
+			opsRes.OpsRequest = testapps.CreateOpsRequest(ctx, testCtx, newRollbackOps("1"))
+
+			By("the parameters of the revision are restored")
+			rollbackAction := &configurationRollbackAction{}
+			Expect(rollbackAction.Action(reqCtx, k8sClient, opsRes)).Should(Succeed())
+			Eventually(testapps.CheckObj(&testCtx, client.ObjectKeyFromObject(configuration), func(g Gomega, config *appsv1alpha1.Configuration) {
+				params := config.Spec.ConfigItemDetails[0].ConfigFileParams["my.cnf"].Parameters
+				g.Expect(*params["max_connections"]).Should(Equal("1000"))
+				g.Expect(config.Annotations[constant.ConfigRevisionSourceAnnotationKey]).Should(ContainSubstring(opsRes.OpsRequest.Name))
+			})).Should(Succeed())
+
+			By("the reconfiguring status of the component is initialized")
+			Expect(opsRes.OpsRequest.Status.ReconfiguringStatusAsComponent).Should(HaveKey(consensusComp))
+			Expect(opsRes.OpsRequest.Status.ReconfiguringStatusAsComponent[consensusComp].ConfigurationStatus[0].Name).Should(Equal(configSpecName))
+		})
+
+		It("Test configurationRollback OpsRequest with an unknown revision", func() {
+			reqCtx := intctrlutil.RequestCtx{Ctx: ctx}
+			opsRes, _, _ := initOperationsResources(clusterDefinitionName, clusterVersionName, clusterName)
+			mockConfigurationWithHistory()
+			opsRes.OpsRequest = newRollbackOps("100")
+			err := (&configurationRollbackAction{}).Action(reqCtx, k8sClient, opsRes)
+			Expect(intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal)).Should(BeTrue())
+		})
+	})
+
+	Context("isConfigurationRollbackItemDisruptive", func() {
+		cc := &appsv1beta1.ConfigConstraintSpec{
+			DynamicParameters: []string{"max_connections"},
+		}
+		newParams := func(params map[string]*string) map[string]appsv1alpha1.ConfigParams {
+			return map[string]appsv1alpha1.ConfigParams{"my.cnf": {Parameters: params}}
+		}
+		oldValue, newValue := "100", "1000"
+
+		It("decides by the restored parameters", func() {
+			current := newParams(map[string]*string{"max_connections": &newValue, "innodb_buffer_pool_size": &oldValue})
+
+			By("nothing is restored")
+			Expect(isConfigurationRollbackItemDisruptive(current, current, nil)).Should(BeFalse())
+
+			By("the dynamic parameters are reloaded")
+			history := newParams(map[string]*string{"max_connections": &oldValue, "innodb_buffer_pool_size": &oldValue})
+			Expect(isConfigurationRollbackItemDisruptive(current, history, cc)).Should(BeFalse())
+
+			By("the static parameters require restarting")
+			history = newParams(map[string]*string{"max_connections": &newValue, "innodb_buffer_pool_size": &newValue})
+			Expect(isConfigurationRollbackItemDisruptive(current, history, cc)).Should(BeTrue())
+
+			By("the parameters absent from the revision are deleted, which requires restarting")
+			history = newParams(map[string]*string{"innodb_buffer_pool_size": &oldValue})
+			Expect(isConfigurationRollbackItemDisruptive(current, history, cc)).Should(BeTrue())
+
+			By("restoring the file content requires restarting")
+			content := "[mysqld]"
+			history = map[string]appsv1alpha1.ConfigParams{"my.cnf": {Content: &content}}
+			Expect(isConfigurationRollbackItemDisruptive(nil, history, cc)).Should(BeTrue())
+		})
+	})
+})
@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package operations
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 	"sync"
@@ -78,6 +79,14 @@ func (opsMgr *OpsManager) Do(reqCtx intctrlutil.RequestCtx, cli client.Client, o
 		if opsRequest.Spec.Cancel {
 			return &ctrl.Result{}, PatchOpsStatus(reqCtx.Ctx, cli, opsRes, appsv1alpha1.OpsCancelledPhase)
 		}
+		// wait for the scheduled start time or the maintenance window of the cluster.
+		if waitDuration, err := handleOpsSchedule(reqCtx, cli, opsRes, opsBehaviour); intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+			return &ctrl.Result{}, patchValidateErrorCondition(reqCtx.Ctx, cli, opsRes, err.Error())
+		} else if err != nil {
+			return nil, err
+		} else if waitDuration > 0 {
+			return intctrlutil.ResultToP(intctrlutil.RequeueAfter(waitDuration, reqCtx.Log, "wait for the scheduled start time"))
+		}
 		// validate entry condition for OpsRequest, check if the cluster is in the right phase
 		if err = validateOpsWaitingPhase(opsRes.Cluster, opsRequest, opsBehaviour); err != nil {
 			// check if the error is caused by WaitForClusterPhaseErr  error
@@ -134,6 +143,34 @@ func (opsMgr *OpsManager) Do(reqCtx intctrlutil.RequestCtx, cli client.Client, o
 	return nil, nil
 }
 
+// DryRun performs the action of the OpsRequest for preview. The client is expected to be a dry-run client,
+// so that the changes are only reflected on the objects in memory, e.g. opsRes.Cluster.
+// It returns a fatal error if the OpsRequest is invalid or can't be previewed.
+func (opsMgr *OpsManager) DryRun(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	opsRequest := opsRes.OpsRequest
+	opsBehaviour, ok := opsMgr.OpsMap[opsRequest.Spec.Type]
+	if !ok || opsBehaviour.OpsHandler == nil {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`OpsRequest type "%s" is not supported`, opsRequest.Spec.Type))
+	}
+	if opsBehaviour.IsClusterCreation {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`dry-run is not supported for OpsRequest type "%s"`, opsRequest.Spec.Type))
+	}
+	var err error
+	if opsRequest.Spec.Type == appsv1alpha1.CustomType {
+		err = initOpsDefAndValidate(reqCtx, cli, opsRes)
+	} else {
+		err = opsRequest.Validate(reqCtx.Ctx, cli, opsRes.Cluster, true)
+	}
+	if err != nil {
+		return intctrlutil.NewFatalError(err.Error())
+	}
+	opsRes.ToClusterPhase = opsBehaviour.ToClusterPhase
+	if opsRequest.Status.StartTimestamp.IsZero() {
+		opsRequest.Status.StartTimestamp = metav1.Now()
+	}
+	return opsBehaviour.OpsHandler.Action(reqCtx, cli, opsRes)
+}
+
 // Reconcile entry function when OpsRequest.status.phase is Running.
 // loops till the operation is completed.
 func (opsMgr *OpsManager) Reconcile(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (time.Duration, error) {
@@ -0,0 +1,137 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+const reasonOpsScheduled = "Scheduled"
+
+// handleOpsSchedule checks if the OpsRequest needs to wait for its scheduled start time, and returns how long
+// it should wait. The waiting OpsRequest holds its position in the cluster queue until the time comes.
+func handleOpsSchedule(reqCtx intctrlutil.RequestCtx,
+	cli client.Client,
+	opsRes *OpsResource,
+	opsBehaviour OpsBehaviour) (time.Duration, error) {
+	now := time.Now()
+	if opsBehaviour.IsDisruptive != nil && opsRes.Cluster != nil && opsRes.Cluster.Spec.MaintenanceWindow != nil {
+		disruptive, err := opsBehaviour.IsDisruptive(reqCtx, cli, opsRes)
+		if err != nil {
+			return 0, err
+		}
+		opsBehaviour.Disruptive = disruptive
+	}
+	scheduledStartTime, err := getOpsScheduledStartTime(opsRes.OpsRequest, opsRes.Cluster, opsBehaviour, now)
+	if err != nil {
+		return 0, err
+	}
+	if !scheduledStartTime.After(now) {
+		return 0, nil
+	}
+	opsRequest := opsRes.OpsRequest
+	if opsRequest.Status.ScheduledStartTime == nil || !opsRequest.Status.ScheduledStartTime.Equal(&metav1.Time{Time: scheduledStartTime}) {
+		patch := client.MergeFrom(opsRequest.DeepCopy())
+		opsRequest.Status.ScheduledStartTime = &metav1.Time{Time: scheduledStartTime}
+		if err = cli.Status().Patch(reqCtx.Ctx, opsRequest, patch); err != nil {
+			return 0, err
+		}
+		opsRes.Recorder.Eventf(opsRequest, corev1.EventTypeNormal, reasonOpsScheduled,
+			"OpsRequest is scheduled to start at %s", scheduledStartTime.Format(time.RFC3339))
+	}
+	if opsBehaviour.QueueByCluster || opsBehaviour.QueueBySelf {
+		if _, err = enqueueOpsRequestToClusterAnnotation(reqCtx.Ctx, cli, opsRes, opsBehaviour); err != nil {
+			return 0, err
+		}
+	}
+	return scheduledStartTime.Sub(now), nil
+}
+
+// getOpsScheduledStartTime returns the time when the OpsRequest is allowed to start,
+// the OpsRequest can start now if the returned time is not after now.
+func getOpsScheduledStartTime(opsRequest *appsv1alpha1.OpsRequest,
+	cluster *appsv1alpha1.Cluster,
+	opsBehaviour OpsBehaviour,
+	now time.Time) (time.Time, error) {
+	startTime := now
+	policy := appsv1alpha1.MaintenanceWindowPolicyDisruptive
+	if schedule := opsRequest.Spec.Schedule; schedule != nil {
+		if schedule.StartTime != nil && schedule.StartTime.After(now) {
+			startTime = schedule.StartTime.Time
+		}
+		if schedule.MaintenanceWindowPolicy != "" {
+			policy = schedule.MaintenanceWindowPolicy
+		}
+	}
+	if cluster == nil || cluster.Spec.MaintenanceWindow == nil {
+		return startTime, nil
+	}
+	switch policy {
+	case appsv1alpha1.MaintenanceWindowPolicyIgnore:
+		return startTime, nil
+	case appsv1alpha1.MaintenanceWindowPolicyDisruptive:
+		if !opsBehaviour.Disruptive {
+			return startTime, nil
+		}
+	}
+	return nextMaintenanceWindowStart(cluster.Spec.MaintenanceWindow, startTime)
+}
+
+// nextMaintenanceWindowStart returns the given time if it is within the maintenance window,
+// otherwise returns the start time of the next maintenance window.
+func nextMaintenanceWindowStart(window *appsv1alpha1.ClusterMaintenanceWindow, t time.Time) (time.Time, error) {
+	if window.Duration.Duration <= 0 {
+		return t, intctrlutil.NewFatalError(fmt.Sprintf(`the duration "%s" of the maintenance window must be positive`, window.Duration.String()))
+	}
+	location := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(window.TimeZone); err != nil {
+			return t, intctrlutil.NewFatalError(fmt.Sprintf(`invalid time zone "%s" of the maintenance window: %s`, window.TimeZone, err.Error()))
+		}
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return t, intctrlutil.NewFatalError(fmt.Sprintf(`invalid schedule "%s" of the maintenance window: %s`, window.Schedule, err.Error()))
+	}
+	// the first window which starts after (t - duration) either covers t or is the next window.
+	start := schedule.Next(t.In(location).Add(-window.Duration.Duration))
+	if start.IsZero() {
+		return t, intctrlutil.NewFatalError(fmt.Sprintf(`the schedule "%s" of the maintenance window never activates`, window.Schedule))
+	}
+	if !start.After(t) {
+		return t, nil
+	}
+	return start, nil
+}
+
+// isOpsWaitingForSchedule checks if the OpsRequest is waiting for its scheduled start time.
+func isOpsWaitingForSchedule(opsRequest *appsv1alpha1.OpsRequest) bool {
+	return opsRequest.Status.ScheduledStartTime != nil && opsRequest.Status.ScheduledStartTime.After(time.Now())
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+var _ = Describe("OpsRequest schedule", func() {
+	// 2024-06-05 is a Wednesday.
+	now := time.Date(2024, 6, 5, 10, 0, 0, 0, time.UTC)
+	window := &appsv1alpha1.ClusterMaintenanceWindow{
+		// every Wednesday from 2 AM to 4 AM in UTC.
+		Schedule: "0 2 * * 3",
+		Duration: metav1.Duration{Duration: 2 * time.Hour},
+	}
+	newCluster := func(window *appsv1alpha1.ClusterMaintenanceWindow) *appsv1alpha1.Cluster {
+		return &appsv1alpha1.Cluster{Spec: appsv1alpha1.ClusterSpec{MaintenanceWindow: window}}
+	}
+	newOps := func(schedule *appsv1alpha1.OpsSchedule) *appsv1alpha1.OpsRequest {
+		return &appsv1alpha1.OpsRequest{Spec: appsv1alpha1.OpsRequestSpec{Schedule: schedule}}
+	}
+
+	Context("nextMaintenanceWindowStart", func() {
+		It("returns the given time within the window", func() {
+			t := time.Date(2024, 6, 5, 3, 0, 0, 0, time.UTC)
+			start, err := nextMaintenanceWindowStart(window, t)
+			Expect(err).Should(Succeed())
+			Expect(start).Should(Equal(t))
+		})
+
+		It("returns the start time of the next window", func() {
+			start, err := nextMaintenanceWindowStart(window, now)
+			Expect(err).Should(Succeed())
+			Expect(start.Equal(time.Date(2024, 6, 12, 2, 0, 0, 0, time.UTC))).Should(BeTrue())
+		})
+
+		It("respects the time zone", func() {
+			w := window.DeepCopy()
+			w.TimeZone = "Asia/Shanghai"
+			start, err := nextMaintenanceWindowStart(w, now)
+			Expect(err).Should(Succeed())
+			// 2 AM of 2024-06-12 in Asia/Shanghai is 6 PM of 2024-06-11 in UTC.
+			Expect(start.Equal(time.Date(2024, 6, 11, 18, 0, 0, 0, time.UTC))).Should(BeTrue())
+		})
+
+		It("fails with invalid settings", func() {
+			w := window.DeepCopy()
+			w.Schedule = "invalid"
+			_, err := nextMaintenanceWindowStart(w, now)
+			Expect(intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal)).Should(BeTrue())
+
+			w = window.DeepCopy()
+			w.TimeZone = "invalid"
+			_, err = nextMaintenanceWindowStart(w, now)
+			Expect(intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal)).Should(BeTrue())
+		})
+	})
+
+	Context("getOpsScheduledStartTime", func() {
+		It("starts now without schedule and maintenance window", func() {
+			start, err := getOpsScheduledStartTime(newOps(nil), newCluster(nil), OpsBehaviour{Disruptive: true}, now)
+			Expect(err).Should(Succeed())
+			Expect(start).Should(Equal(now))
+		})
+
+		It("waits for the start time", func() {
+			startTime := metav1.NewTime(now.Add(time.Hour))
+			start, err := getOpsScheduledStartTime(newOps(&appsv1alpha1.OpsSchedule{StartTime: &startTime}), newCluster(nil), OpsBehaviour{}, now)
+			Expect(err).Should(Succeed())
+			Expect(start).Should(Equal(startTime.Time))
+		})
+
+		It("waits for the maintenance window according to the policy", func() {
+			nextWindow := time.Date(2024, 6, 12, 2, 0, 0, 0, time.UTC)
+			By("disruptive operation waits for the window by default")
+			start, err := getOpsScheduledStartTime(newOps(nil), newCluster(window), OpsBehaviour{Disruptive: true}, now)
+			Expect(err).Should(Succeed())
+			Expect(start.Equal(nextWindow)).Should(BeTrue())
+
+			By("non-disruptive operation starts now by default")
+			start, err = getOpsScheduledStartTime(newOps(nil), newCluster(window), OpsBehaviour{}, now)
+			Expect(err).Should(Succeed())
+			Expect(start).Should(Equal(now))
+
+			By("non-disruptive operation waits for the window with Always policy")
+			schedule := &appsv1alpha1.OpsSchedule{MaintenanceWindowPolicy: appsv1alpha1.MaintenanceWindowPolicyAlways}
+			start, err = getOpsScheduledStartTime(newOps(schedule), newCluster(window), OpsBehaviour{}, now)
+			Expect(err).Should(Succeed())
+			Expect(start.Equal(nextWindow)).Should(BeTrue())
+
+			By("disruptive operation starts now with Ignore policy")
+			schedule = &appsv1alpha1.OpsSchedule{MaintenanceWindowPolicy: appsv1alpha1.MaintenanceWindowPolicyIgnore}
+			start, err = getOpsScheduledStartTime(newOps(schedule), newCluster(window), OpsBehaviour{Disruptive: true}, now)
+			Expect(err).Should(Succeed())
+			Expect(start).Should(Equal(now))
+		})
+	})
+
+	Context("isReconfigureItemDisruptive", func() {
+		cc := &appsv1beta1.ConfigConstraintSpec{
+			DynamicParameters: []string{"max_connections"},
+		}
+		newItem := func(policy *appsv1alpha1.UpgradePolicy, params ...appsv1alpha1.ParameterPair) appsv1alpha1.ConfigurationItem {
+			return appsv1alpha1.ConfigurationItem{
+				Name:   "mysql-config",
+				Policy: policy,
+				Keys:   []appsv1alpha1.ParameterConfig{{Key: "my.cnf", Parameters: params}},
+			}
+		}
+		value := "1000"
+
+		It("decides by the specified policy", func() {
+			restart := appsv1alpha1.RollingPolicy
+			Expect(isReconfigureItemDisruptive(newItem(&restart, appsv1alpha1.ParameterPair{Key: "max_connections", Value: &value}), cc)).Should(BeTrue())
+			reload := appsv1alpha1.AsyncDynamicReloadPolicy
+			Expect(isReconfigureItemDisruptive(newItem(&reload, appsv1alpha1.ParameterPair{Key: "innodb_buffer_pool_size", Value: &value}), cc)).Should(BeFalse())
+		})
+
+		It("decides by the parameters", func() {
+			By("the dynamic parameters are reloaded")
+			Expect(isReconfigureItemDisruptive(newItem(nil, appsv1alpha1.ParameterPair{Key: "max_connections", Value: &value}), cc)).Should(BeFalse())
+
+			By("the static parameters require restarting")
+			Expect(isReconfigureItemDisruptive(newItem(nil,
+				appsv1alpha1.ParameterPair{Key: "max_connections", Value: &value},
+				appsv1alpha1.ParameterPair{Key: "innodb_buffer_pool_size", Value: &value}), cc)).Should(BeTrue())
+
+			By("deleting a parameter requires restarting")
+			Expect(isReconfigureItemDisruptive(newItem(nil, appsv1alpha1.ParameterPair{Key: "max_connections"}), cc)).Should(BeTrue())
+
+			By("replacing the file requires restarting")
+			item := newItem(nil)
+			item.Keys[0].FileContent = "[mysqld]"
+			Expect(isReconfigureItemDisruptive(item, cc)).Should(BeTrue())
+
+			By("the ConfigConstraint is not found")
+			Expect(isReconfigureItemDisruptive(newItem(nil, appsv1alpha1.ParameterPair{Key: "max_connections", Value: &value}), nil)).Should(BeTrue())
+		})
+	})
+})
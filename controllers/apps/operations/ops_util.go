@@ -35,6 +35,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/configuration/core"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/metrics"
 )
 
 var _ error = &WaitForClusterPhaseErr{}
@@ -96,10 +97,34 @@ func PatchOpsStatusWithOpsDeepCopy(ctx context.Context,
 			return err
 		}
 	}
+	started := false
 	if phase == appsv1alpha1.OpsCreatingPhase && opsRequest.Status.StartTimestamp.IsZero() {
 		opsRequest.Status.StartTimestamp = metav1.Time{Time: time.Now()}
+		started = true
 	}
-	return cli.Status().Patch(ctx, opsRequest, patch)
+	if err := cli.Status().Patch(ctx, opsRequest, patch); err != nil {
+		return err
+	}
+	recordOpsRequestMetrics(opsRequest, opsRequestDeepCopy.Status.Phase, started)
+	return nil
+}
+
+// recordOpsRequestMetrics records the OpsRequest metrics when the OpsRequest starts or completes.
+func recordOpsRequestMetrics(opsRequest *appsv1alpha1.OpsRequest, prevPhase appsv1alpha1.OpsPhase, started bool) {
+	opsType := string(opsRequest.Spec.Type)
+	if started {
+		metrics.RecordOpsRequestStarted(opsRequest.Namespace, opsType)
+	}
+	phase := opsRequest.Status.Phase
+	if !opsRequest.IsComplete(phase) || opsRequest.IsComplete(prevPhase) {
+		return
+	}
+	startTime := opsRequest.Status.StartTimestamp
+	if startTime.IsZero() {
+		startTime = opsRequest.CreationTimestamp
+	}
+	metrics.RecordOpsRequestCompleted(opsRequest.Namespace, opsType, string(phase),
+		opsRequest.Status.CompletionTimestamp.Sub(startTime.Time))
 }
 
 // PatchOpsStatus patches OpsRequest.status
@@ -213,7 +238,12 @@ func validateOpsWaitingPhase(cluster *appsv1alpha1.Cluster, ops *appsv1alpha1.Op
 	}
 	// check if entry-condition is met
 	// if the cluster is not in the expected phase, we should wait for it for up to TTLSecondsBeforeAbort seconds.
-	if ops.Spec.PreConditionDeadlineSeconds == nil || (time.Now().After(ops.GetCreationTimestamp().Add(time.Duration(*ops.Spec.PreConditionDeadlineSeconds) * time.Second))) {
+	// the deadline is counted from the scheduled start time if the opsRequest has waited for it.
+	startTime := ops.GetCreationTimestamp()
+	if ops.Status.ScheduledStartTime != nil && ops.Status.ScheduledStartTime.After(startTime.Time) {
+		startTime = *ops.Status.ScheduledStartTime
+	}
+	if ops.Spec.PreConditionDeadlineSeconds == nil || (time.Now().After(startTime.Add(time.Duration(*ops.Spec.PreConditionDeadlineSeconds) * time.Second))) {
 		return nil
 	}
 
@@ -0,0 +1,136 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+type PromoteStandbyOpsHandler struct{}
+
+var _ OpsHandler = PromoteStandbyOpsHandler{}
+
+func init() {
+	promoteStandbyBehaviour := OpsBehaviour{
+		FromClusterPhases: appsv1alpha1.GetClusterUpRunningPhases(),
+		ToClusterPhase:    appsv1alpha1.UpdatingClusterPhase,
+		QueueByCluster:    true,
+		OpsHandler:        PromoteStandbyOpsHandler{},
+	}
+
+	opsMgr := GetOpsManager()
+	opsMgr.RegisterOps(appsv1alpha1.PromoteStandbyType, promoteStandbyBehaviour)
+}
+
+// ActionStartedCondition the started condition when handling the promoteStandby request.
+func (p PromoteStandbyOpsHandler) ActionStartedCondition(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (*metav1.Condition, error) {
+	return appsv1alpha1.NewPromoteStandbyCondition(opsRes.OpsRequest), nil
+}
+
+// Action handles the old primary Cluster according to the oldPrimaryPolicy first,
+// then removes Cluster.spec.standby to promote the standby Cluster to a primary one.
+func (p PromoteStandbyOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	cluster := opsRes.Cluster
+	if cluster.Spec.Standby == nil {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`cluster "%s" is not a standby cluster`, cluster.Name))
+	}
+	if err := p.handleOldPrimary(reqCtx, cli, opsRes); err != nil {
+		return err
+	}
+	cluster.Spec.Standby = nil
+	return cli.Update(reqCtx.Ctx, cluster)
+}
+
+// ReconcileAction will be performed when action is done and loops till OpsRequest.status.phase is Succeed/Failed.
+// the promotion is done when the standby status is removed and the cluster is running again.
+func (p PromoteStandbyOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (appsv1alpha1.OpsPhase, time.Duration, error) {
+	cluster := opsRes.Cluster
+	if cluster.Status.Standby == nil && cluster.Status.Phase == appsv1alpha1.RunningClusterPhase {
+		return appsv1alpha1.OpsSucceedPhase, 0, nil
+	}
+	return appsv1alpha1.OpsRunningPhase, 5 * time.Second, nil
+}
+
+// SaveLastConfiguration this operation only modifies the Cluster.spec.standby, no need to save the last configuration.
+func (p PromoteStandbyOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	return nil
+}
+
+// handleOldPrimary demotes the old primary Cluster to a standby of the promoted Cluster, or fences it by stopping it.
+// It is skipped if the old primary Cluster is lost, which is the usual case of a disaster recovery.
+func (p PromoteStandbyOpsHandler) handleOldPrimary(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	var (
+		cluster = opsRes.Cluster
+		standby = cluster.Spec.Standby
+		policy  = appsv1alpha1.OldPrimaryDemote
+	)
+	if opsRes.OpsRequest.Spec.PromoteStandby != nil && opsRes.OpsRequest.Spec.PromoteStandby.OldPrimaryPolicy != "" {
+		policy = opsRes.OpsRequest.Spec.PromoteStandby.OldPrimaryPolicy
+	}
+	if policy == appsv1alpha1.OldPrimaryNone {
+		return nil
+	}
+	namespace := standby.PrimaryClusterNamespace
+	if namespace == "" {
+		namespace = cluster.Namespace
+	}
+	oldPrimary := &appsv1alpha1.Cluster{}
+	if err := cli.Get(reqCtx.Ctx, client.ObjectKey{Namespace: namespace, Name: standby.PrimaryCluster}, oldPrimary); err != nil {
+		if apierrors.IsNotFound(err) {
+			reqCtx.Log.Info(fmt.Sprintf("the old primary cluster %s/%s is not found, skip to %s it", namespace, standby.PrimaryCluster, policy))
+			return nil
+		}
+		return err
+	}
+	switch policy {
+	case appsv1alpha1.OldPrimaryFence:
+		if slices.Contains([]appsv1alpha1.ClusterPhase{appsv1alpha1.StoppedClusterPhase,
+			appsv1alpha1.StoppingClusterPhase}, oldPrimary.Status.Phase) {
+			return nil
+		}
+		if _, ok := oldPrimary.Annotations[constant.SnapShotForStartAnnotationKey]; ok {
+			return nil
+		}
+		if err := stopClusterComponents(oldPrimary); err != nil {
+			return err
+		}
+	default:
+		primaryNamespace := ""
+		if cluster.Namespace != oldPrimary.Namespace {
+			primaryNamespace = cluster.Namespace
+		}
+		// the old primary cluster has been running, so it follows the promoted cluster without bootstrapping from backups.
+		oldPrimary.Spec.Standby = &appsv1alpha1.ClusterStandby{
+			PrimaryCluster:          cluster.Name,
+			PrimaryClusterNamespace: primaryNamespace,
+		}
+	}
+	return cli.Update(reqCtx.Ctx, oldPrimary)
+}
@@ -121,7 +121,8 @@ func enqueueOpsRequestToClusterAnnotation(ctx context.Context, cli client.Client
 			Type:        opsRes.OpsRequest.Spec.Type,
 			QueueBySelf: opsBehaviour.QueueBySelf,
 			// check if the opsRequest should be in the queue.
-			InQueue: existOtherRunningOps(opsRequestSlice, opsRes.OpsRequest.Spec.Type, opsBehaviour) && !opsRes.OpsRequest.Force(),
+			InQueue: (existOtherRunningOps(opsRequestSlice, opsRes.OpsRequest.Spec.Type, opsBehaviour) && !opsRes.OpsRequest.Force()) ||
+				isOpsWaitingForSchedule(opsRes.OpsRequest),
 		}
 		opsRequestSlice = append(opsRequestSlice, opsRecorder)
 	default:
@@ -129,6 +130,10 @@ func enqueueOpsRequestToClusterAnnotation(ctx context.Context, cli client.Client
 			// the opsRequest is already running.
 			return &opsRecorder, nil
 		}
+		if isOpsWaitingForSchedule(opsRes.OpsRequest) {
+			// the opsRequest keeps in the queue until its scheduled start time.
+			return &opsRecorder, nil
+		}
 		if !opsRes.OpsRequest.Spec.Force && existOtherRunningOps(opsRequestSlice, opsRecorder.Type, opsBehaviour) {
 			// if exists other running opsRequest, return.
 			return &opsRecorder, nil
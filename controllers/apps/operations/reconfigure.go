@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
 	"github.com/apecloud/kubeblocks/pkg/configuration/core"
 	configctrl "github.com/apecloud/kubeblocks/pkg/controller/configuration"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
@@ -46,6 +47,8 @@ func init() {
 		// TODO: add cluster reconcile Reconfiguring phase.
 		ToClusterPhase: appsv1alpha1.UpdatingClusterPhase,
 		QueueByCluster: true,
+		Disruptive:     true,
+		IsDisruptive:   isReconfigureDisruptive,
 		OpsHandler:     &reAction,
 	}
 	opsManager.RegisterOps(appsv1alpha1.ReconfiguringType, reconfigureBehaviour)
@@ -111,15 +114,16 @@ func (r *reconfigureAction) syncDependResources(reqCtx intctrlutil.RequestCtx, c
 }
 
 func (r *reconfigureAction) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource) (appsv1alpha1.OpsPhase, time.Duration, error) {
-	var (
-		isFinished = true
-		opsRequest = resource.OpsRequest.Spec
-	)
+	return r.syncReconfigureStatus(reqCtx, cli, resource, fromReconfigureOperations(resource.OpsRequest.Spec, reqCtx, cli, resource))
+}
+
+func (r *reconfigureAction) syncReconfigureStatus(reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource, reconfigures []reconfigureParams) (appsv1alpha1.OpsPhase, time.Duration, error) {
+	isFinished := true
 
 	// Node: support multiple component
 	opsDeepCopy := resource.OpsRequest.DeepCopy()
 	statusAsComponents := make([]appsv1alpha1.ConfigurationItemStatus, 0)
-	for _, reconfigureParams := range fromReconfigureOperations(opsRequest, reqCtx, cli, resource) {
+	for _, reconfigureParams := range reconfigures {
 		phase, err := r.doSyncReconfigureStatus(reconfigureParams)
 		switch {
 		case err != nil:
@@ -139,6 +143,95 @@ func (r *reconfigureAction) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli c
 	return syncReconfigureForOps(reqCtx, cli, resource, statusAsComponents, opsDeepCopy, phase)
 }
 
+// isReconfigureDisruptive checks whether any of the reconfigurations requires restarting the pods,
+// the reconfigurations which can be reloaded dynamically don't need to wait for the maintenance window.
+func isReconfigureDisruptive(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (bool, error) {
+	spec := opsRes.OpsRequest.Spec
+	var operations []appsv1alpha1.Reconfigure
+	if spec.Reconfigure != nil {
+		operations = append(operations, *spec.Reconfigure)
+	}
+	operations = append(operations, spec.Reconfigures...)
+	for _, reconfigure := range operations {
+		fetcher := configctrl.NewResourceFetcher(&configctrl.ResourceCtx{
+			Context:       reqCtx.Ctx,
+			Client:        cli,
+			Namespace:     opsRes.Cluster.Namespace,
+			ClusterName:   opsRes.Cluster.Name,
+			ComponentName: reconfigure.ComponentName,
+		})
+		if err := fetcher.Configuration().Complete(); err != nil {
+			return false, err
+		}
+		// the reload policies can't be resolved without the Configuration, treat it as disruptive.
+		if fetcher.ConfigurationObj == nil {
+			return true, nil
+		}
+		for _, item := range reconfigure.Configurations {
+			cc, err := getConfigConstraintSpec(reqCtx, cli, getConfigConstraintName(fetcher.ConfigurationObj, item.Name))
+			if err != nil {
+				return false, err
+			}
+			if isReconfigureItemDisruptive(item, cc) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func getConfigConstraintSpec(reqCtx intctrlutil.RequestCtx, cli client.Client, ccName string) (*appsv1beta1.ConfigConstraintSpec, error) {
+	if ccName == "" {
+		return nil, nil
+	}
+	cc := &appsv1beta1.ConfigConstraint{}
+	if err := cli.Get(reqCtx.Ctx, client.ObjectKey{Name: ccName}, cc); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return &cc.Spec, nil
+}
+
+func getConfigConstraintName(configuration *appsv1alpha1.Configuration, configSpecName string) string {
+	if configuration == nil {
+		return ""
+	}
+	item := configuration.Spec.GetConfigurationItem(configSpecName)
+	if item == nil || item.ConfigSpec == nil {
+		return ""
+	}
+	return item.ConfigSpec.ConfigConstraintRef
+}
+
+// isReconfigureItemDisruptive decides whether the reconfiguration restarts the pods as the reconfigure policy does:
+// the specified policy is respected, otherwise only the updates of the dynamic parameters are reloaded without restarting.
+func isReconfigureItemDisruptive(item appsv1alpha1.ConfigurationItem, cc *appsv1beta1.ConfigConstraintSpec) bool {
+	if item.Policy != nil && *item.Policy != appsv1alpha1.NonePolicy {
+		switch *item.Policy {
+		case appsv1alpha1.AsyncDynamicReloadPolicy, appsv1alpha1.SyncDynamicReloadPolicy:
+			return false
+		default:
+			return true
+		}
+	}
+	// the restart policy is used if the ConfigConstraint can't be found.
+	if cc == nil {
+		return true
+	}
+	for _, key := range item.Keys {
+		// the whole file is replaced, and the parameters can't be told apart.
+		if len(key.FileContent) > 0 {
+			return true
+		}
+		for _, param := range key.Parameters {
+			// deleting a parameter requires restarting.
+			if param.Value == nil || !core.IsDynamicParameter(param.Key, cc) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func fromReconfigureOperations(request appsv1alpha1.OpsRequestSpec, reqCtx intctrlutil.RequestCtx, cli client.Client, resource *OpsResource) (reconfigures []reconfigureParams) {
 	var operations []appsv1alpha1.Reconfigure
 
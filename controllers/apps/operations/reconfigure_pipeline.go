@@ -21,6 +21,7 @@ package operations
 
 import (
 	"fmt"
+	"reflect"
 	"slices"
 
 	"github.com/pkg/errors"
@@ -223,7 +224,13 @@ func (p *pipeline) UpdateOpsLabel() *pipeline {
 
 func (p *pipeline) Sync() *pipeline {
 	return p.Wrap(func() error {
-		return p.Client.Patch(p.reqCtx.Ctx, p.updatedObject, client.MergeFrom(p.ConfigurationObj))
+		// records the OpsRequest in the revision history of the configuration item
+		if !reflect.DeepEqual(p.ConfigurationObj.Spec, p.updatedObject.Spec) {
+			if err := configctrl.SetRevisionSource(p.updatedObject, p.config.Name, p.resource.OpsRequest); err != nil {
+				return err
+			}
+		}
+		return p.Client.Patch(p.reqCtx.Ctx, p.updatedObject, client.MergeFromWithOptions(p.ConfigurationObj, client.MergeFromWithOptimisticLock{}))
 	})
 }
 
@@ -20,20 +20,27 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package operations
 
 import (
+	"context"
 	"reflect"
+	"strconv"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
 	"github.com/apecloud/kubeblocks/pkg/configuration/core"
 	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	configctrl "github.com/apecloud/kubeblocks/pkg/controller/configuration"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
 	testutil "github.com/apecloud/kubeblocks/pkg/testutil/k8s"
@@ -342,3 +349,57 @@ func Test_createImmutableParamsFilter(t *testing.T) {
 		})
 	}
 }
+
+func Test_pipelineSyncWithOptimisticLock(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := appsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	configuration := &appsv1alpha1.Configuration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mysql-test-mysql"},
+		Spec: appsv1alpha1.ConfigurationSpec{
+			ConfigItemDetails: []appsv1alpha1.ConfigurationItemDetail{{Name: "mysql-config"}},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(configuration).Build()
+	opsRequest := &appsv1alpha1.OpsRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "reconfigure-ops"}}
+
+	newSyncPipeline := func() *pipeline {
+		p := newPipeline(reconfigureContext{
+			config:   appsv1alpha1.ConfigurationItem{Name: "mysql-config"},
+			cli:      cli,
+			reqCtx:   intctrlutil.RequestCtx{Ctx: context.Background()},
+			resource: &OpsResource{OpsRequest: opsRequest, Cluster: &appsv1alpha1.Cluster{}},
+		})
+		p.ConfigurationObj = &appsv1alpha1.Configuration{}
+		if err := cli.Get(context.Background(), client.ObjectKeyFromObject(configuration), p.ConfigurationObj); err != nil {
+			t.Fatal(err)
+		}
+		p.updatedObject = p.ConfigurationObj.DeepCopy()
+		value := "1000"
+		p.updatedObject.Spec.ConfigItemDetails[0].ConfigFileParams = map[string]appsv1alpha1.ConfigParams{
+			"my.cnf": {Parameters: map[string]*string{"max_connections": &value}},
+		}
+		return p
+	}
+
+	// the Configuration is updated by others after it is fetched
+	stale := newSyncPipeline()
+	latest := newSyncPipeline()
+	if err := latest.Sync().Complete().err; err != nil {
+		t.Fatalf("Expected the patch succeeds, but got %v", err)
+	}
+	if err := stale.Sync().Complete().err; !apierrors.IsConflict(err) {
+		t.Errorf("Expected the patch with the stale Configuration conflicts, but got %v", err)
+	}
+
+	synced := &appsv1alpha1.Configuration{}
+	if err := cli.Get(context.Background(), client.ObjectKeyFromObject(configuration), synced); err != nil {
+		t.Fatal(err)
+	}
+	// the next generation is made by the OpsRequest
+	source := configctrl.GetRevisionSource(synced, "mysql-config", strconv.FormatInt(latest.ConfigurationObj.Generation+1, 10))
+	if source == nil || source.OpsRequest != opsRequest.Name {
+		t.Errorf("Expected the revision is made by the OpsRequest, but got %v", source)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+type RotatePasswordOpsHandler struct{}
+
+var _ OpsHandler = RotatePasswordOpsHandler{}
+
+func init() {
+	rotatePasswordBehaviour := OpsBehaviour{
+		FromClusterPhases: appsv1alpha1.GetClusterUpRunningPhases(),
+		ToClusterPhase:    appsv1alpha1.UpdatingClusterPhase,
+		QueueByCluster:    true,
+		OpsHandler:        RotatePasswordOpsHandler{},
+	}
+
+	opsMgr := GetOpsManager()
+	opsMgr.RegisterOps(appsv1alpha1.RotatePasswordType, rotatePasswordBehaviour)
+}
+
+// ActionStartedCondition the started condition when handling the rotatePassword request.
+func (r RotatePasswordOpsHandler) ActionStartedCondition(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (*metav1.Condition, error) {
+	return appsv1alpha1.NewRotatePasswordCondition(opsRes.OpsRequest), nil
+}
+
+// Action requests the Components to rotate the passwords of the accounts, by annotating them with the request time.
+// The passwords are rotated by the component controller.
+func (r RotatePasswordOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	requestedAt := r.requestedAt(opsRes)
+	for _, v := range opsRes.OpsRequest.Spec.RotatePasswordList {
+		comps, err := r.listComponents(reqCtx, cli, opsRes.Cluster, v.ComponentName)
+		if err != nil {
+			return err
+		}
+		for i := range comps {
+			comp := &comps[i]
+			compName := comp.Labels[constant.KBAppComponentLabelKey]
+			patch := client.MergeFrom(comp.DeepCopy())
+			if comp.Annotations == nil {
+				comp.Annotations = map[string]string{}
+			}
+			for _, accountName := range v.AccountNames {
+				if _, err = r.getAccountSecret(reqCtx, cli, opsRes.Cluster, compName, accountName); err != nil {
+					if apierrors.IsNotFound(err) {
+						return intctrlutil.NewFatalError(fmt.Sprintf(`account "%s" of component "%s" is not found`, accountName, compName))
+					}
+					return err
+				}
+				comp.Annotations[constant.RotatePasswordAnnotationPrefix+accountName] = requestedAt.Format(time.RFC3339)
+			}
+			if err = cli.Patch(reqCtx.Ctx, comp, patch); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReconcileAction will be performed when action is done and loops till OpsRequest.status.phase is Succeed/Failed.
+// the rotation is done when all the account secrets have been re-created with the new passwords after the request.
+func (r RotatePasswordOpsHandler) ReconcileAction(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) (appsv1alpha1.OpsPhase, time.Duration, error) {
+	requestedAt := r.requestedAt(opsRes)
+	for _, v := range opsRes.OpsRequest.Spec.RotatePasswordList {
+		comps, err := r.listComponents(reqCtx, cli, opsRes.Cluster, v.ComponentName)
+		if err != nil {
+			return "", 0, err
+		}
+		for _, comp := range comps {
+			compName := comp.Labels[constant.KBAppComponentLabelKey]
+			for _, accountName := range v.AccountNames {
+				secret, err := r.getAccountSecret(reqCtx, cli, opsRes.Cluster, compName, accountName)
+				if err != nil {
+					// the account secret is being re-created
+					if apierrors.IsNotFound(err) {
+						return appsv1alpha1.OpsRunningPhase, 5 * time.Second, nil
+					}
+					return "", 0, err
+				}
+				rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[constant.PasswordRotatedAtAnnotationKey])
+				if err != nil || rotatedAt.Before(requestedAt) {
+					return appsv1alpha1.OpsRunningPhase, 5 * time.Second, nil
+				}
+			}
+		}
+	}
+	return appsv1alpha1.OpsSucceedPhase, 0, nil
+}
+
+// SaveLastConfiguration this operation doesn't modify the Cluster spec, no need to save the last configuration.
+func (r RotatePasswordOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
+	return nil
+}
+
+// requestedAt returns the time of the rotation request, truncated to the precision of the annotations.
+func (r RotatePasswordOpsHandler) requestedAt(opsRes *OpsResource) time.Time {
+	startTime := opsRes.OpsRequest.Status.StartTimestamp.Time
+	if startTime.IsZero() {
+		startTime = opsRes.OpsRequest.CreationTimestamp.Time
+	}
+	return startTime.UTC().Truncate(time.Second)
+}
+
+// listComponents lists the Components of a component or the shards of a sharding.
+func (r RotatePasswordOpsHandler) listComponents(reqCtx intctrlutil.RequestCtx, cli client.Client,
+	cluster *appsv1alpha1.Cluster, compName string) ([]appsv1alpha1.Component, error) {
+	compList := &appsv1alpha1.ComponentList{}
+	if err := cli.List(reqCtx.Ctx, compList, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{constant.AppInstanceLabelKey: cluster.Name}); err != nil {
+		return nil, err
+	}
+	comps := make([]appsv1alpha1.Component, 0)
+	for _, comp := range compList.Items {
+		if comp.Labels[constant.KBAppComponentLabelKey] == compName || comp.Labels[constant.KBAppShardingNameLabelKey] == compName {
+			comps = append(comps, comp)
+		}
+	}
+	if len(comps) == 0 {
+		return nil, intctrlutil.NewFatalError(fmt.Sprintf(`component "%s" is not found`, compName))
+	}
+	return comps, nil
+}
+
+func (r RotatePasswordOpsHandler) getAccountSecret(reqCtx intctrlutil.RequestCtx, cli client.Client,
+	cluster *appsv1alpha1.Cluster, compName, accountName string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{
+		Namespace: cluster.Namespace,
+		Name:      constant.GenerateAccountSecretName(cluster.Name, compName, accountName),
+	}
+	if err := cli.Get(reqCtx.Ctx, secretKey, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package operations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/generics"
+	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
+)
+
+var _ = Describe("RotatePassword OpsRequest", func() {
+
+	var (
+		randomStr             = testCtx.GetRandomStr()
+		clusterDefinitionName = "cluster-definition-for-ops-" + randomStr
+		clusterVersionName    = "clusterversion-for-ops-" + randomStr
+		clusterName           = "cluster-for-ops-" + randomStr
+		accountName           = "root"
+	)
+
+	cleanEnv := func() {
+		// must wait till resources deleted and no longer existed before the testcases start,
+		// otherwise if later it needs to create some new resource objects with the same name,
+		// in race conditions, it will find the existence of old objects, resulting failure to
+		// create the new objects.
+		By("clean resources")
+
+		// delete cluster(and all dependent sub-resources), clusterversion and clusterdef
+		testapps.ClearClusterResources(&testCtx)
+
+		// delete rest resources
+		inNS := client.InNamespace(testCtx.DefaultNamespace)
+		ml := client.HasLabels{testCtx.TestObjLabelKey}
+		// namespaced
+		testapps.ClearResources(&testCtx, generics.OpsRequestSignature, inNS, ml)
+		testapps.ClearResources(&testCtx, generics.ComponentSignature, inNS, ml)
+		testapps.ClearResources(&testCtx, generics.SecretSignature, inNS, ml)
+	}
+
+	BeforeEach(cleanEnv)
+
+	AfterEach(cleanEnv)
+
+	mockComponentAndAccount := func() (*appsv1alpha1.Component, *corev1.Secret) {
+		labels := constant.GetComponentWellKnownLabels(clusterName, consensusComp)
+		labels[testCtx.TestObjLabelKey] = "true"
+		comp := builder.NewComponentBuilder(testCtx.DefaultNamespace, constant.GenerateClusterComponentName(clusterName, consensusComp), "compdef").
+			AddLabelsInMap(labels).
+			GetObject()
+		testapps.CreateK8sResource(&testCtx, comp)
+		secret := builder.NewSecretBuilder(testCtx.DefaultNamespace, constant.GenerateAccountSecretName(clusterName, consensusComp, accountName)).
+			AddLabelsInMap(labels).
+			AddLabels(constant.ClusterAccountLabelKey, accountName).
+			PutData(constant.AccountNameForSecret, []byte(accountName)).
+			PutData(constant.AccountPasswdForSecret, []byte("password")).
+			GetObject()
+		testapps.CreateK8sResource(&testCtx, secret)
+		return comp, secret
+	}
+
+	Context("Test OpsRequest", func() {
+		It("Test rotatePassword OpsRequest", func() {
+			reqCtx := intctrlutil.RequestCtx{Ctx: ctx}
+			opsRes, _, _ := initOperationsResources(clusterDefinitionName, clusterVersionName, clusterName)
+			comp, secret := mockComponentAndAccount()
+
+			By("create RotatePassword opsRequest")
+			ops := testapps.NewOpsRequestObj("rotate-password-ops-"+randomStr, testCtx.DefaultNamespace,
+				clusterName, appsv1alpha1.RotatePasswordType)
+			ops.Spec.RotatePasswordList = []appsv1alpha1.RotatePassword{
+				{
+					ComponentOps: appsv1alpha1.ComponentOps{ComponentName: consensusComp},
+					AccountNames: []string{accountName},
+				},
+			}
+			opsRes.OpsRequest = testapps.CreateOpsRequest(ctx, testCtx, ops)
+			opsRes.OpsRequest.Status.StartTimestamp = opsRes.OpsRequest.CreationTimestamp
+
+			By("the rotation is requested on the component")
+			Expect(RotatePasswordOpsHandler{}.Action(reqCtx, k8sClient, opsRes)).Should(Succeed())
+			Eventually(testapps.CheckObj(&testCtx, client.ObjectKeyFromObject(comp), func(g Gomega, comp *appsv1alpha1.Component) {
+				g.Expect(comp.Annotations).Should(HaveKey(constant.RotatePasswordAnnotationPrefix + accountName))
+			})).Should(Succeed())
+
+			By("the rotation is running until the account secret is rotated")
+			phase, _, err := RotatePasswordOpsHandler{}.ReconcileAction(reqCtx, k8sClient, opsRes)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(phase).Should(Equal(appsv1alpha1.OpsRunningPhase))
+
+			Expect(testapps.ChangeObj(&testCtx, secret, func(secret *corev1.Secret) {
+				secret.Annotations = map[string]string{
+					constant.PasswordRotatedAtAnnotationKey: time.Now().Add(time.Minute).UTC().Format(time.RFC3339),
+				}
+			})).Should(Succeed())
+			Eventually(func(g Gomega) {
+				phase, _, err := RotatePasswordOpsHandler{}.ReconcileAction(reqCtx, k8sClient, opsRes)
+				g.Expect(err).ShouldNot(HaveOccurred())
+				g.Expect(phase).Should(Equal(appsv1alpha1.OpsSucceedPhase))
+			}).Should(Succeed())
+		})
+
+		It("Test rotatePassword OpsRequest with an unknown account", func() {
+			reqCtx := intctrlutil.RequestCtx{Ctx: ctx}
+			opsRes, _, _ := initOperationsResources(clusterDefinitionName, clusterVersionName, clusterName)
+			mockComponentAndAccount()
+			opsRes.OpsRequest = testapps.NewOpsRequestObj("rotate-password-ops-"+randomStr, testCtx.DefaultNamespace,
+				clusterName, appsv1alpha1.RotatePasswordType)
+			opsRes.OpsRequest.Spec.RotatePasswordList = []appsv1alpha1.RotatePassword{
+				{
+					ComponentOps: appsv1alpha1.ComponentOps{ComponentName: consensusComp},
+					AccountNames: []string{"unknown"},
+				},
+			}
+			err := RotatePasswordOpsHandler{}.Action(reqCtx, k8sClient, opsRes)
+			Expect(intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal)).Should(BeTrue())
+		})
+	})
+})
@@ -58,10 +58,7 @@ func (stop StopOpsHandler) ActionStartedCondition(reqCtx intctrlutil.RequestCtx,
 
 // Action modifies Cluster.spec.components[*].replicas from the opsRequest
 func (stop StopOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Client, opsRes *OpsResource) error {
-	var (
-		componentReplicasMap = map[string]int32{}
-		cluster              = opsRes.Cluster
-	)
+	cluster := opsRes.Cluster
 	// if the cluster is already stopping or stopped, return
 	if slices.Contains([]appsv1alpha1.ClusterPhase{appsv1alpha1.StoppedClusterPhase,
 		appsv1alpha1.StoppingClusterPhase}, opsRes.Cluster.Status.Phase) {
@@ -78,33 +75,9 @@ func (stop StopOpsHandler) Action(reqCtx intctrlutil.RequestCtx, cli client.Clie
 		}); err != nil {
 		return err
 	}
-	setReplicas := func(compSpec *appsv1alpha1.ClusterComponentSpec, componentName string) {
-		compKey := getComponentKeyForStartSnapshot(componentName, "")
-		componentReplicasMap[compKey] = compSpec.Replicas
-		expectReplicas := int32(0)
-		compSpec.Replicas = expectReplicas
-		for i := range compSpec.Instances {
-			compKey = getComponentKeyForStartSnapshot(componentName, compSpec.Instances[i].Name)
-			componentReplicasMap[compKey] = compSpec.Instances[i].GetReplicas()
-			compSpec.Instances[i].Replicas = &expectReplicas
-		}
-	}
-	for i := range cluster.Spec.ComponentSpecs {
-		compSpec := &cluster.Spec.ComponentSpecs[i]
-		setReplicas(compSpec, compSpec.Name)
-	}
-	for i, v := range cluster.Spec.ShardingSpecs {
-		setReplicas(&cluster.Spec.ShardingSpecs[i].Template, v.Name)
-	}
-	componentReplicasSnapshot, err := json.Marshal(componentReplicasMap)
-	if err != nil {
+	if err := stopClusterComponents(cluster); err != nil {
 		return err
 	}
-	if cluster.Annotations == nil {
-		cluster.Annotations = map[string]string{}
-	}
-	// record the replicas snapshot of components to the annotations of cluster before stopping the cluster.
-	cluster.Annotations[constant.SnapShotForStartAnnotationKey] = string(componentReplicasSnapshot)
 	return cli.Update(reqCtx.Ctx, cluster)
 }
 
@@ -135,6 +108,40 @@ func (stop StopOpsHandler) SaveLastConfiguration(reqCtx intctrlutil.RequestCtx,
 	return nil
 }
 
+// stopClusterComponents sets the replicas of all components to 0 and records the replicas snapshot
+// to the annotations of cluster, which is used to restore the replicas when starting the cluster.
+func stopClusterComponents(cluster *appsv1alpha1.Cluster) error {
+	componentReplicasMap := map[string]int32{}
+	setReplicas := func(compSpec *appsv1alpha1.ClusterComponentSpec, componentName string) {
+		compKey := getComponentKeyForStartSnapshot(componentName, "")
+		componentReplicasMap[compKey] = compSpec.Replicas
+		expectReplicas := int32(0)
+		compSpec.Replicas = expectReplicas
+		for i := range compSpec.Instances {
+			compKey = getComponentKeyForStartSnapshot(componentName, compSpec.Instances[i].Name)
+			componentReplicasMap[compKey] = compSpec.Instances[i].GetReplicas()
+			compSpec.Instances[i].Replicas = &expectReplicas
+		}
+	}
+	for i := range cluster.Spec.ComponentSpecs {
+		compSpec := &cluster.Spec.ComponentSpecs[i]
+		setReplicas(compSpec, compSpec.Name)
+	}
+	for i, v := range cluster.Spec.ShardingSpecs {
+		setReplicas(&cluster.Spec.ShardingSpecs[i].Template, v.Name)
+	}
+	componentReplicasSnapshot, err := json.Marshal(componentReplicasMap)
+	if err != nil {
+		return err
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	// record the replicas snapshot of components to the annotations of cluster before stopping the cluster.
+	cluster.Annotations[constant.SnapShotForStartAnnotationKey] = string(componentReplicasSnapshot)
+	return nil
+}
+
 func getComponentKeyForStartSnapshot(compName, templateName string) string {
 	if templateName != "" {
 		return fmt.Sprintf("%s.%s", compName, templateName)
@@ -54,6 +54,7 @@ func init() {
 		FromClusterPhases: appsv1alpha1.GetClusterUpRunningPhases(),
 		ToClusterPhase:    appsv1alpha1.UpdatingClusterPhase,
 		QueueByCluster:    true,
+		Disruptive:        true,
 		OpsHandler:        switchoverOpsHandler{},
 	}
 
@@ -68,6 +68,13 @@ type OpsBehaviour struct {
 	// QueueWithSelf indicates that the operation is queued for execution within opsType scope.
 	QueueBySelf bool
 
+	// Disruptive indicates that the operation may interrupt the service of the cluster, such as restarting the pods.
+	// The disruptive operation waits for the maintenance window of the cluster before it starts.
+	Disruptive bool
+
+	// IsDisruptive decides whether the OpsRequest is disruptive by its spec, it takes precedence over Disruptive if set.
+	IsDisruptive func(reqCtx intctrlutil.RequestCtx, cli client.Client, opsResource *OpsResource) (bool, error)
+
 	OpsHandler OpsHandler
 }
 
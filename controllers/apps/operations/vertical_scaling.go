@@ -44,6 +44,7 @@ func init() {
 		ToClusterPhase:    appsv1alpha1.UpdatingClusterPhase,
 		OpsHandler:        vsHandler,
 		QueueByCluster:    true,
+		Disruptive:        true,
 		CancelFunc:        vsHandler.Cancel,
 	}
 
@@ -171,7 +171,12 @@ func (r *OpsRequestReconciler) handleOpsRequestByPhase(reqCtx intctrlutil.Reques
 			return intctrlutil.ResultToP(intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, ""))
 		}
 		return intctrlutil.ResultToP(intctrlutil.Reconciled())
-	case appsv1alpha1.OpsPendingPhase, appsv1alpha1.OpsCreatingPhase:
+	case appsv1alpha1.OpsPendingPhase:
+		if isDryRun(opsRes.OpsRequest) {
+			return r.previewOpsRequest(reqCtx, opsRes)
+		}
+		return r.doOpsRequestAction(reqCtx, opsRes)
+	case appsv1alpha1.OpsCreatingPhase:
 		return r.doOpsRequestAction(reqCtx, opsRes)
 	case appsv1alpha1.OpsRunningPhase, appsv1alpha1.OpsCancellingPhase:
 		return r.reconcileStatusDuringRunningOrCanceling(reqCtx, opsRes)
@@ -328,6 +333,19 @@ func (r *OpsRequestReconciler) doOpsRequestAction(reqCtx intctrlutil.RequestCtx,
 	return intctrlutil.ResultToP(intctrlutil.Reconciled())
 }
 
+// previewOpsRequest renders the changes of the OpsRequest into a ConfigMap without performing it,
+// the OpsRequest keeps pending until the dry-run annotation is removed.
+func (r *OpsRequestReconciler) previewOpsRequest(reqCtx intctrlutil.RequestCtx, opsRes *operations.OpsResource) (*ctrl.Result, error) {
+	plan, err := previewOpsRequestPlan(reqCtx, r.Client, opsRes)
+	if err != nil {
+		return intctrlutil.ResultToP(intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, ""))
+	}
+	if err = writeDryRunPlan(reqCtx, r.Client, opsRes.OpsRequest, plan); err != nil {
+		return intctrlutil.ResultToP(intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, ""))
+	}
+	return intctrlutil.ResultToP(intctrlutil.Reconciled())
+}
+
 // handleOpsReqDeletedDuringRunning handles the cluster annotation if the OpsRequest is deleted during running.
 func (r *OpsRequestReconciler) handleOpsReqDeletedDuringRunning(reqCtx intctrlutil.RequestCtx) error {
 	clusterList := &appsv1alpha1.ClusterList{}
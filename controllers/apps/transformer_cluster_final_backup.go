@@ -0,0 +1,195 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+)
+
+const (
+	finalBackupCheckInterval  = 10 * time.Second
+	defaultFinalBackupTimeout = time.Hour
+)
+
+// clusterFinalBackupTransformer takes the final backup of a cluster before it's deleted,
+// it holds the deletion until the backup completes, or fails according to the failure policy.
+type clusterFinalBackupTransformer struct{}
+
+var _ graph.Transformer = &clusterFinalBackupTransformer{}
+
+func (t *clusterFinalBackupTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	transCtx, _ := ctx.(*clusterTransformContext)
+	cluster := transCtx.Cluster
+	finalBackup := cluster.Spec.FinalBackup
+	if !transCtx.OrigCluster.IsDeleting() || finalBackup == nil {
+		return nil
+	}
+	// the cluster is never deleted with DoNotTerminate, which is handled by the deletion transformer
+	if cluster.Spec.TerminationPolicy == appsv1alpha1.DoNotTerminate {
+		return nil
+	}
+
+	status := cluster.Status.FinalBackup
+	if status == nil {
+		status = &appsv1alpha1.ClusterFinalBackupStatus{BackupName: finalBackupName(cluster)}
+		cluster.Status.FinalBackup = status
+	}
+	switch {
+	case status.Phase == dpv1alpha1.BackupPhaseCompleted:
+		return nil
+	case status.Phase == dpv1alpha1.BackupPhaseFailed && finalBackup.FailurePolicy == appsv1alpha1.FinalBackupFailureProceed:
+		return nil
+	}
+
+	cluster.Status.Phase = appsv1alpha1.DeletingClusterPhase
+
+	backup := &dpv1alpha1.Backup{}
+	backupKey := client.ObjectKey{Namespace: cluster.Namespace, Name: status.BackupName}
+	if err := transCtx.Client.Get(transCtx.Context, backupKey, backup); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if backup, err = t.buildBackup(transCtx, status.BackupName); err != nil {
+			return t.handleFailure(transCtx, status, err.Error())
+		}
+		graphCli, _ := transCtx.Client.(model.GraphClient)
+		graphCli.Create(dag, backup)
+		transCtx.EventRecorder.Eventf(cluster, corev1.EventTypeNormal, "FinalBackupCreated",
+			"creating the final backup %s before deleting the cluster", backup.Name)
+		return newRequeueError(finalBackupCheckInterval, "wait for the final backup to complete")
+	}
+
+	status.Phase = backup.Status.Phase
+	switch backup.Status.Phase {
+	case dpv1alpha1.BackupPhaseCompleted:
+		status.Message = ""
+		transCtx.EventRecorder.Eventf(cluster, corev1.EventTypeNormal, "FinalBackupCompleted",
+			"the final backup %s is completed", backup.Name)
+		return nil
+	case dpv1alpha1.BackupPhaseFailed:
+		return t.handleFailure(transCtx, status, fmt.Sprintf("the final backup %s is failed: %s", backup.Name, backup.Status.FailureReason))
+	}
+
+	timeout := defaultFinalBackupTimeout
+	if finalBackup.Timeout != nil {
+		timeout = finalBackup.Timeout.Duration
+	}
+	if time.Since(backup.CreationTimestamp.Time) > timeout {
+		return t.handleFailure(transCtx, status, fmt.Sprintf("the final backup %s is not completed in %s", backup.Name, timeout))
+	}
+	return newRequeueError(finalBackupCheckInterval, "wait for the final backup to complete")
+}
+
+// handleFailure records the failure of the final backup, and blocks the deletion unless the failure policy is Proceed.
+func (t *clusterFinalBackupTransformer) handleFailure(transCtx *clusterTransformContext,
+	status *appsv1alpha1.ClusterFinalBackupStatus, message string) error {
+	cluster := transCtx.Cluster
+	status.Phase = dpv1alpha1.BackupPhaseFailed
+	status.Message = message
+	if cluster.Spec.FinalBackup.FailurePolicy == appsv1alpha1.FinalBackupFailureProceed {
+		transCtx.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "FinalBackupFailed",
+			"%s, proceed to delete the cluster", message)
+		return nil
+	}
+	transCtx.EventRecorder.Eventf(cluster, corev1.EventTypeWarning, "FinalBackupFailed",
+		"%s, the deletion is blocked until spec.finalBackup is removed or its failurePolicy is Proceed", message)
+	return graph.ErrPrematureStop
+}
+
+// buildBackup builds the final backup, which isn't owned by the cluster and is protected from the cluster deletion.
+func (t *clusterFinalBackupTransformer) buildBackup(transCtx *clusterTransformContext, name string) (*dpv1alpha1.Backup, error) {
+	cluster := transCtx.Cluster
+	finalBackup := cluster.Spec.FinalBackup
+
+	backupPolicyList := &dpv1alpha1.BackupPolicyList{}
+	if err := transCtx.Client.List(transCtx.Context, backupPolicyList, client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{constant.AppInstanceLabelKey: cluster.Name}); err != nil {
+		return nil, err
+	}
+	backupPolicyName := finalBackup.BackupPolicyName
+	if backupPolicyName == "" {
+		for _, backupPolicy := range backupPolicyList.Items {
+			if backupPolicy.Annotations[dptypes.DefaultBackupPolicyAnnotationKey] != "true" {
+				continue
+			}
+			if backupPolicyName != "" {
+				return nil, fmt.Errorf("cluster %s has multiple default backup policies", cluster.Name)
+			}
+			backupPolicyName = backupPolicy.Name
+		}
+		if backupPolicyName == "" {
+			return nil, fmt.Errorf("not found any default backup policy for cluster %s", cluster.Name)
+		}
+	}
+	if _, methods := utils.GetBackupMethodsFromBackupPolicy(backupPolicyList, backupPolicyName); len(methods) == 0 {
+		return nil, fmt.Errorf("backup policy %s is not found or not available", backupPolicyName)
+	} else if _, ok := methods[finalBackup.Method]; !ok {
+		return nil, fmt.Errorf("backup method %s is not found in backup policy %s", finalBackup.Method, backupPolicyName)
+	}
+	if finalBackup.RetentionPeriod != "" {
+		if _, err := finalBackup.RetentionPeriod.ToDuration(); err != nil {
+			return nil, err
+		}
+	}
+
+	backup := &dpv1alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      name,
+			Labels: map[string]string{
+				constant.AppInstanceLabelKey:      cluster.Name,
+				constant.BackupProtectionLabelKey: constant.BackupRetain,
+			},
+		},
+		Spec: dpv1alpha1.BackupSpec{
+			BackupPolicyName: backupPolicyName,
+			BackupMethod:     finalBackup.Method,
+			RetentionPeriod:  finalBackup.RetentionPeriod,
+		},
+	}
+	if finalBackup.RepoName != "" {
+		backup.Labels[dptypes.BackupRepoLabelKey] = finalBackup.RepoName
+	}
+	return backup, nil
+}
+
+// finalBackupName returns the name of the final backup, the cluster UID tells it apart from
+// the final backups of the deleted clusters with the same name.
+func finalBackupName(cluster *appsv1alpha1.Cluster) string {
+	uid := string(cluster.UID)
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	return fmt.Sprintf("%s-final-%s", cluster.Name, uid)
+}
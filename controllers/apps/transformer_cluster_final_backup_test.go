@@ -0,0 +1,166 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
+)
+
+var _ = Describe("clusterFinalBackupTransformer", func() {
+	var (
+		transCtx     *clusterTransformContext
+		reader       *mockReader
+		dag          *graph.DAG
+		cluster      *appsv1alpha1.Cluster
+		backupPolicy *dpv1alpha1.BackupPolicy
+	)
+
+	newTransCtx := func() {
+		transCtx = &clusterTransformContext{
+			Context:       testCtx.Ctx,
+			Client:        model.NewGraphClient(reader),
+			EventRecorder: clusterRecorder,
+			Logger:        logger,
+			Cluster:       cluster.DeepCopy(),
+			OrigCluster:   cluster,
+		}
+		dag = graph.NewDAG()
+		transCtx.Client.(model.GraphClient).Root(dag, transCtx.OrigCluster, transCtx.Cluster, model.ActionStatusPtr())
+	}
+
+	BeforeEach(func() {
+		cluster = testapps.NewClusterFactory(testCtx.DefaultNamespace, "test-cluster", "", "").
+			AddComponentV2("comp1", "compdef1").
+			SetTerminationPolicy(appsv1alpha1.WipeOut).
+			GetObject()
+		cluster.UID = "5e3b7f0c-8d2a-4e4f-9d51-2f9c2a6b7c11"
+		cluster.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		cluster.Spec.FinalBackup = &appsv1alpha1.ClusterFinalBackup{
+			Method:          "xtrabackup",
+			RepoName:        "my-repo",
+			RetentionPeriod: "30d",
+		}
+
+		backupPolicy = &dpv1alpha1.BackupPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testCtx.DefaultNamespace,
+				Name:        "test-cluster-comp1-backup-policy",
+				Labels:      map[string]string{constant.AppInstanceLabelKey: cluster.Name},
+				Annotations: map[string]string{dptypes.DefaultBackupPolicyAnnotationKey: "true"},
+			},
+			Spec: dpv1alpha1.BackupPolicySpec{
+				BackupMethods: []dpv1alpha1.BackupMethod{{Name: "xtrabackup"}},
+			},
+			Status: dpv1alpha1.BackupPolicyStatus{Phase: dpv1alpha1.AvailablePhase},
+		}
+		reader = &mockReader{objs: []client.Object{backupPolicy}}
+		newTransCtx()
+	})
+
+	getBackup := func() *dpv1alpha1.Backup {
+		for _, obj := range transCtx.Client.(model.GraphClient).FindAll(dag, &dpv1alpha1.Backup{}) {
+			return obj.(*dpv1alpha1.Backup)
+		}
+		return nil
+	}
+
+	setBackupPhase := func(backup *dpv1alpha1.Backup, phase dpv1alpha1.BackupPhase) {
+		backup.CreationTimestamp = metav1.Now()
+		backup.Status.Phase = phase
+		reader.objs = []client.Object{backupPolicy, backup}
+		cluster.Status = *transCtx.Cluster.Status.DeepCopy()
+		newTransCtx()
+	}
+
+	It("creates the final backup and waits for it to complete", func() {
+		transformer := &clusterFinalBackupTransformer{}
+		err := transformer.Transform(transCtx, dag)
+		Expect(intctrlutil.IsRequeueError(err)).Should(BeTrue())
+
+		backup := getBackup()
+		Expect(backup).ShouldNot(BeNil())
+		Expect(backup.Name).Should(Equal("test-cluster-final-5e3b7f0c"))
+		Expect(backup.OwnerReferences).Should(BeEmpty())
+		Expect(backup.Labels).Should(HaveKeyWithValue(constant.BackupProtectionLabelKey, constant.BackupRetain))
+		Expect(backup.Labels).Should(HaveKeyWithValue(dptypes.BackupRepoLabelKey, "my-repo"))
+		Expect(backup.Spec.BackupPolicyName).Should(Equal(backupPolicy.Name))
+		Expect(backup.Spec.BackupMethod).Should(Equal("xtrabackup"))
+		Expect(backup.Spec.RetentionPeriod).Should(BeEquivalentTo("30d"))
+		Expect(transCtx.Cluster.Status.FinalBackup.BackupName).Should(Equal(backup.Name))
+
+		By("the backup is running")
+		setBackupPhase(backup, dpv1alpha1.BackupPhaseRunning)
+		err = transformer.Transform(transCtx, dag)
+		Expect(intctrlutil.IsRequeueError(err)).Should(BeTrue())
+		Expect(transCtx.Cluster.Status.FinalBackup.Phase).Should(Equal(dpv1alpha1.BackupPhaseRunning))
+
+		By("the backup is completed")
+		setBackupPhase(backup, dpv1alpha1.BackupPhaseCompleted)
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+		Expect(transCtx.Cluster.Status.FinalBackup.Phase).Should(Equal(dpv1alpha1.BackupPhaseCompleted))
+	})
+
+	It("blocks or proceeds the deletion if the final backup fails", func() {
+		transformer := &clusterFinalBackupTransformer{}
+		Expect(intctrlutil.IsRequeueError(transformer.Transform(transCtx, dag))).Should(BeTrue())
+
+		backup := getBackup()
+		setBackupPhase(backup, dpv1alpha1.BackupPhaseFailed)
+		Expect(transformer.Transform(transCtx, dag)).Should(Equal(graph.ErrPrematureStop))
+		Expect(transCtx.Cluster.Status.FinalBackup.Phase).Should(Equal(dpv1alpha1.BackupPhaseFailed))
+
+		cluster.Spec.FinalBackup.FailurePolicy = appsv1alpha1.FinalBackupFailureProceed
+		setBackupPhase(backup, dpv1alpha1.BackupPhaseFailed)
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+	})
+
+	It("skips the final backup if the cluster is not terminated", func() {
+		cluster.Spec.TerminationPolicy = appsv1alpha1.DoNotTerminate
+		newTransCtx()
+		transformer := &clusterFinalBackupTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+		Expect(getBackup()).Should(BeNil())
+		Expect(transCtx.Cluster.Status.FinalBackup).Should(BeNil())
+	})
+
+	It("fails if the backup method is not found", func() {
+		cluster.Spec.FinalBackup.Method = "not-exist"
+		newTransCtx()
+		transformer := &clusterFinalBackupTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Equal(graph.ErrPrematureStop))
+		Expect(getBackup()).Should(BeNil())
+		Expect(transCtx.Cluster.Status.FinalBackup.Message).Should(ContainSubstring("backup method not-exist is not found"))
+	})
+})
@@ -0,0 +1,437 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/restore"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
+)
+
+const (
+	standbyBackupWaitInterval = 30 * time.Second
+	standbyLagProbeInterval   = 30 * time.Second
+	standbyLagProbeTimeout    = 10 * time.Second
+)
+
+// clusterStandbyTransformer bootstraps a standby cluster from the backups of its primary cluster,
+// tells the components where to replicate from, and reports the replication lag.
+type clusterStandbyTransformer struct {
+	lagProber *standbyLagProber
+}
+
+var _ graph.Transformer = &clusterStandbyTransformer{}
+
+func (t *clusterStandbyTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	transCtx, _ := ctx.(*clusterTransformContext)
+	if model.IsObjectDeleting(transCtx.OrigCluster) {
+		t.lagProber.forget(client.ObjectKeyFromObject(transCtx.OrigCluster))
+		return nil
+	}
+
+	cluster := transCtx.Cluster
+	if cluster.Spec.Standby == nil {
+		t.lagProber.forget(client.ObjectKeyFromObject(cluster))
+		return t.handlePromoted(transCtx)
+	}
+
+	status := cluster.Status.Standby
+	if status == nil {
+		status = &appsv1alpha1.ClusterStandbyStatus{Phase: appsv1alpha1.StandbyBootstrappingPhase}
+		cluster.Status.Standby = status
+	}
+
+	primary, err := t.getPrimaryCluster(transCtx)
+	if err != nil {
+		return err
+	}
+
+	if err = t.bootstrap(transCtx, primary, status); err != nil {
+		return err
+	}
+
+	if err = t.resolveEndpoints(transCtx, primary, status); err != nil {
+		return err
+	}
+
+	if cluster.Status.Phase != appsv1alpha1.RunningClusterPhase {
+		return nil
+	}
+	status.Phase = appsv1alpha1.StandbyReplicatingPhase
+	t.probeLag(transCtx, status)
+	return intctrlutil.NewDelayedRequeueError(standbyLagProbeInterval, "probe the replication lag of the standby cluster")
+}
+
+// handlePromoted stops the components of a promoted cluster from following the old primary cluster.
+// Components keep the annotations they have, so the standby source is reset to empty explicitly.
+func (t *clusterStandbyTransformer) handlePromoted(transCtx *clusterTransformContext) error {
+	cluster := transCtx.Cluster
+	if cluster.Status.Standby == nil {
+		return nil
+	}
+
+	reset := true
+	for _, compSpec := range cluster.Spec.ComponentSpecs {
+		setCompAnnotation(transCtx, compSpec.Name, constant.StandbySourceAnnotationKey, "")
+
+		comp := &appsv1alpha1.Component{}
+		compKey := client.ObjectKey{Namespace: cluster.Namespace, Name: component.FullName(cluster.Name, compSpec.Name)}
+		if err := transCtx.Client.Get(transCtx.Context, compKey, comp); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if comp.Annotations[constant.StandbySourceAnnotationKey] != "" {
+			reset = false
+		}
+	}
+	if reset {
+		cluster.Status.Standby = nil
+	}
+	return nil
+}
+
+// getPrimaryCluster returns the primary cluster, nil if it doesn't exist, e.g. it has been lost in a disaster.
+func (t *clusterStandbyTransformer) getPrimaryCluster(transCtx *clusterTransformContext) (*appsv1alpha1.Cluster, error) {
+	standby := transCtx.Cluster.Spec.Standby
+	namespace := standby.PrimaryClusterNamespace
+	if namespace == "" {
+		namespace = transCtx.Cluster.Namespace
+	}
+	primary := &appsv1alpha1.Cluster{}
+	if err := transCtx.Client.Get(transCtx.Context, client.ObjectKey{Namespace: namespace, Name: standby.PrimaryCluster}, primary); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return primary, nil
+}
+
+// bootstrap restores a new standby cluster from the backups of the primary cluster.
+func (t *clusterStandbyTransformer) bootstrap(transCtx *clusterTransformContext,
+	primary *appsv1alpha1.Cluster, status *appsv1alpha1.ClusterStandbyStatus) error {
+	cluster := transCtx.Cluster
+	if len(status.Backups) > 0 || cluster.Status.Phase != "" {
+		return nil
+	}
+	if primary == nil {
+		return intctrlutil.NewFatalError(fmt.Sprintf("the primary cluster %s of the standby cluster is not found",
+			cluster.Spec.Standby.PrimaryCluster))
+	}
+
+	backups, err := t.getBootstrapBackups(transCtx, primary)
+	if err != nil {
+		return err
+	}
+
+	restoreInfo := map[string]map[string]string{}
+	status.Backups = map[string]string{}
+	for compName, backup := range backups {
+		annotation, err := restore.GetRestoreFromBackupAnnotation(backup, string(dpv1alpha1.VolumeClaimRestorePolicyParallel), "", false)
+		if err != nil {
+			return err
+		}
+		compRestoreInfo := map[string]map[string]string{}
+		if err = json.Unmarshal([]byte(annotation), &compRestoreInfo); err != nil {
+			return err
+		}
+		for name, info := range compRestoreInfo {
+			restoreInfo[name] = info
+		}
+		status.Backups[compName] = backup.Name
+	}
+	annotation, err := json.Marshal(restoreInfo)
+	if err != nil {
+		return err
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[constant.RestoreFromBackupAnnotationKey] = string(annotation)
+	for _, compSpec := range cluster.Spec.ComponentSpecs {
+		setCompAnnotation(transCtx, compSpec.Name, constant.RestoreFromBackupAnnotationKey, string(annotation))
+	}
+	return nil
+}
+
+// getBootstrapBackups returns the backup to restore for each component, keyed by the component name.
+func (t *clusterStandbyTransformer) getBootstrapBackups(transCtx *clusterTransformContext,
+	primary *appsv1alpha1.Cluster) (map[string]*dpv1alpha1.Backup, error) {
+	backups := map[string]*dpv1alpha1.Backup{}
+	if backupName := transCtx.Cluster.Spec.Standby.BackupName; backupName != "" {
+		backup := &dpv1alpha1.Backup{}
+		if err := transCtx.Client.Get(transCtx.Context, client.ObjectKey{Namespace: primary.Namespace, Name: backupName}, backup); err != nil {
+			return nil, err
+		}
+		if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+			return nil, intctrlutil.NewRequeueError(standbyBackupWaitInterval,
+				fmt.Sprintf("wait for the backup %s to be completed, current phase: %s", backupName, backup.Status.Phase))
+		}
+		backups[backup.Labels[constant.KBAppComponentLabelKey]] = backup
+		return backups, nil
+	}
+
+	for _, compSpec := range transCtx.Cluster.Spec.ComponentSpecs {
+		backupList := &dpv1alpha1.BackupList{}
+		if err := transCtx.Client.List(transCtx.Context, backupList, client.InNamespace(primary.Namespace),
+			client.MatchingLabels{
+				constant.AppInstanceLabelKey:    primary.Name,
+				constant.KBAppComponentLabelKey: compSpec.Name,
+			}); err != nil {
+			return nil, err
+		}
+		var candidates []*dpv1alpha1.Backup
+		for i, backup := range backupList.Items {
+			if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted ||
+				backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous) ||
+				backup.Status.CompletionTimestamp == nil {
+				continue
+			}
+			candidates = append(candidates, &backupList.Items[i])
+		}
+		if len(candidates) == 0 {
+			return nil, intctrlutil.NewRequeueError(standbyBackupWaitInterval,
+				fmt.Sprintf("wait for a completed backup of the component %s of the primary cluster %s", compSpec.Name, primary.Name))
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].Status.CompletionTimestamp.After(candidates[j].Status.CompletionTimestamp.Time)
+		})
+		backups[compSpec.Name] = candidates[0]
+	}
+	return backups, nil
+}
+
+// resolveEndpoints tells the components the endpoint of the primary components to replicate from.
+func (t *clusterStandbyTransformer) resolveEndpoints(transCtx *clusterTransformContext,
+	primary *appsv1alpha1.Cluster, status *appsv1alpha1.ClusterStandbyStatus) error {
+	if status.Components == nil {
+		status.Components = map[string]appsv1alpha1.StandbyComponentStatus{}
+	}
+	var unresolved []string
+	for _, compSpec := range transCtx.Cluster.Spec.ComponentSpecs {
+		endpoint, err := t.resolveEndpoint(transCtx, primary, compSpec.Name)
+		if err != nil {
+			return err
+		}
+		compStatus := status.Components[compSpec.Name]
+		if endpoint == "" {
+			// the primary cluster is lost, keep following the last known endpoint.
+			endpoint = compStatus.PrimaryEndpoint
+		}
+		if endpoint == "" {
+			unresolved = append(unresolved, compSpec.Name)
+			continue
+		}
+		setCompAnnotation(transCtx, compSpec.Name, constant.StandbySourceAnnotationKey, endpoint)
+		compStatus.PrimaryEndpoint = endpoint
+		status.Components[compSpec.Name] = compStatus
+	}
+	status.Message = ""
+	if len(unresolved) > 0 {
+		status.Message = fmt.Sprintf("the primary endpoint of components %s is not resolved", strings.Join(unresolved, ","))
+	}
+	return nil
+}
+
+func (t *clusterStandbyTransformer) resolveEndpoint(transCtx *clusterTransformContext,
+	primary *appsv1alpha1.Cluster, compName string) (string, error) {
+	for _, endpoint := range transCtx.Cluster.Spec.Standby.Endpoints {
+		if endpoint.ComponentName == compName {
+			return net.JoinHostPort(endpoint.Host, strconv.Itoa(int(endpoint.Port))), nil
+		}
+	}
+	if primary == nil {
+		return "", nil
+	}
+
+	// the default service of the primary component, which lives in the data-plane where the primary cluster is placed.
+	svc := &corev1.Service{}
+	svcKey := client.ObjectKey{Namespace: primary.Namespace, Name: constant.GenerateDefaultComponentServiceName(primary.Name, compName)}
+	if err := transCtx.Client.Get(intoContext(transCtx.Context, placement(primary)), svcKey, svc, inDataContext4C()); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if len(svc.Spec.Ports) == 0 {
+		return "", nil
+	}
+	host := fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+	return net.JoinHostPort(host, strconv.Itoa(int(svc.Spec.Ports[0].Port))), nil
+}
+
+// probeLag records the maximum replication lag of the replicas of each component. The lag is probed
+// in the background, and the result is recorded by the following reconciliations.
+func (t *clusterStandbyTransformer) probeLag(transCtx *clusterTransformContext, status *appsv1alpha1.ClusterStandbyStatus) {
+	cluster := transCtx.Cluster
+	clusterKey := client.ObjectKeyFromObject(cluster)
+	for _, compSpec := range cluster.Spec.ComponentSpecs {
+		compStatus := status.Components[compSpec.Name]
+		if result, ok := t.lagProber.result(clusterKey, compSpec.Name); ok &&
+			(compStatus.LastProbeTime == nil || result.probeTime.After(compStatus.LastProbeTime.Time)) {
+			compStatus.LagSeconds = result.lag
+			compStatus.LastProbeTime = &result.probeTime
+			status.Components[compSpec.Name] = compStatus
+		}
+		if compStatus.LastProbeTime != nil && time.Since(compStatus.LastProbeTime.Time) < standbyLagProbeInterval {
+			continue
+		}
+		pods, err := component.ListOwnedPods(transCtx.Context, transCtx.Client, cluster.Namespace, cluster.Name, compSpec.Name)
+		if err != nil {
+			transCtx.Logger.Info("list pods failed", "component", compSpec.Name, "error", err.Error())
+			continue
+		}
+		t.lagProber.probe(transCtx.Client, clusterKey, compSpec.Name, pods, transCtx.Logger)
+	}
+}
+
+// standbyLagResult is the replication lag probed from the replicas of a component.
+type standbyLagResult struct {
+	lag       *int64
+	probeTime metav1.Time
+}
+
+// standbyLagProber probes the replication lag through lorry in the background,
+// so the reconciliation never waits for the replicas to answer. A nil prober
+// probes nothing, e.g. when previewing the plan of a dry-run.
+type standbyLagProber struct {
+	sync.Mutex
+	// the components being probed and the probed results, keyed by the cluster
+	probing map[client.ObjectKey]map[string]bool
+	results map[client.ObjectKey]map[string]standbyLagResult
+}
+
+func newStandbyLagProber() *standbyLagProber {
+	return &standbyLagProber{
+		probing: map[client.ObjectKey]map[string]bool{},
+		results: map[client.ObjectKey]map[string]standbyLagResult{},
+	}
+}
+
+func (p *standbyLagProber) result(cluster client.ObjectKey, compName string) (standbyLagResult, bool) {
+	if p == nil {
+		return standbyLagResult{}, false
+	}
+	p.Lock()
+	defer p.Unlock()
+	result, ok := p.results[cluster][compName]
+	return result, ok
+}
+
+// probe starts probing the lag of the component, nothing is done if the component is being probed.
+// The reader reads the credentials of the secured lorry.
+func (p *standbyLagProber) probe(reader client.Reader, cluster client.ObjectKey, compName string, pods []*corev1.Pod, logger logr.Logger) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	if p.probing[cluster][compName] {
+		return
+	}
+	if p.probing[cluster] == nil {
+		p.probing[cluster] = map[string]bool{}
+	}
+	p.probing[cluster][compName] = true
+
+	go func() {
+		lag := probeMaxLag(reader, pods, logger)
+		p.Lock()
+		defer p.Unlock()
+		// the cluster is forgotten while probing
+		if !p.probing[cluster][compName] {
+			return
+		}
+		delete(p.probing[cluster], compName)
+		if p.results[cluster] == nil {
+			p.results[cluster] = map[string]standbyLagResult{}
+		}
+		p.results[cluster][compName] = standbyLagResult{lag: lag, probeTime: metav1.Now()}
+	}()
+}
+
+// forget drops the results of the cluster, which is deleted or promoted.
+func (p *standbyLagProber) forget(cluster client.ObjectKey) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	delete(p.probing, cluster)
+	delete(p.results, cluster)
+}
+
+func probeMaxLag(reader client.Reader, pods []*corev1.Pod, logger logr.Logger) *int64 {
+	var maxLag *int64
+	for _, pod := range pods {
+		ctx, cancel := context.WithTimeout(context.Background(), standbyLagProbeTimeout)
+		lorryCli, err := lorry.NewClient(ctx, reader, *pod)
+		if err != nil || intctrlutil.IsNil(lorryCli) {
+			cancel()
+			continue
+		}
+		lag, err := lorryCli.GetLag(ctx)
+		cancel()
+		if err != nil {
+			logger.Info("get replication lag failed", "pod", pod.Name, "error", err.Error())
+			continue
+		}
+		if maxLag == nil || lag > *maxLag {
+			maxLag = &lag
+		}
+	}
+	return maxLag
+}
+
+func setCompAnnotation(transCtx *clusterTransformContext, compName, key, value string) {
+	if transCtx.Annotations == nil {
+		transCtx.Annotations = map[string]map[string]string{}
+	}
+	if transCtx.Annotations[compName] == nil {
+		transCtx.Annotations[compName] = map[string]string{}
+	}
+	transCtx.Annotations[compName][key] = value
+}
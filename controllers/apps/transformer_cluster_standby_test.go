@@ -0,0 +1,87 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
+)
+
+var _ = Describe("standbyLagProber", func() {
+	var (
+		clusterKey = client.ObjectKey{Namespace: "default", Name: "standby"}
+		pods       = []*corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "standby-mysql-0"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "standby-mysql-1"}},
+		}
+	)
+
+	AfterEach(func() {
+		lorry.UnsetMockClient()
+	})
+
+	It("probes the max lag of the replicas in the background", func() {
+		release := make(chan struct{})
+		mockLorryClient(func(recorder *lorry.MockClientMockRecorder) {
+			lag := int64(0)
+			recorder.GetLag(gomock.Any()).DoAndReturn(func(context.Context) (int64, error) {
+				<-release
+				lag += 5
+				return lag, nil
+			}).Times(2)
+		})
+
+		prober := newStandbyLagProber()
+		prober.probe(nil, clusterKey, "mysql", pods, logger)
+		// the component is being probed, and the probe doesn't wait for the replicas
+		prober.probe(nil, clusterKey, "mysql", pods, logger)
+		_, ok := prober.result(clusterKey, "mysql")
+		Expect(ok).Should(BeFalse())
+
+		close(release)
+		Eventually(func(g Gomega) {
+			result, ok := prober.result(clusterKey, "mysql")
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(*result.lag).Should(Equal(int64(10)))
+		}).Within(time.Second).Should(Succeed())
+
+		prober.forget(clusterKey)
+		_, ok = prober.result(clusterKey, "mysql")
+		Expect(ok).Should(BeFalse())
+	})
+
+	It("probes nothing with a nil prober", func() {
+		var prober *standbyLagProber
+		prober.probe(nil, clusterKey, "mysql", pods, logger)
+		_, ok := prober.result(clusterKey, "mysql")
+		Expect(ok).Should(BeFalse())
+		prober.forget(clusterKey)
+	})
+})
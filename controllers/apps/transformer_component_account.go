@@ -22,6 +22,7 @@ package apps
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -64,6 +65,18 @@ func (t *componentAccountTransformer) Transform(ctx graph.TransformContext, dag
 		if exist {
 			continue
 		}
+		// the immutable account secret created by the former versions is deleted after its password has been
+		// rotated in the engine, re-create it with the pending password.
+		rotating, err := getRotatingAccountSecret(transCtx, synthesizeComp, account.Name)
+		if err != nil {
+			return err
+		}
+		if rotating != nil && rotating.Annotations[constant.PasswordAppliedAnnotationKey] == "true" {
+			secret := t.buildRotatedAccountSecret(synthesizeComp, account, rotating)
+			graphCli.Create(dag, secret, inUniversalContext4G())
+			graphCli.Delete(dag, rotating, inUniversalContext4G())
+			continue
+		}
 		secret, err := t.buildAccountSecret(transCtx, synthesizeComp, account)
 		if err != nil {
 			return err
@@ -144,6 +157,24 @@ func (t *componentAccountTransformer) generatePassword(account appsv1alpha1.Syst
 	return []byte(passwd)
 }
 
+func (t *componentAccountTransformer) buildRotatedAccountSecret(synthesizeComp *component.SynthesizedComponent,
+	account appsv1alpha1.SystemAccount, rotating *corev1.Secret) *corev1.Secret {
+	secret := t.buildAccountSecretWithPassword(synthesizeComp, account, rotating.Data[constant.AccountPasswdForSecret])
+	secret.SetAnnotations(buildPasswordRotatedAnnotations(synthesizeComp.PasswordRotations[account.Name], time.Now()))
+	return secret
+}
+
+// buildPasswordRotatedAnnotations builds the annotations of the account secret whose password is rotated at the time.
+func buildPasswordRotatedAnnotations(policy appsv1alpha1.PasswordRotationPolicy, rotatedAt time.Time) map[string]string {
+	annotations := map[string]string{
+		constant.PasswordRotatedAtAnnotationKey: rotatedAt.UTC().Format(time.RFC3339),
+	}
+	if policy.GracePeriod != nil && policy.GracePeriod.Duration > 0 {
+		annotations[constant.PreviousPasswordValidUntilAnnotationKey] = rotatedAt.Add(policy.GracePeriod.Duration).UTC().Format(time.RFC3339)
+	}
+	return annotations
+}
+
 func (t *componentAccountTransformer) buildAccountSecretWithPassword(synthesizeComp *component.SynthesizedComponent,
 	account appsv1alpha1.SystemAccount, password []byte) *corev1.Secret {
 	secretName := constant.GenerateAccountSecretName(synthesizeComp.ClusterName, synthesizeComp.Name, account.Name)
@@ -153,6 +184,5 @@ func (t *componentAccountTransformer) buildAccountSecretWithPassword(synthesizeC
 		AddLabels(constant.ClusterAccountLabelKey, account.Name).
 		PutData(constant.AccountNameForSecret, []byte(account.Name)).
 		PutData(constant.AccountPasswdForSecret, password).
-		SetImmutable(true).
 		GetObject()
 }
@@ -65,6 +65,10 @@ func (t *componentAccountProvisionTransformer) Transform(ctx graph.TransformCont
 	if len(transCtx.SynthesizeComponent.SystemAccounts) == 0 {
 		return nil
 	}
+	// the accounts are provisioned by lorry directly, which can't be previewed
+	if transCtx.DryRun {
+		return nil
+	}
 	if transCtx.Component.Status.Phase != appsv1alpha1.RunningClusterCompPhase {
 		return nil
 	}
@@ -201,7 +205,7 @@ func (t *componentAccountProvisionTransformer) buildLorryClient(transCtx *compon
 		return nil, fmt.Errorf("unable to find appropriate pods to create accounts")
 	}
 
-	lorryCli, err := lorry.NewClient(*pods[0])
+	lorryCli, err := lorry.NewClient(transCtx.Context, transCtx.Client, *pods[0])
 	if err != nil {
 		return nil, err
 	}
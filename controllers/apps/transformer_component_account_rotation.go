@@ -0,0 +1,361 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/common"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	"github.com/apecloud/kubeblocks/pkg/controllerutil"
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
+)
+
+// accountRotationRetryInterval is the interval to check the progress of an in-flight password rotation.
+const accountRotationRetryInterval = 10 * time.Second
+
+// componentAccountRotationTransformer rotates the passwords of component system accounts,
+// and restarts the pods once the account secrets they consume are rotated.
+//
+// A rotation goes through the following steps:
+//  1. a rotating secret holding the new password is created when the rotation is due;
+//  2. the new password is set in the engine, and the rotating secret is marked as applied;
+//  3. the new password is written into the account secret in place, and the rotating secret is deleted;
+//  4. the old password is discarded in the engine when its grace period expires.
+//
+// The immutable account secrets created by the former versions can't be updated, they are deleted in step 3 and
+// re-created with the new password by the componentAccountTransformer instead.
+type componentAccountRotationTransformer struct{}
+
+var _ graph.Transformer = &componentAccountRotationTransformer{}
+
+func (t *componentAccountRotationTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	transCtx, _ := ctx.(*componentTransformContext)
+	if model.IsObjectDeleting(transCtx.ComponentOrig) {
+		return nil
+	}
+	if common.IsCompactMode(transCtx.ComponentOrig.Annotations) {
+		transCtx.V(1).Info("Component is in compact mode, no need to rotate account passwords", "component", client.ObjectKeyFromObject(transCtx.ComponentOrig))
+		return nil
+	}
+
+	var requeueAfter time.Duration
+	// the passwords are rotated by lorry directly, which can't be previewed
+	if !transCtx.DryRun {
+		for _, account := range transCtx.SynthesizeComponent.SystemAccounts {
+			after, err := t.rotate(transCtx, dag, account)
+			if err != nil {
+				return err
+			}
+			if after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+				requeueAfter = after
+			}
+		}
+	}
+
+	if err := t.setCredentialRotatedAt(transCtx); err != nil {
+		return err
+	}
+
+	if requeueAfter > 0 {
+		return controllerutil.NewDelayedRequeueError(requeueAfter, "wait for the next password rotation")
+	}
+	return nil
+}
+
+// rotate drives the password rotation of the account, and returns when the account needs to be checked again.
+func (t *componentAccountRotationTransformer) rotate(transCtx *componentTransformContext,
+	dag *graph.DAG, account appsv1alpha1.SystemAccount) (time.Duration, error) {
+	synthesizedComp := transCtx.SynthesizeComponent
+	policy, hasPolicy := synthesizedComp.PasswordRotations[account.Name]
+	requestedAt, hasRequest := t.getRotationRequest(transCtx.Component, account.Name)
+
+	secret, err := getAccountSecret(transCtx, synthesizedComp, account.Name)
+	if err != nil || secret == nil {
+		return 0, err
+	}
+	rotating, err := getRotatingAccountSecret(transCtx, synthesizedComp, account.Name)
+	if err != nil {
+		return 0, err
+	}
+	if rotating != nil {
+		return accountRotationRetryInterval, t.applyPassword(transCtx, dag, policy, secret, rotating)
+	}
+
+	graceUntil, graceOK := parseTimeAnnotation(secret, constant.PreviousPasswordValidUntilAnnotationKey)
+	if !hasPolicy && !hasRequest && !graceOK {
+		return 0, nil
+	}
+
+	now := time.Now()
+	if graceOK && !now.Before(graceUntil) {
+		if err = t.discardOldPassword(transCtx, dag, secret); err != nil {
+			return 0, err
+		}
+		graceOK = false
+	}
+
+	rotatedAt := getPasswordRotatedAt(secret)
+	if hasRequest && requestedAt.After(rotatedAt) {
+		t.createRotatingSecret(transCtx, dag, account)
+		return accountRotationRetryInterval, nil
+	}
+
+	var after time.Duration
+	if hasPolicy && policy.Interval.Duration > 0 {
+		due := rotatedAt.Add(policy.Interval.Duration)
+		if !now.Before(due) {
+			t.createRotatingSecret(transCtx, dag, account)
+			return accountRotationRetryInterval, nil
+		}
+		after = due.Sub(now)
+	}
+	if graceOK && (after == 0 || graceUntil.Sub(now) < after) {
+		after = graceUntil.Sub(now)
+	}
+	return after, nil
+}
+
+func (t *componentAccountRotationTransformer) getRotationRequest(comp *appsv1alpha1.Component, accountName string) (time.Time, bool) {
+	return parseTimeAnnotation(comp, constant.RotatePasswordAnnotationPrefix+accountName)
+}
+
+func (t *componentAccountRotationTransformer) createRotatingSecret(transCtx *componentTransformContext,
+	dag *graph.DAG, account appsv1alpha1.SystemAccount) {
+	synthesizedComp := transCtx.SynthesizeComponent
+	secretName := constant.GenerateAccountRotatingSecretName(synthesizedComp.ClusterName, synthesizedComp.Name, account.Name)
+	labels := constant.GetComponentWellKnownLabels(synthesizedComp.ClusterName, synthesizedComp.Name)
+	secret := builder.NewSecretBuilder(synthesizedComp.Namespace, secretName).
+		AddLabelsInMap(labels).
+		PutData(constant.AccountNameForSecret, []byte(account.Name)).
+		PutData(constant.AccountPasswdForSecret, (&componentAccountTransformer{}).generatePassword(account)).
+		GetObject()
+	graphCli, _ := transCtx.Client.(model.GraphClient)
+	graphCli.Create(dag, secret, inUniversalContext4G())
+}
+
+// applyPassword sets the pending password in the engine, and then writes it into the account secret.
+func (t *componentAccountRotationTransformer) applyPassword(transCtx *componentTransformContext, dag *graph.DAG,
+	policy appsv1alpha1.PasswordRotationPolicy, secret, rotating *corev1.Secret) error {
+	graphCli, _ := transCtx.Client.(model.GraphClient)
+	// the rotating secret is kept until the password is set in the engine, to retry with the same password
+	if rotating.Annotations[constant.PasswordAppliedAnnotationKey] != "true" {
+		lorryCli, err := t.buildLorryClient(transCtx)
+		if err != nil || controllerutil.IsNil(lorryCli) {
+			return err
+		}
+		username, password := rotating.Data[constant.AccountNameForSecret], rotating.Data[constant.AccountPasswdForSecret]
+		retainCurrent := policy.GracePeriod != nil && policy.GracePeriod.Duration > 0
+		if err = lorryCli.UpdateUserPassword(transCtx.Context, string(username), string(password), retainCurrent); err != nil {
+			return err
+		}
+		rotatingCopy := rotating.DeepCopy()
+		if rotatingCopy.Annotations == nil {
+			rotatingCopy.Annotations = map[string]string{}
+		}
+		rotatingCopy.Annotations[constant.PasswordAppliedAnnotationKey] = "true"
+		graphCli.Update(dag, rotating, rotatingCopy, inUniversalContext4G())
+		transCtx.EventRecorder.Event(transCtx.Component, corev1.EventTypeNormal, "PasswordRotated",
+			fmt.Sprintf("the password of account %s has been rotated", string(username)))
+		return nil
+	}
+
+	if secret.Immutable != nil && *secret.Immutable {
+		graphCli.Delete(dag, secret, inUniversalContext4G())
+		return nil
+	}
+	secretCopy := secret.DeepCopy()
+	secretCopy.Data[constant.AccountPasswdForSecret] = rotating.Data[constant.AccountPasswdForSecret]
+	if secretCopy.Annotations == nil {
+		secretCopy.Annotations = map[string]string{}
+	}
+	delete(secretCopy.Annotations, constant.PreviousPasswordValidUntilAnnotationKey)
+	for k, v := range buildPasswordRotatedAnnotations(policy, time.Now()) {
+		secretCopy.Annotations[k] = v
+	}
+	graphCli.Update(dag, secret, secretCopy, inUniversalContext4G())
+	// the rotating secret is deleted after the new password is written into the account secret
+	graphCli.Delete(dag, rotating, inUniversalContext4G())
+	graphCli.DependOn(dag, rotating, secretCopy)
+	return nil
+}
+
+func (t *componentAccountRotationTransformer) discardOldPassword(transCtx *componentTransformContext,
+	dag *graph.DAG, secret *corev1.Secret) error {
+	lorryCli, err := t.buildLorryClient(transCtx)
+	if err != nil || controllerutil.IsNil(lorryCli) {
+		return err
+	}
+	if err = lorryCli.DiscardOldUserPassword(transCtx.Context, string(secret.Data[constant.AccountNameForSecret])); err != nil {
+		return err
+	}
+	secretCopy := secret.DeepCopy()
+	delete(secretCopy.Annotations, constant.PreviousPasswordValidUntilAnnotationKey)
+	graphCli, _ := transCtx.Client.(model.GraphClient)
+	graphCli.Update(dag, secret, secretCopy, inUniversalContext4G())
+	return nil
+}
+
+// buildLorryClient builds the client to the writable replica, it returns nil if the component is not ready to change passwords.
+func (t *componentAccountRotationTransformer) buildLorryClient(transCtx *componentTransformContext) (lorry.Client, error) {
+	if transCtx.Component.Status.Phase != appsv1alpha1.RunningClusterCompPhase {
+		return nil, nil
+	}
+	return (&componentAccountProvisionTransformer{}).buildLorryClient(transCtx)
+}
+
+// setCredentialRotatedAt annotates the pod template with the latest rotation time of the account secrets referenced by
+// the containers, to restart the pods when any of them is rotated.
+func (t *componentAccountRotationTransformer) setCredentialRotatedAt(transCtx *componentTransformContext) error {
+	synthesizedComp := transCtx.SynthesizeComponent
+	if synthesizedComp.PodSpec == nil {
+		return nil
+	}
+	latest, err := t.getRunningCredentialRotatedAt(transCtx)
+	if err != nil {
+		return err
+	}
+	for _, name := range referencedSecretNames(synthesizedComp) {
+		secret := &corev1.Secret{}
+		if err = transCtx.Client.Get(transCtx.Context, types.NamespacedName{Namespace: synthesizedComp.Namespace, Name: name}, secret); err != nil {
+			// the account secret is being re-created during the rotation
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if _, ok := secret.Labels[constant.ClusterAccountLabelKey]; !ok {
+			continue
+		}
+		if rotatedAt, ok := parseTimeAnnotation(secret, constant.PasswordRotatedAtAnnotationKey); ok && rotatedAt.After(latest) {
+			latest = rotatedAt
+		}
+	}
+	if !latest.IsZero() {
+		setPodTemplateAnnotation(synthesizedComp, constant.CredentialRotatedAtAnnotationKey, latest.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// getRunningCredentialRotatedAt returns the rotation time annotated on the running workload, the annotation never
+// goes back even if the account secrets are absent temporarily.
+func (t *componentAccountRotationTransformer) getRunningCredentialRotatedAt(transCtx *componentTransformContext) (time.Time, error) {
+	synthesizedComp := transCtx.SynthesizeComponent
+	itsKey := types.NamespacedName{
+		Namespace: synthesizedComp.Namespace,
+		Name:      constant.GenerateWorkloadNamePattern(synthesizedComp.ClusterName, synthesizedComp.Name),
+	}
+	its := &workloads.InstanceSet{}
+	if err := transCtx.Client.Get(transCtx.Context, itsKey, its); err != nil {
+		return time.Time{}, client.IgnoreNotFound(err)
+	}
+	value, ok := its.Spec.Template.Annotations[constant.CredentialRotatedAtAnnotationKey]
+	if !ok {
+		return time.Time{}, nil
+	}
+	rotatedAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, nil
+	}
+	return rotatedAt, nil
+}
+
+// referencedSecretNames returns the names of secrets referenced by the env of containers.
+func referencedSecretNames(synthesizedComp *component.SynthesizedComponent) []string {
+	names := make([]string, 0)
+	appendName := func(env corev1.EnvVar) {
+		if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+			return
+		}
+		for _, name := range names {
+			if name == env.ValueFrom.SecretKeyRef.Name {
+				return
+			}
+		}
+		names = append(names, env.ValueFrom.SecretKeyRef.Name)
+	}
+	for _, env := range synthesizedComp.EnvVars {
+		appendName(env)
+	}
+	for _, containers := range [][]corev1.Container{synthesizedComp.PodSpec.InitContainers, synthesizedComp.PodSpec.Containers} {
+		for _, c := range containers {
+			for _, env := range c.Env {
+				appendName(env)
+			}
+		}
+	}
+	return names
+}
+
+func getAccountSecret(transCtx *componentTransformContext,
+	synthesizedComp *component.SynthesizedComponent, accountName string) (*corev1.Secret, error) {
+	name := constant.GenerateAccountSecretName(synthesizedComp.ClusterName, synthesizedComp.Name, accountName)
+	return getSecretIfExists(transCtx, synthesizedComp.Namespace, name)
+}
+
+func getRotatingAccountSecret(transCtx *componentTransformContext,
+	synthesizedComp *component.SynthesizedComponent, accountName string) (*corev1.Secret, error) {
+	name := constant.GenerateAccountRotatingSecretName(synthesizedComp.ClusterName, synthesizedComp.Name, accountName)
+	return getSecretIfExists(transCtx, synthesizedComp.Namespace, name)
+}
+
+func getSecretIfExists(transCtx *componentTransformContext, namespace, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := transCtx.Client.Get(transCtx.Context, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return secret, nil
+}
+
+// getPasswordRotatedAt returns the last rotation time of the account secret, which defaults to its creation time.
+func getPasswordRotatedAt(secret *corev1.Secret) time.Time {
+	if rotatedAt, ok := parseTimeAnnotation(secret, constant.PasswordRotatedAtAnnotationKey); ok {
+		return rotatedAt
+	}
+	return secret.CreationTimestamp.Time
+}
+
+func parseTimeAnnotation(obj client.Object, key string) (time.Time, bool) {
+	value, ok := obj.GetAnnotations()[key]
+	if !ok || strings.TrimSpace(value) == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
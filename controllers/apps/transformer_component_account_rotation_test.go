@@ -0,0 +1,88 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+)
+
+var _ = Describe("component account rotation transformer", func() {
+	Context("account secrets", func() {
+		It("defaults the rotation time to the creation time of the secret", func() {
+			createdAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(createdAt)},
+			}
+			Expect(getPasswordRotatedAt(secret).Equal(createdAt)).Should(BeTrue())
+
+			rotatedAt := time.Now().Truncate(time.Second)
+			secret.Annotations = map[string]string{
+				constant.PasswordRotatedAtAnnotationKey: rotatedAt.UTC().Format(time.RFC3339),
+			}
+			Expect(getPasswordRotatedAt(secret).Equal(rotatedAt)).Should(BeTrue())
+
+			secret.Annotations[constant.PasswordRotatedAtAnnotationKey] = "invalid"
+			Expect(getPasswordRotatedAt(secret).Equal(createdAt)).Should(BeTrue())
+		})
+
+		It("annotates the grace period of the previous password", func() {
+			rotatedAt := time.Now().Truncate(time.Second)
+			annotations := buildPasswordRotatedAnnotations(appsv1alpha1.PasswordRotationPolicy{}, rotatedAt)
+			Expect(annotations).Should(HaveLen(1))
+			Expect(annotations[constant.PasswordRotatedAtAnnotationKey]).Should(Equal(rotatedAt.UTC().Format(time.RFC3339)))
+
+			policy := appsv1alpha1.PasswordRotationPolicy{GracePeriod: &metav1.Duration{Duration: time.Hour}}
+			annotations = buildPasswordRotatedAnnotations(policy, rotatedAt)
+			Expect(annotations[constant.PreviousPasswordValidUntilAnnotationKey]).Should(Equal(rotatedAt.Add(time.Hour).UTC().Format(time.RFC3339)))
+		})
+
+		It("lists the secrets referenced by the containers", func() {
+			secretEnv := func(name, secret string) corev1.EnvVar {
+				return corev1.EnvVar{
+					Name: name,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+							Key:                  constant.AccountPasswdForSecret,
+						},
+					},
+				}
+			}
+			synthesizedComp := &component.SynthesizedComponent{
+				EnvVars: []corev1.EnvVar{secretEnv("PASSWORD", "account-root"), {Name: "PLAIN", Value: "value"}},
+				PodSpec: &corev1.PodSpec{
+					InitContainers: []corev1.Container{{Env: []corev1.EnvVar{secretEnv("PASSWORD", "account-root")}}},
+					Containers:     []corev1.Container{{Env: []corev1.EnvVar{secretEnv("PROXY_PASSWORD", "account-proxy")}}},
+				},
+			}
+			Expect(referencedSecretNames(synthesizedComp)).Should(Equal([]string{"account-root", "account-proxy"}))
+		})
+	})
+})
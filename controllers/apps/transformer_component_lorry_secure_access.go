@@ -0,0 +1,91 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	"github.com/apecloud/kubeblocks/pkg/controller/plan"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+// componentLorrySecureAccessTransformer issues the certificates and token to access Lorry of the component,
+// and renews the certificates before they expire.
+type componentLorrySecureAccessTransformer struct{}
+
+var _ graph.Transformer = &componentLorrySecureAccessTransformer{}
+
+func (t *componentLorrySecureAccessTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	transCtx, _ := ctx.(*componentTransformContext)
+	if model.IsObjectDeleting(transCtx.ComponentOrig) {
+		return nil
+	}
+	synthesizedComp := transCtx.SynthesizeComponent
+	if !viper.GetBool(constant.KBEnvLorrySecureAccessEnabled) || synthesizedComp.PodSpec == nil ||
+		intctrlutil.GetLorryContainer(synthesizedComp.PodSpec.Containers) == nil {
+		return nil
+	}
+
+	secretKey := types.NamespacedName{
+		Namespace: synthesizedComp.Namespace,
+		Name:      constant.GenerateLorrySecureAccessSecretName(synthesizedComp.ClusterName, synthesizedComp.Name),
+	}
+	existing := &corev1.Secret{}
+	if err := transCtx.Client.Get(transCtx.Context, secretKey, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		existing = nil
+	}
+
+	if existing != nil {
+		// the secret is re-issued if the certificate can't be parsed
+		if cert, err := plan.ParseTLSCert(existing, constant.CertName); err == nil {
+			renewAt := plan.GetTLSCertRenewalTime(cert, plan.DefaultTLSCertDuration, plan.DefaultTLSCertRenewBefore)
+			if time.Now().Before(renewAt) {
+				return intctrlutil.NewDelayedRequeueError(time.Until(renewAt), "renew the Lorry certificates before they expire")
+			}
+		}
+	}
+
+	// Lorry and the clients reload the renewed secret on their own, the pods are not restarted.
+	secret, err := plan.ComposeLorrySecureAccessSecret(synthesizedComp.Namespace, synthesizedComp.ClusterName,
+		synthesizedComp.Name, plan.DefaultTLSCertDuration, existing)
+	if err != nil {
+		return err
+	}
+	graphCli, _ := transCtx.Client.(model.GraphClient)
+	if existing == nil {
+		graphCli.Create(dag, secret)
+	} else {
+		secretCopy := existing.DeepCopy()
+		secretCopy.StringData = secret.StringData
+		graphCli.Update(dag, existing, secretCopy)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"reflect"
+
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apecloud/kubeblocks/pkg/common"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/factory"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+)
+
+// componentPDBTransformer maintains the PodDisruptionBudget of the component workload,
+// which is derived from the roles and replicas of the component.
+type componentPDBTransformer struct{}
+
+var _ graph.Transformer = &componentPDBTransformer{}
+
+func (t *componentPDBTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	transCtx, _ := ctx.(*componentTransformContext)
+	if model.IsObjectDeleting(transCtx.ComponentOrig) {
+		return nil
+	}
+	if common.IsCompactMode(transCtx.ComponentOrig.Annotations) {
+		transCtx.V(1).Info("Component is in compact mode, no need to create pod disruption budget", "component", client.ObjectKeyFromObject(transCtx.ComponentOrig))
+		return nil
+	}
+
+	synthesizeComp := transCtx.SynthesizeComponent
+	// the budget can't be kept across the data-plane k8s clusters the replicas are placed to.
+	var protoPDB *policyv1.PodDisruptionBudget
+	if placement(transCtx.Component) == "" {
+		var err error
+		if protoPDB, err = factory.BuildPodDisruptionBudget(synthesizeComp); err != nil {
+			return err
+		}
+	}
+
+	runningPDB := &policyv1.PodDisruptionBudget{}
+	pdbKey := client.ObjectKey{
+		Namespace: synthesizeComp.Namespace,
+		Name:      constant.GenerateWorkloadNamePattern(synthesizeComp.ClusterName, synthesizeComp.Name),
+	}
+	if err := transCtx.Client.Get(transCtx.Context, pdbKey, runningPDB, inDataContext4C()); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		runningPDB = nil
+	}
+
+	graphCli, _ := transCtx.Client.(model.GraphClient)
+	switch {
+	case runningPDB == nil && protoPDB != nil:
+		graphCli.Create(dag, protoPDB, inDataContext4G())
+	case runningPDB != nil && !model.IsOwnerOf(transCtx.ComponentOrig, runningPDB):
+		// don't touch the PDB not owned by the component, e.g. the one created by the users.
+	case runningPDB != nil && protoPDB == nil:
+		graphCli.Delete(dag, runningPDB, inDataContext4G())
+	case runningPDB != nil:
+		pdbCopy := runningPDB.DeepCopy()
+		pdbCopy.Spec = protoPDB.Spec
+		if !reflect.DeepEqual(runningPDB, pdbCopy) {
+			graphCli.Update(dag, runningPDB, pdbCopy, inDataContext4G())
+		}
+	}
+	return nil
+}
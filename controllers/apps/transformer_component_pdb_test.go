@@ -0,0 +1,146 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	"github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+var _ = Describe("component pdb transformer test", func() {
+	const (
+		clusterName = "test-cluster"
+		compName    = "comp"
+	)
+
+	var (
+		reader   *mockReader
+		dag      *graph.DAG
+		transCtx *componentTransformContext
+	)
+
+	BeforeEach(func() {
+		reader = &mockReader{}
+		comp := &appsv1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testCtx.DefaultNamespace,
+				Name:      constant.GenerateClusterComponentName(clusterName, compName),
+			},
+		}
+		graphCli := model.NewGraphClient(reader)
+		dag = graph.NewDAG()
+		graphCli.Root(dag, comp, comp, model.ActionStatusPtr())
+		transCtx = &componentTransformContext{
+			Context:       ctx,
+			Client:        graphCli,
+			Logger:        logger,
+			Component:     comp,
+			ComponentOrig: comp.DeepCopy(),
+			SynthesizeComponent: &component.SynthesizedComponent{
+				Namespace:   testCtx.DefaultNamespace,
+				ClusterName: clusterName,
+				Name:        compName,
+				Replicas:    3,
+				Roles: []appsv1alpha1.ReplicaRole{
+					{Name: "leader", Votable: true, Writable: true},
+					{Name: "follower", Votable: true},
+				},
+			},
+		}
+	})
+
+	findPDB := func() (*policyv1.PodDisruptionBudget, *model.Action) {
+		graphCli := transCtx.Client.(model.GraphClient)
+		objs := graphCli.FindAll(dag, &policyv1.PodDisruptionBudget{})
+		if len(objs) == 0 {
+			return nil, nil
+		}
+		vertex := graphCli.FindMatchedVertex(dag, objs[0]).(*model.ObjectVertex)
+		return objs[0].(*policyv1.PodDisruptionBudget), vertex.Action
+	}
+
+	runningPDB := func(minAvailable int32) *policyv1.PodDisruptionBudget {
+		value := intstr.FromInt32(minAvailable)
+		pdb := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testCtx.DefaultNamespace,
+				Name:      constant.GenerateWorkloadNamePattern(clusterName, compName),
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{MinAvailable: &value},
+		}
+		Expect(controllerutil.SetOwnerReference(transCtx.Component, pdb)).Should(Succeed())
+		return pdb
+	}
+
+	It("creates the pdb keeping a quorum", func() {
+		transformer := &componentPDBTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+
+		pdb, action := findPDB()
+		Expect(pdb).ShouldNot(BeNil())
+		Expect(*action).Should(Equal(model.CREATE))
+		Expect(pdb.Spec.MinAvailable.IntVal).Should(BeEquivalentTo(2))
+		Expect(pdb.Spec.Selector.MatchLabels).Should(Equal(constant.GetComponentWellKnownLabels(clusterName, compName)))
+	})
+
+	It("updates the pdb after scaling out", func() {
+		reader.objs = []client.Object{runningPDB(2)}
+		transCtx.SynthesizeComponent.Replicas = 5
+		transformer := &componentPDBTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+
+		pdb, action := findPDB()
+		Expect(*action).Should(Equal(model.UPDATE))
+		Expect(pdb.Spec.MinAvailable.IntVal).Should(BeEquivalentTo(3))
+	})
+
+	It("deletes the pdb after scaling in to one replica", func() {
+		reader.objs = []client.Object{runningPDB(2)}
+		transCtx.SynthesizeComponent.Replicas = 1
+		transformer := &componentPDBTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+
+		_, action := findPDB()
+		Expect(*action).Should(Equal(model.DELETE))
+	})
+
+	It("doesn't touch the pdb not owned by the component", func() {
+		pdb := runningPDB(3)
+		pdb.OwnerReferences = nil
+		reader.objs = []client.Object{pdb}
+		transformer := &componentPDBTransformer{}
+		Expect(transformer.Transform(transCtx, dag)).Should(Succeed())
+
+		pdb, _ = findPDB()
+		Expect(pdb).Should(BeNil())
+	})
+})
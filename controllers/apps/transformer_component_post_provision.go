@@ -50,6 +50,10 @@ func (t *componentPostProvisionTransformer) Transform(ctx graph.TransformContext
 	if model.IsObjectDeleting(compOrig) {
 		return nil
 	}
+	// the lifecycle action is executed by lorry directly, which can't be previewed
+	if transCtx.DryRun {
+		return nil
+	}
 
 	actionCtx, err := component.NewActionContext(cluster, comp, runningWorkload,
 		synthesizeComp.LifecycleActions, synthesizeComp.ScriptTemplates, component.PostProvisionAction)
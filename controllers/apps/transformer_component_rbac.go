@@ -123,7 +123,12 @@ func isDataProtectionEnabled(backupTpl *appsv1alpha1.BackupPolicyTemplate, clust
 	return false
 }
 
-func isVolumeProtectionEnabled(compDef *appsv1alpha1.ComponentDefinition) bool {
+func isVolumeProtectionEnabled(compDef *appsv1alpha1.ComponentDefinition, comp *appsv1alpha1.Component) bool {
+	for _, vct := range comp.Spec.VolumeClaimTemplates {
+		if vct.Autoscaling != nil {
+			return true
+		}
+	}
 	for _, vol := range compDef.Spec.Volumes {
 		if vol.HighWatermark > 0 && vol.HighWatermark < 100 {
 			return true
@@ -259,8 +264,10 @@ func buildServiceAccount(transCtx *componentTransformContext) (*corev1.ServiceAc
 	}
 
 	serviceAccountName := comp.Spec.ServiceAccountName
-	volumeProtectionEnable := isVolumeProtectionEnabled(compDef)
+	volumeProtectionEnable := isVolumeProtectionEnabled(compDef, comp)
 	dataProtectionEnable := isDataProtectionEnabled(backupPolicyTPL, cluster, comp)
+	// the secured lorry reviews the service account tokens, which requires the clusterRoleBinding permission too.
+	needCRB := volumeProtectionEnable || viper.GetBool(constant.KBEnvLorrySecureAccessEnabled)
 	if serviceAccountName == "" {
 		// If probe, volume protection, and data protection are disabled at the same tme, then do not create a service account.
 		if !isProbesEnabled(compDef) && !volumeProtectionEnable && !dataProtectionEnable {
@@ -272,14 +279,14 @@ func buildServiceAccount(transCtx *componentTransformContext) (*corev1.ServiceAc
 
 	if isRoleBindingExist(transCtx, serviceAccountName) && isServiceAccountExist(transCtx, serviceAccountName) {
 		// Volume protection requires the clusterRoleBinding permission, if volume protection is not enabled or the corresponding clusterRoleBinding already exists, then skip.
-		if !volumeProtectionEnable || isClusterRoleBindingExist(transCtx, serviceAccountName) {
+		if !needCRB || isClusterRoleBindingExist(transCtx, serviceAccountName) {
 			return nil, false, nil
 		}
 	}
 
 	buildSa := factory.BuildServiceAccount(cluster, serviceAccountName)
-	// if volume protection is enabled, the service account needs to be bound to the clusterRoleBinding.
-	return buildSa, volumeProtectionEnable, nil
+	// if volume protection or the secured lorry is enabled, the service account needs to be bound to the clusterRoleBinding.
+	return buildSa, needCRB, nil
 }
 
 func buildRoleBinding(cluster *appsv1alpha1.Cluster, serviceAccountName string) *rbacv1.RoleBinding {
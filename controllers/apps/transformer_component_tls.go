@@ -21,14 +21,20 @@ package apps
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
 	cfgcore "github.com/apecloud/kubeblocks/pkg/configuration/core"
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/controller/component"
@@ -36,6 +42,14 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/controller/model"
 	"github.com/apecloud/kubeblocks/pkg/controller/plan"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
+)
+
+const (
+	// certManagerIssueWaitInterval is the interval to check whether cert-manager has issued the certificates.
+	certManagerIssueWaitInterval = 5 * time.Second
+	// tlsCertSyncPeriod is how long to wait for kubelet to sync the renewed secret into the pods before reloading.
+	tlsCertSyncPeriod = 2 * time.Minute
 )
 
 // componentTLSTransformer handles component configuration render
@@ -54,16 +68,22 @@ func (t *componentTLSTransformer) Transform(ctx graph.TransformContext, dag *gra
 		return err
 	}
 
-	// build tls cert
-	if err := buildTLSCert(transCtx.Context, transCtx.Client, *synthesizedComp, dag); err != nil {
-		return err
+	// build tls cert, the delayed requeue to renew the certificates doesn't stop the re-render check
+	cert, renewErr := buildTLSCert(transCtx.Context, transCtx.Client, synthesizedComp, dag)
+	if renewErr != nil && !intctrlutil.IsDelayedRequeueError(renewErr) {
+		return renewErr
 	}
 
 	if err := checkAndTriggerReRender(transCtx.Context, *synthesizedComp, t.Client); err != nil {
 		return err
 	}
 
-	return nil
+	if cert != nil {
+		if err := applyTLSCert(transCtx, cert, dag); err != nil {
+			return err
+		}
+	}
+	return renewErr
 }
 
 // a hack way to notify the configuration controller to re-render config
@@ -131,29 +151,214 @@ func checkAndTriggerReRender(ctx context.Context, synthesizedComp component.Synt
 	return nil
 }
 
-func buildTLSCert(ctx context.Context, cli client.Reader, synthesizedComp component.SynthesizedComponent, dag *graph.DAG) error {
+// buildTLSCert builds the TLS certificates issued by KubeBlocks or cert-manager, and returns the certificate the pods should use.
+func buildTLSCert(ctx context.Context, cli client.Reader, synthesizedComp *component.SynthesizedComponent, dag *graph.DAG) (*x509.Certificate, error) {
 	tls := synthesizedComp.TLSConfig
 	if tls == nil || !tls.Enable {
-		return nil
+		return nil, nil
 	}
 	if tls.Issuer == nil {
-		return fmt.Errorf("issuer shouldn't be nil when tls enabled")
+		return nil, fmt.Errorf("issuer shouldn't be nil when tls enabled")
 	}
 
 	switch tls.Issuer.Name {
 	case appsv1alpha1.IssuerUserProvided:
 		if err := plan.CheckTLSSecretRef(ctx, cli, synthesizedComp.Namespace, tls.Issuer.SecretRef); err != nil {
-			return err
+			return nil, err
 		}
 	case appsv1alpha1.IssuerKubeBlocks:
-		secret, err := plan.ComposeTLSSecret(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name)
-		if err != nil {
-			return err
+		return buildKubeBlocksTLSCert(ctx, cli, synthesizedComp, dag)
+	case appsv1alpha1.IssuerCertManager:
+		return buildCertManagerTLSCert(ctx, cli, synthesizedComp, dag)
+	}
+
+	return nil, nil
+}
+
+// buildKubeBlocksTLSCert issues the self-signed certificates, and renews them before they expire.
+func buildKubeBlocksTLSCert(ctx context.Context, cli client.Reader, synthesizedComp *component.SynthesizedComponent, dag *graph.DAG) (*x509.Certificate, error) {
+	issuer := synthesizedComp.TLSConfig.Issuer
+	if err := plan.CheckTLSCertLifetime(issuer); err != nil {
+		return nil, err
+	}
+	duration := plan.GetTLSCertDuration(issuer)
+	renewBefore := plan.GetTLSCertRenewBefore(issuer)
+
+	secretKey := types.NamespacedName{
+		Namespace: synthesizedComp.Namespace,
+		Name:      plan.GenerateTLSSecretName(synthesizedComp.ClusterName, synthesizedComp.Name),
+	}
+	existing := &corev1.Secret{}
+	if err := cli.Get(ctx, secretKey, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		existing = nil
+	}
+
+	if existing != nil {
+		// the secret is re-issued if the certificate can't be parsed
+		if cert, err := plan.ParseTLSCert(existing, constant.CertName); err == nil {
+			renewAt := plan.GetTLSCertRenewalTime(cert, duration, renewBefore)
+			if time.Now().Before(renewAt) {
+				return cert, intctrlutil.NewDelayedRequeueError(time.Until(renewAt), "renew the TLS certificates before they expire")
+			}
 		}
-		graphCli, _ := cli.(model.GraphClient)
+	}
+
+	secret, err := plan.ComposeTLSSecret(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name, duration)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := plan.ParseTLSCert(secret, constant.CertName)
+	if err != nil {
+		return nil, err
+	}
+	graphCli, _ := cli.(model.GraphClient)
+	if existing == nil {
 		graphCli.Create(dag, secret)
+	} else {
+		secretCopy := existing.DeepCopy()
+		secretCopy.StringData = secret.StringData
+		graphCli.Update(dag, existing, secretCopy)
+	}
+	return cert, nil
+}
+
+// buildCertManagerTLSCert requests the certificates from cert-manager, which renews them before they expire.
+func buildCertManagerTLSCert(ctx context.Context, cli client.Reader, synthesizedComp *component.SynthesizedComponent, dag *graph.DAG) (*x509.Certificate, error) {
+	issuer := synthesizedComp.TLSConfig.Issuer
+	if err := plan.CheckTLSCertLifetime(issuer); err != nil {
+		return nil, err
+	}
+	certificate, err := plan.ComposeTLSCertificate(synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	graphCli, _ := cli.(model.GraphClient)
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(plan.CertManagerCertificateGVK)
+	if err = cli.Get(ctx, client.ObjectKeyFromObject(certificate), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		graphCli.Create(dag, certificate)
+	} else if certificateCopy, updated := mergeCertificateSpec(existing, certificate); updated {
+		graphCli.Update(dag, existing, certificateCopy)
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := types.NamespacedName{Namespace: certificate.GetNamespace(), Name: plan.GenerateTLSSecretName(synthesizedComp.ClusterName, synthesizedComp.Name)}
+	if err = cli.Get(ctx, secretKey, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, intctrlutil.NewRequeueError(certManagerIssueWaitInterval, "wait for cert-manager to issue the TLS certificates")
+		}
+		return nil, err
 	}
+	return plan.ParseTLSCert(secret, constant.CertName)
+}
 
+// mergeCertificateSpec merges the spec fields managed by KubeBlocks into the existing Certificate,
+// it returns whether any of them is changed.
+func mergeCertificateSpec(existing, expected *unstructured.Unstructured) (*unstructured.Unstructured, bool) {
+	existingSpec, _, _ := unstructured.NestedMap(existing.Object, "spec")
+	expectedSpec, _, _ := unstructured.NestedMap(expected.Object, "spec")
+	if existingSpec == nil {
+		existingSpec = map[string]interface{}{}
+	}
+	updated := false
+	for k, v := range expectedSpec {
+		if !equality.Semantic.DeepEqual(existingSpec[k], v) {
+			existingSpec[k] = v
+			updated = true
+		}
+	}
+	if !updated {
+		return nil, false
+	}
+	existingCopy := existing.DeepCopy()
+	existingCopy.Object["spec"] = existingSpec
+	return existingCopy, true
+}
+
+// applyTLSCert makes the pods load the certificate. The renewed secret is synced into the running pods by kubelet,
+// the pods reload it with the reconfigure action if the component defines one, or are restarted otherwise.
+func applyTLSCert(transCtx *componentTransformContext, cert *x509.Certificate, dag *graph.DAG) error {
+	synthesizedComp := transCtx.SynthesizeComponent
+	serial := plan.GetTLSCertSerial(cert)
+	if !tlsCertReloadable(synthesizedComp) {
+		// the pods are restarted when the serial number stamped into the pod template changes
+		setPodTemplateAnnotation(synthesizedComp, constant.TLSCertSerialAnnotationKey, serial)
+		return nil
+	}
+
+	// keep the serial number the pods are created with, which doesn't restart them
+	loaded := ""
+	if its, ok := transCtx.RunningWorkload.(*workloads.InstanceSet); ok {
+		if created, ok := its.Spec.Template.Annotations[constant.TLSCertSerialAnnotationKey]; ok {
+			setPodTemplateAnnotation(synthesizedComp, constant.TLSCertSerialAnnotationKey, created)
+			loaded = created
+		}
+	}
+	comp := transCtx.Component
+	if value, ok := comp.Annotations[constant.TLSCertSerialAnnotationKey]; ok {
+		loaded = value
+	}
+	if loaded == serial {
+		return nil
+	}
+
+	// the pods are created or restarted with the certificate if none is loaded yet
+	if loaded != "" {
+		if wait := tlsCertSyncPeriod - time.Since(cert.NotBefore); wait > 0 {
+			return intctrlutil.NewDelayedRequeueError(wait, "wait for the renewed TLS certificates to be synced into the pods")
+		}
+		// the reconfigure action is executed by lorry directly, which can't be previewed
+		if transCtx.DryRun {
+			return nil
+		}
+		if err := reloadTLSCert(transCtx); err != nil {
+			return err
+		}
+	}
+
+	compObj := comp.DeepCopy()
+	if comp.Annotations == nil {
+		comp.Annotations = map[string]string{}
+	}
+	comp.Annotations[constant.TLSCertSerialAnnotationKey] = serial
+	graphCli, _ := transCtx.Client.(model.GraphClient)
+	graphCli.Update(dag, compObj, comp, &model.ReplaceIfExistingOption{})
+	return nil
+}
+
+// tlsCertReloadable checks whether the pods can reload the renewed certificates without restarting.
+func tlsCertReloadable(synthesizedComp *component.SynthesizedComponent) bool {
+	actions := synthesizedComp.LifecycleActions
+	return actions != nil && actions.Reconfigure != nil &&
+		actions.Reconfigure.CustomHandler != nil && actions.Reconfigure.CustomHandler.Exec != nil
+}
+
+// reloadTLSCert executes the reconfigure action on all pods of the component.
+func reloadTLSCert(transCtx *componentTransformContext) error {
+	synthesizedComp := transCtx.SynthesizeComponent
+	pods, err := component.ListOwnedPods(transCtx.Context, transCtx.Client, synthesizedComp.Namespace, synthesizedComp.ClusterName, synthesizedComp.Name)
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods {
+		lorryCli, err := lorry.NewClient(transCtx.Context, transCtx.Client, *pod)
+		if err != nil {
+			return err
+		}
+		if intctrlutil.IsNil(lorryCli) {
+			return fmt.Errorf("failed to build lorry client for pod %s", pod.Name)
+		}
+		if err = lorryCli.Reconfigure(transCtx.Context); err != nil {
+			return intctrlutil.NewErrorf(intctrlutil.ErrorTypeRequeue, "reload the TLS certificates of pod %s failed: %s", pod.Name, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -195,9 +400,13 @@ func composeTLSVolume(clusterName string, synthesizeComp component.SynthesizedCo
 		return nil, fmt.Errorf("secret ref shouldn't be nil when issuer is UserProvided")
 	}
 
+	if tls.Issuer.Name == appsv1alpha1.IssuerCertManager && tls.Issuer.IssuerRef == nil {
+		return nil, fmt.Errorf("issuer ref shouldn't be nil when issuer is CertManager")
+	}
+
 	var secretName, ca, cert, key string
 	switch tls.Issuer.Name {
-	case appsv1alpha1.IssuerKubeBlocks:
+	case appsv1alpha1.IssuerKubeBlocks, appsv1alpha1.IssuerCertManager:
 		secretName = plan.GenerateTLSSecretName(clusterName, synthesizeComp.Name)
 		ca = constant.CAName
 		cert = constant.CertName
@@ -21,13 +21,19 @@ package apps
 
 import (
 	"context"
+	"crypto/x509"
+	"math/big"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/golang/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,8 +41,13 @@ import (
 	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
 	cfgcore "github.com/apecloud/kubeblocks/pkg/configuration/core"
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
 	"github.com/apecloud/kubeblocks/pkg/controller/plan"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	"github.com/apecloud/kubeblocks/pkg/generics"
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
 	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
 	testk8s "github.com/apecloud/kubeblocks/pkg/testutil/k8s"
 )
@@ -110,7 +121,7 @@ var _ = Describe("TLS self-signed cert function", func() {
 			BeforeEach(func() {
 				// prepare self provided tls certs secret
 				var err error
-				userProvidedTLSSecretObj, err = plan.ComposeTLSSecret(testCtx.DefaultNamespace, "test", "self-provided")
+				userProvidedTLSSecretObj, err = plan.ComposeTLSSecret(testCtx.DefaultNamespace, "test", "self-provided", plan.DefaultTLSCertDuration)
 				Expect(err).Should(BeNil())
 				Expect(k8sClient.Create(ctx, userProvidedTLSSecretObj)).Should(Succeed())
 			})
@@ -214,4 +225,111 @@ var _ = Describe("TLS self-signed cert function", func() {
 			})
 		})
 	})
+
+	Context("cert-manager certificate", func() {
+		It("should merge the managed spec fields only", func() {
+			issuer := &appsv1alpha1.Issuer{
+				Name:      appsv1alpha1.IssuerCertManager,
+				IssuerRef: &appsv1alpha1.CertManagerIssuerRef{Name: "ca-issuer", Kind: "Issuer"},
+			}
+			expected, err := plan.ComposeTLSCertificate(testCtx.DefaultNamespace, "test", "mysql", issuer)
+			Expect(err).Should(BeNil())
+
+			existing := expected.DeepCopy()
+			Expect(unstructured.SetNestedField(existing.Object, "PKCS8", "spec", "privateKey", "encoding")).Should(Succeed())
+			_, updated := mergeCertificateSpec(existing, expected)
+			Expect(updated).Should(BeTrue())
+
+			existing = expected.DeepCopy()
+			Expect(unstructured.SetNestedField(existing.Object, int64(3), "spec", "revisionHistoryLimit")).Should(Succeed())
+			_, updated = mergeCertificateSpec(existing, expected)
+			Expect(updated).Should(BeFalse())
+
+			issuer.Duration = &metav1.Duration{Duration: 24 * time.Hour}
+			issuer.RenewBefore = &metav1.Duration{Duration: time.Hour}
+			expected, err = plan.ComposeTLSCertificate(testCtx.DefaultNamespace, "test", "mysql", issuer)
+			Expect(err).Should(BeNil())
+			merged, updated := mergeCertificateSpec(existing, expected)
+			Expect(updated).Should(BeTrue())
+			duration, _, _ := unstructured.NestedString(merged.Object, "spec", "duration")
+			Expect(duration).Should(Equal("24h0m0s"))
+			limit, _, _ := unstructured.NestedInt64(merged.Object, "spec", "revisionHistoryLimit")
+			Expect(limit).Should(BeEquivalentTo(3))
+		})
+	})
+
+	Context("apply the renewed certificates", func() {
+		var (
+			dag      *graph.DAG
+			transCtx *componentTransformContext
+			cert     *x509.Certificate
+		)
+
+		BeforeEach(func() {
+			secret, err := plan.ComposeTLSSecret(testCtx.DefaultNamespace, "test", "mysql", plan.DefaultTLSCertDuration)
+			Expect(err).Should(BeNil())
+			cert, err = plan.ParseTLSCert(secret, constant.CertName)
+			Expect(err).Should(BeNil())
+			// the certificate is issued long enough for kubelet to sync it into the pods
+			cert.NotBefore = cert.NotBefore.Add(-tlsCertSyncPeriod)
+
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: testCtx.DefaultNamespace, Name: "test-mysql-0"}}
+			comp := &appsv1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   testCtx.DefaultNamespace,
+					Name:        "test-mysql",
+					Annotations: map[string]string{constant.TLSCertSerialAnnotationKey: "former"},
+				},
+			}
+			graphCli := model.NewGraphClient(&mockReader{objs: []client.Object{pod}})
+			dag = graph.NewDAG()
+			graphCli.Root(dag, comp, comp, model.ActionStatusPtr())
+			transCtx = &componentTransformContext{
+				Context:       ctx,
+				Client:        graphCli,
+				Logger:        logger,
+				Component:     comp,
+				ComponentOrig: comp.DeepCopy(),
+				SynthesizeComponent: &component.SynthesizedComponent{
+					Namespace:   testCtx.DefaultNamespace,
+					ClusterName: "test",
+					Name:        "mysql",
+				},
+			}
+		})
+
+		AfterEach(func() {
+			lorry.UnsetMockClient()
+		})
+
+		It("should restart the pods if the reconfigure action isn't defined", func() {
+			Expect(applyTLSCert(transCtx, cert, dag)).Should(Succeed())
+			Expect(transCtx.SynthesizeComponent.UserDefinedAnnotations[constant.TLSCertSerialAnnotationKey]).Should(Equal(plan.GetTLSCertSerial(cert)))
+			Expect(transCtx.Component.Annotations[constant.TLSCertSerialAnnotationKey]).Should(Equal("former"))
+		})
+
+		It("should reload the certificates with the reconfigure action", func() {
+			transCtx.SynthesizeComponent.LifecycleActions = &appsv1alpha1.ComponentLifecycleActions{
+				Reconfigure: &appsv1alpha1.LifecycleActionHandler{
+					CustomHandler: &appsv1alpha1.Action{
+						Exec: &appsv1alpha1.ExecAction{Command: []string{"reload"}},
+					},
+				},
+			}
+			mockLorryClient(func(recorder *lorry.MockClientMockRecorder) {
+				recorder.Reconfigure(gomock.Any()).Return(nil).Times(1)
+			})
+			Expect(applyTLSCert(transCtx, cert, dag)).Should(Succeed())
+			Expect(transCtx.Component.Annotations[constant.TLSCertSerialAnnotationKey]).Should(Equal(plan.GetTLSCertSerial(cert)))
+
+			By("the reloaded certificate isn't reloaded again")
+			Expect(applyTLSCert(transCtx, cert, dag)).Should(Succeed())
+
+			By("wait for the renewed certificate to be synced into the pods")
+			cert.NotBefore = time.Now()
+			cert.SerialNumber = cert.SerialNumber.Add(cert.SerialNumber, big.NewInt(1))
+			err := applyTLSCert(transCtx, cert, dag)
+			Expect(intctrlutil.IsDelayedRequeueError(err)).Should(BeTrue())
+		})
+	})
 })
@@ -23,6 +23,7 @@ import (
 	"fmt"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/controller/component"
 	"github.com/apecloud/kubeblocks/pkg/controller/graph"
 )
 
@@ -46,6 +47,9 @@ func (t *componentValidationTransformer) Transform(ctx graph.TransformContext, d
 	if err = validateCompReplicas(comp, transCtx.CompDef); err != nil {
 		return newRequeueError(requeueDuration, err.Error())
 	}
+	if err = validatePasswordRotations(transCtx.SynthesizeComponent); err != nil {
+		return newRequeueError(requeueDuration, err.Error())
+	}
 	// if err = validateSidecarContainers(comp, transCtx.CompDef); err != nil {
 	// 	return newRequeueError(requeueDuration, err.Error())
 	// }
@@ -92,6 +96,20 @@ func validateCompReplicas(comp *appsv1alpha1.Component, compDef *appsv1alpha1.Co
 	return replicasOutOfLimitError(replicas, *replicasLimit)
 }
 
+// validatePasswordRotations rejects the grace period of the password rotations if the engine can't retain the current password.
+func validatePasswordRotations(synthesizedComp *component.SynthesizedComponent) error {
+	if component.IsDualPasswordSupported(synthesizedComp) {
+		return nil
+	}
+	for name, policy := range synthesizedComp.PasswordRotations {
+		if policy.GracePeriod != nil && policy.GracePeriod.Duration > 0 {
+			return fmt.Errorf("the grace period of the password rotation of account %s is not supported by the engine, "+
+				"the engine can't retain the current password", name)
+		}
+	}
+	return nil
+}
+
 func replicasOutOfLimitError(replicas int32, replicasLimit appsv1alpha1.ReplicasLimit) error {
 	return fmt.Errorf("replicas %d out-of-limit [%d, %d]", replicas, replicasLimit.MinReplicas, replicasLimit.MaxReplicas)
 }
@@ -68,6 +68,8 @@ type componentWorkloadOps struct {
 	runningItsPodNames    []string
 	desiredCompPodNameSet sets.Set[string]
 	runningItsPodNameSet  sets.Set[string]
+	// dryRun skips the member leave actions, which can't be previewed
+	dryRun bool
 }
 
 var _ graph.Transformer = &componentWorkloadTransformer{}
@@ -122,7 +124,7 @@ func (t *componentWorkloadTransformer) Transform(ctx graph.TransformContext, dag
 		if protoITS == nil {
 			graphCli.Delete(dag, runningITS)
 		} else {
-			err = t.handleUpdate(reqCtx, graphCli, dag, cluster, synthesizeComp, runningITS, protoITS)
+			err = t.handleUpdate(reqCtx, graphCli, dag, cluster, synthesizeComp, runningITS, protoITS, transCtx.DryRun)
 		}
 	}
 	return err
@@ -145,9 +147,9 @@ func (t *componentWorkloadTransformer) runningInstanceSetObject(ctx graph.Transf
 }
 
 func (t *componentWorkloadTransformer) handleUpdate(reqCtx intctrlutil.RequestCtx, cli model.GraphClient, dag *graph.DAG,
-	cluster *appsv1alpha1.Cluster, synthesizeComp *component.SynthesizedComponent, runningITS, protoITS *workloads.InstanceSet) error {
+	cluster *appsv1alpha1.Cluster, synthesizeComp *component.SynthesizedComponent, runningITS, protoITS *workloads.InstanceSet, dryRun bool) error {
 	// TODO(xingran): Some workload operations should be moved down to Lorry implementation. Subsequent operations such as horizontal scaling will be removed from the component controller
-	if err := t.handleWorkloadUpdate(reqCtx, dag, cluster, synthesizeComp, runningITS, protoITS); err != nil {
+	if err := t.handleWorkloadUpdate(reqCtx, dag, cluster, synthesizeComp, runningITS, protoITS, dryRun); err != nil {
 		return err
 	}
 
@@ -164,8 +166,9 @@ func (t *componentWorkloadTransformer) handleUpdate(reqCtx intctrlutil.RequestCt
 }
 
 func (t *componentWorkloadTransformer) handleWorkloadUpdate(reqCtx intctrlutil.RequestCtx, dag *graph.DAG,
-	cluster *appsv1alpha1.Cluster, synthesizeComp *component.SynthesizedComponent, obj, its *workloads.InstanceSet) error {
+	cluster *appsv1alpha1.Cluster, synthesizeComp *component.SynthesizedComponent, obj, its *workloads.InstanceSet, dryRun bool) error {
 	cwo := newComponentWorkloadOps(reqCtx, t.Client, cluster, synthesizeComp, obj, its, dag)
+	cwo.dryRun = dryRun
 
 	// handle expand volume
 	if err := cwo.expandVolume(); err != nil {
@@ -491,10 +494,11 @@ func (r *componentWorkloadOps) scaleIn(itsObj *workloads.InstanceSet) error {
 		return nil
 	}
 	// TODO: check the component definition to determine whether we need to call leave member before deleting replicas.
-	err := r.leaveMember4ScaleIn()
-	if err != nil {
-		r.reqCtx.Log.Info(fmt.Sprintf("leave member at scaling-in error, retry later: %s", err.Error()))
-		return err
+	if !r.dryRun {
+		if err := r.leaveMember4ScaleIn(); err != nil {
+			r.reqCtx.Log.Info(fmt.Sprintf("leave member at scaling-in error, retry later: %s", err.Error()))
+			return err
+		}
 	}
 	return r.deletePVCs4ScaleIn(itsObj)
 }
@@ -601,7 +605,7 @@ func (r *componentWorkloadOps) leaveMember4ScaleIn() error {
 		podsToMemberLeave = append(podsToMemberLeave, pod)
 	}
 	for _, pod := range podsToMemberLeave {
-		lorryCli, err1 := lorry.NewClient(*pod)
+		lorryCli, err1 := lorry.NewClient(r.reqCtx.Ctx, r.cli, *pod)
 		if err1 != nil {
 			if err == nil {
 				err = err1
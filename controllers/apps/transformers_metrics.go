@@ -0,0 +1,67 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package apps
+
+import (
+	"time"
+
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/metrics"
+)
+
+const (
+	clusterControllerName   = "cluster"
+	componentControllerName = "component"
+)
+
+// timedTransformer records the latency of the wrapped transformer to the reconcile metrics.
+type timedTransformer struct {
+	controller  string
+	name        string
+	transformer graph.Transformer
+}
+
+var _ graph.Transformer = &timedTransformer{}
+
+func (t *timedTransformer) Transform(ctx graph.TransformContext, dag *graph.DAG) error {
+	start := time.Now()
+	err := t.transformer.Transform(ctx, dag)
+	metricErr := err
+	if err == graph.ErrPrematureStop || intctrlutil.IsDelayedRequeueError(err) {
+		// not failures, the chain stops or delays the requeue intentionally
+		metricErr = nil
+	}
+	metrics.RecordTransformer(t.controller, t.name, time.Since(start), metricErr)
+	return err
+}
+
+// newTimedTransformerChain wraps each transformer in the chain to record its latency.
+func newTimedTransformerChain(controller string, transformers graph.TransformerChain) graph.TransformerChain {
+	chain := make(graph.TransformerChain, 0, len(transformers))
+	for _, transformer := range transformers {
+		chain = append(chain, &timedTransformer{
+			controller:  controller,
+			name:        metrics.TransformerName(transformer),
+			transformer: transformer,
+		})
+	}
+	return chain
+}
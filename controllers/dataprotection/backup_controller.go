@@ -29,9 +29,11 @@ import (
 
 	vsv1beta1 "github.com/kubernetes-csi/external-snapshotter/client/v3/apis/volumesnapshot/v1beta1"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	"golang.org/x/exp/slices"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -144,7 +146,8 @@ func (r *BackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&batchv1.Job{}).
 		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.filterBackupPods)).
-		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.parseBackupJob))
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.parseBackupJob)).
+		Watches(&dpv1alpha1.Backup{}, handler.EnqueueRequestsFromMapFunc(r.parseAncestorBackups))
 
 	if dputils.SupportsVolumeSnapshotV1() {
 		b.Owns(&vsv1.VolumeSnapshot{}, builder.Predicates{})
@@ -194,6 +197,26 @@ func (r *BackupReconciler) parseBackupJob(_ context.Context, object client.Objec
 	return requests
 }
 
+// parseAncestorBackups enqueues the ancestor backups of the backup, so that the ancestors waiting
+// for the dependent backups to be deleted can be deleted then.
+func (r *BackupReconciler) parseAncestorBackups(_ context.Context, object client.Object) []reconcile.Request {
+	backup, ok := object.(*dpv1alpha1.Backup)
+	if !ok {
+		return nil
+	}
+	var requests []reconcile.Request
+	ancestors := backup.Status.AncestorBackupNames
+	if parent := dputils.GetParentBackupName(backup); len(parent) > 0 && !slices.Contains(ancestors, parent) {
+		ancestors = append([]string{parent}, ancestors...)
+	}
+	for _, name := range ancestors {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: name},
+		})
+	}
+	return requests
+}
+
 // deleteBackupFiles deletes the backup files stored in backup repository.
 func (r *BackupReconciler) deleteBackupFiles(reqCtx intctrlutil.RequestCtx, backup *dpv1alpha1.Backup) error {
 	deleteBackup := func() error {
@@ -243,6 +266,23 @@ func (r *BackupReconciler) handleDeletingPhase(reqCtx intctrlutil.RequestCtx, ba
 	// if backup phase is Deleting, delete the backup reference workloads,
 	// backup data stored in backup repository and volume snapshots.
 	// TODO(ldm): if backup is being used by restore, do not delete it.
+
+	// the backup is still depended on by the incremental or differential backups, deleting it
+	// will break their backup chains, so keep it until all the dependent backups are deleted.
+	dependents, err := dputils.GetDependentBackups(reqCtx.Ctx, r.Client, backup)
+	if err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	}
+	if len(dependents) > 0 {
+		names := make([]string, 0, len(dependents))
+		for i := range dependents {
+			names = append(names, dependents[i].Name)
+		}
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "WaitForDependentBackups",
+			fmt.Sprintf("backup can not be deleted until the backups depending on it are deleted: %s", strings.Join(names, ",")))
+		return intctrlutil.Reconciled()
+	}
+
 	if err := r.deleteExternalResources(reqCtx, backup); err != nil {
 		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
 	}
@@ -273,6 +313,10 @@ func (r *BackupReconciler) handleNewPhase(
 	if err = r.recordBackupStatusTargets(reqCtx, request); err != nil {
 		return r.updateStatusIfFailed(reqCtx, backup, request.Backup, err)
 	}
+	// record the backup chain for incremental and differential backup.
+	if err = r.recordBackupChain(reqCtx, request); err != nil {
+		return r.updateStatusIfFailed(reqCtx, backup, request.Backup, err)
+	}
 	backupStatusCopy := request.Backup.Status.DeepCopy()
 	// set and patch backup object meta, including labels, annotations and finalizers
 	// if the backup object meta is changed, the backup object will be patched.
@@ -289,6 +333,55 @@ func (r *BackupReconciler) handleNewPhase(
 	return intctrlutil.Reconciled()
 }
 
+// recordBackupChain resolves the parent backup of the incremental or differential backup,
+// and records the backup chain from the base full backup to the parent backup in the status.
+func (r *BackupReconciler) recordBackupChain(
+	reqCtx intctrlutil.RequestCtx,
+	request *dpbackup.Request) error {
+	if request.ActionSet == nil || request.Status.BaseBackupName != "" {
+		return nil
+	}
+	backupType := request.ActionSet.Spec.BackupType
+	if backupType != dpv1alpha1.BackupTypeIncremental && backupType != dpv1alpha1.BackupTypeDifferential {
+		return nil
+	}
+	var (
+		parent = &dpv1alpha1.Backup{}
+		err    error
+	)
+	if parentName := request.Spec.ParentBackupName; parentName != "" {
+		if err = r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: request.Namespace, Name: parentName}, parent); err != nil {
+			if apierrors.IsNotFound(err) {
+				return intctrlutil.NewFatalError(fmt.Sprintf(`parent backup "%s" is not found`, parentName))
+			}
+			return err
+		}
+		if parent.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+			return intctrlutil.NewFatalError(fmt.Sprintf(`parent backup "%s" is not completed`, parentName))
+		}
+	} else {
+		if parent, err = dputils.SelectParentBackup(reqCtx.Ctx, r.Client, request.Backup, request.BackupMethod, backupType); err != nil {
+			return err
+		}
+		if parent == nil {
+			return intctrlutil.NewFatalError(fmt.Sprintf(`no completed backup is available as the parent of the %s backup "%s"`,
+				strings.ToLower(string(backupType)), request.Name))
+		}
+	}
+	if backupType == dpv1alpha1.BackupTypeDifferential && !dputils.IsFullBackup(parent) {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`the parent backup "%s" of the differential backup must be a full backup`, parent.Name))
+	}
+	ancestors, err := dputils.GetBackupChain(reqCtx.Ctx, r.Client, parent)
+	if err != nil {
+		return err
+	}
+	ancestors = append(ancestors, parent.Name)
+	request.Status.ParentBackupName = parent.Name
+	request.Status.BaseBackupName = ancestors[0]
+	request.Status.AncestorBackupNames = ancestors
+	return nil
+}
+
 // recordBackupStatusTargets records the backup status target or targets for next reconcile.
 func (r *BackupReconciler) recordBackupStatusTargets(
 	reqCtx intctrlutil.RequestCtx,
@@ -611,6 +704,7 @@ func (r *BackupReconciler) handleRunningPhase(
 	if err = r.Client.Status().Patch(reqCtx.Ctx, request.Backup, client.MergeFrom(backup)); err != nil {
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
 	}
+	recordBackupMetrics(request.Backup)
 	return intctrlutil.Reconciled()
 }
 
@@ -657,7 +751,11 @@ func (r *BackupReconciler) checkIsCompletedDuringRunning(reqCtx intctrlutil.Requ
 		duration := backup.Status.CompletionTimestamp.Sub(backup.Status.StartTimestamp.Time).Round(time.Second)
 		backup.Status.Duration = &metav1.Duration{Duration: duration}
 	}
-	return true, r.Client.Status().Patch(reqCtx.Ctx, backup, patch)
+	if err = r.Client.Status().Patch(reqCtx.Ctx, backup, patch); err != nil {
+		return false, err
+	}
+	recordBackupMetrics(backup)
+	return true, nil
 }
 
 // handleCompletedPhase handles the backup object in completed phase.
@@ -691,6 +789,9 @@ func (r *BackupReconciler) updateStatusIfFailed(
 	if errUpdate := r.Client.Status().Patch(reqCtx.Ctx, backup, client.MergeFrom(original)); errUpdate != nil {
 		return intctrlutil.CheckedRequeueWithError(errUpdate, reqCtx.Log, "")
 	}
+	if original.Status.Phase != dpv1alpha1.BackupPhaseFailed {
+		recordBackupMetrics(backup)
+	}
 	return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
 }
 
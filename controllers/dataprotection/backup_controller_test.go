@@ -684,7 +684,7 @@ var _ = Describe("Backup Controller test", func() {
 				})).Should(Succeed())
 			})
 
-			It("should fail because actionSet's backup type isn't Full", func() {
+			It("should fail because no parent backup is available for the incremental backup", func() {
 				actionSet := testdp.NewFakeActionSet(&testCtx)
 				actionSetKey := client.ObjectKeyFromObject(actionSet)
 				Eventually(testapps.GetAndChangeObj(&testCtx, actionSetKey, func(fetched *dpv1alpha1.ActionSet) {
@@ -701,6 +701,54 @@ var _ = Describe("Backup Controller test", func() {
 			})
 		})
 
+		Context("creates an incremental backup", func() {
+			It("should record the backup chain from the latest full backup", func() {
+				By("create a full backup and wait for it to complete")
+				fullBackup := testdp.NewFakeBackup(&testCtx, nil)
+				fullBackupKey := client.ObjectKeyFromObject(fullBackup)
+				Eventually(testapps.CheckObj(&testCtx, fullBackupKey, func(g Gomega, fetched *dpv1alpha1.Backup) {
+					g.Expect(fetched.Status.Phase).Should(Equal(dpv1alpha1.BackupPhaseRunning))
+				})).Should(Succeed())
+				testdp.PatchK8sJobStatus(&testCtx, client.ObjectKey{
+					Name:      dpbackup.GenerateBackupJobName(fullBackup, dpbackup.BackupDataJobNamePrefix+"-0"),
+					Namespace: fullBackup.Namespace,
+				}, batchv1.JobComplete)
+				Eventually(testapps.CheckObj(&testCtx, fullBackupKey, func(g Gomega, fetched *dpv1alpha1.Backup) {
+					g.Expect(fetched.Status.Phase).Should(Equal(dpv1alpha1.BackupPhaseCompleted))
+				})).Should(Succeed())
+
+				By("change the actionSet's backup type to Incremental")
+				Eventually(testapps.GetAndChangeObj(&testCtx, client.ObjectKey{Name: testdp.ActionSetName}, func(fetched *dpv1alpha1.ActionSet) {
+					fetched.Spec.BackupType = dpv1alpha1.BackupTypeIncremental
+				})).Should(Succeed())
+
+				By("create an incremental backup without parent backup")
+				incBackup := testdp.NewFakeBackup(&testCtx, func(backup *dpv1alpha1.Backup) {
+					backup.Name = "incremental-backup"
+				})
+				Eventually(testapps.CheckObj(&testCtx, client.ObjectKeyFromObject(incBackup), func(g Gomega, fetched *dpv1alpha1.Backup) {
+					g.Expect(fetched.Status.Phase).Should(Equal(dpv1alpha1.BackupPhaseRunning))
+					g.Expect(fetched.Labels[dptypes.BackupTypeLabelKey]).Should(Equal(string(dpv1alpha1.BackupTypeIncremental)))
+					g.Expect(fetched.Status.ParentBackupName).Should(Equal(fullBackup.Name))
+					g.Expect(fetched.Status.BaseBackupName).Should(Equal(fullBackup.Name))
+					g.Expect(fetched.Status.AncestorBackupNames).Should(Equal([]string{fullBackup.Name}))
+				})).Should(Succeed())
+
+				By("delete the full backup, it should be kept for the incremental backup")
+				testapps.DeleteObject(&testCtx, fullBackupKey, &dpv1alpha1.Backup{})
+				Eventually(testapps.CheckObj(&testCtx, fullBackupKey, func(g Gomega, fetched *dpv1alpha1.Backup) {
+					g.Expect(fetched.Status.Phase).Should(Equal(dpv1alpha1.BackupPhaseDeleting))
+				})).Should(Succeed())
+				Consistently(testapps.CheckObjExists(&testCtx, dpbackup.BuildDeleteBackupFilesJobKey(fullBackup, false),
+					&batchv1.Job{}, false)).Should(Succeed())
+
+				By("delete the incremental backup, the full backup files should be deleted then")
+				testapps.DeleteObject(&testCtx, client.ObjectKeyFromObject(incBackup), &dpv1alpha1.Backup{})
+				Eventually(testapps.CheckObjExists(&testCtx, dpbackup.BuildDeleteBackupFilesJobKey(fullBackup, false),
+					&batchv1.Job{}, true)).Should(Succeed())
+			})
+		})
+
 		Context("create continuous backup", func() {
 			It("should fail when continuous backup don't have backupschedule label", func() {
 				By("create actionset with continuous backuptype")
@@ -22,17 +22,24 @@ package dataprotection
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
 )
 
 // BackupPolicyReconciler reconciles a BackupPolicy object
@@ -72,7 +79,7 @@ func (r *BackupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	if backupPolicy.Status.ObservedGeneration == backupPolicy.Generation &&
 		backupPolicy.Status.Phase.IsAvailable() {
-		return ctrl.Result{}, nil
+		return r.updateRecoverableWindows(reqCtx, backupPolicy)
 	}
 
 	patchStatus := func(phase dpv1alpha1.Phase, message string) error {
@@ -94,7 +101,63 @@ func (r *BackupPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
 	}
 	intctrlutil.RecordCreatedEvent(r.Recorder, backupPolicy)
-	return ctrl.Result{}, nil
+	return r.updateRecoverableWindows(reqCtx, backupPolicy)
+}
+
+// updateRecoverableWindows computes the recoverable windows from the backups of the backup policy,
+// and flags the gaps between the windows with a condition.
+func (r *BackupPolicyReconciler) updateRecoverableWindows(reqCtx intctrlutil.RequestCtx, backupPolicy *dpv1alpha1.BackupPolicy) (ctrl.Result, error) {
+	backupList := &dpv1alpha1.BackupList{}
+	if err := r.Client.List(reqCtx.Ctx, backupList, client.InNamespace(backupPolicy.Namespace),
+		client.MatchingLabels{dptypes.BackupPolicyLabelKey: backupPolicy.Name}); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	hasContinuousBackup := false
+	for _, backup := range backupList.Items {
+		if backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous) {
+			hasContinuousBackup = true
+			break
+		}
+	}
+
+	oldStatus := backupPolicy.Status.DeepCopy()
+	patch := client.MergeFrom(backupPolicy.DeepCopy())
+	windows := dputils.BuildRecoverableWindows(backupList.Items)
+	backupPolicy.Status.RecoverableWindows = windows
+	cond := metav1.Condition{
+		Type:               dpv1alpha1.ConditionTypeRecoverableWindowsContinuous,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonRecoverableWindowsContinuous,
+		ObservedGeneration: backupPolicy.Generation,
+	}
+	switch {
+	case !hasContinuousBackup:
+		meta.RemoveStatusCondition(&backupPolicy.Status.Conditions, dpv1alpha1.ConditionTypeRecoverableWindowsContinuous)
+	case len(windows) == 0:
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = ReasonNoRecoverableWindow
+		cond.Message = "no full backup is completed during the continuous backups, the data can not be restored to any point in time"
+		meta.SetStatusCondition(&backupPolicy.Status.Conditions, cond)
+	case len(windows) > 1:
+		var gaps []string
+		for i := 1; i < len(windows); i++ {
+			gaps = append(gaps, fmt.Sprintf("(%s, %s)", windows[i-1].End.UTC().Format(time.RFC3339),
+				windows[i].Start.UTC().Format(time.RFC3339)))
+		}
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = ReasonRecoverableWindowsHaveGaps
+		cond.Message = fmt.Sprintf("the data can not be restored to the time in the gaps: %s", strings.Join(gaps, ", "))
+		meta.SetStatusCondition(&backupPolicy.Status.Conditions, cond)
+	default:
+		meta.SetStatusCondition(&backupPolicy.Status.Conditions, cond)
+	}
+	if equality.Semantic.DeepEqual(oldStatus, &backupPolicy.Status) {
+		return intctrlutil.Reconciled()
+	}
+	if err := r.Client.Status().Patch(reqCtx.Ctx, backupPolicy, patch); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
 }
 
 func (r *BackupPolicyReconciler) validateBackupPolicy(backupPolicy *dpv1alpha1.BackupPolicy) error {
@@ -126,9 +189,21 @@ func (r *BackupPolicyReconciler) validateBackupPolicy(backupPolicy *dpv1alpha1.B
 func (r *BackupPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return intctrlutil.NewNamespacedControllerManagedBy(mgr).
 		For(&dpv1alpha1.BackupPolicy{}).
+		Watches(&dpv1alpha1.Backup{}, handler.EnqueueRequestsFromMapFunc(r.mapBackupToBackupPolicy)).
 		Complete(r)
 }
 
+// mapBackupToBackupPolicy enqueues the backup policy of the backup to update its recoverable windows.
+func (r *BackupPolicyReconciler) mapBackupToBackupPolicy(_ context.Context, obj client.Object) []ctrl.Request {
+	backup := obj.(*dpv1alpha1.Backup)
+	if backup.Spec.BackupPolicyName == "" {
+		return nil
+	}
+	return []ctrl.Request{{
+		NamespacedName: client.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.BackupPolicyName},
+	}}
+}
+
 func (r *BackupPolicyReconciler) deleteExternalResources(
 	_ intctrlutil.RequestCtx,
 	_ *dpv1alpha1.BackupPolicy) error {
@@ -0,0 +1,597 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/action"
+	dprestore "github.com/apecloud/kubeblocks/pkg/dataprotection/restore"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+// BackupVerificationReconciler reconciles a BackupVerification object
+type BackupVerificationReconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	RestConfig *rest.Config
+}
+
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dataprotection.kubeblocks.io,resources=backupverifications/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=clusters,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile restores the backup into a scratch cluster, runs the checks against it
+// and deletes the scratch cluster once the verification is finished.
+func (r *BackupVerificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqCtx := intctrlutil.RequestCtx{
+		Ctx:      ctx,
+		Req:      req,
+		Log:      log.FromContext(ctx).WithValues("backupVerification", req.NamespacedName),
+		Recorder: r.Recorder,
+	}
+
+	verification := &dpv1alpha1.BackupVerification{}
+	if err := r.Client.Get(reqCtx.Ctx, reqCtx.Req.NamespacedName, verification); err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+
+	// handle finalizer
+	res, err := intctrlutil.HandleCRDeletion(reqCtx, r, verification, dptypes.DataProtectionFinalizerName, func() (*ctrl.Result, error) {
+		return nil, r.deleteExternalResources(reqCtx, verification)
+	})
+	if res != nil {
+		return *res, err
+	}
+
+	if verification.IsFinished() {
+		if err = r.deleteExternalResources(reqCtx, verification); err != nil {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		}
+		return intctrlutil.Reconciled()
+	}
+
+	switch verification.Status.Phase {
+	case "":
+		return r.handleNewPhase(reqCtx, verification)
+	case dpv1alpha1.BackupVerificationPhaseRestoring:
+		return r.handleRestoringPhase(reqCtx, verification)
+	case dpv1alpha1.BackupVerificationPhaseVerifying:
+		return r.handleVerifyingPhase(reqCtx, verification)
+	}
+	return intctrlutil.Reconciled()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupVerificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return intctrlutil.NewNamespacedControllerManagedBy(mgr).
+		For(&dpv1alpha1.BackupVerification{}).
+		Owns(&appsv1alpha1.Cluster{}).
+		Owns(&batchv1.Job{}).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.parseVerificationJob)).
+		Complete(r)
+}
+
+func (r *BackupVerificationReconciler) parseVerificationJob(_ context.Context, object client.Object) []reconcile.Request {
+	job := object.(*batchv1.Job)
+	name := job.Labels[dptypes.BackupVerificationLabelKey]
+	namespace := job.Labels[dptypes.BackupNamespaceLabelKey]
+	if name == "" || namespace == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
+
+func (r *BackupVerificationReconciler) deleteExternalResources(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) error {
+	labels := map[string]string{dptypes.BackupVerificationLabelKey: verification.Name}
+	if err := deleteRelatedJobs(reqCtx, r.Client, verification.Namespace, labels); err != nil {
+		return err
+	}
+	if err := deleteRelatedJobs(reqCtx, r.Client, viper.GetString(constant.CfgKeyCtrlrMgrNS), labels); err != nil {
+		return err
+	}
+	if verification.Status.ClusterName == "" {
+		return nil
+	}
+	cluster := &appsv1alpha1.Cluster{}
+	if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: verification.Namespace,
+		Name: verification.Status.ClusterName}, cluster); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	// only delete the cluster created by this verification
+	if cluster.Labels[dptypes.BackupVerificationLabelKey] != verification.Name {
+		return nil
+	}
+	return intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, cluster)
+}
+
+// handleNewPhase resolves the backup to verify and starts restoring it.
+func (r *BackupVerificationReconciler) handleNewPhase(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (ctrl.Result, error) {
+	backup, err := r.getBackupToVerify(reqCtx, verification)
+	if err != nil {
+		if intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+			return r.finish(reqCtx, verification.DeepCopy(), verification, nil, err.Error())
+		}
+		return RecorderEventAndRequeue(reqCtx, r.Recorder, verification, err)
+	}
+	patch := client.MergeFrom(verification.DeepCopy())
+	verification.Status.Phase = dpv1alpha1.BackupVerificationPhaseRestoring
+	verification.Status.BackupName = backup.Name
+	verification.Status.ClusterName = buildScratchClusterName(verification)
+	verification.Status.StartTimestamp = &metav1.Time{Time: time.Now()}
+	if err = r.Client.Status().Patch(reqCtx.Ctx, verification, patch); err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	}
+	return intctrlutil.Reconciled()
+}
+
+// getBackupToVerify returns the backup specified by the verification, or the latest completed
+// full backup of the backup policy.
+func (r *BackupVerificationReconciler) getBackupToVerify(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (*dpv1alpha1.Backup, error) {
+	if verification.Spec.BackupName != "" {
+		backup := &dpv1alpha1.Backup{}
+		if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: verification.Namespace,
+			Name: verification.Spec.BackupName}, backup); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, intctrlutil.NewFatalError(err.Error())
+			}
+			return nil, err
+		}
+		if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf("backup %s status is %s, only completed backup can be verified",
+				backup.Name, backup.Status.Phase))
+		}
+		if !dputils.IsFullBackup(backup) {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf("backup %s is not a full backup", backup.Name))
+		}
+		return backup, nil
+	}
+	backupList := &dpv1alpha1.BackupList{}
+	if err := r.Client.List(reqCtx.Ctx, backupList, client.InNamespace(verification.Namespace),
+		client.MatchingLabels{dptypes.BackupPolicyLabelKey: verification.Spec.BackupPolicyName}); err != nil {
+		return nil, err
+	}
+	backup := getLatestFullBackup(backupList.Items)
+	if backup == nil {
+		return nil, intctrlutil.NewFatalError(fmt.Sprintf("no completed full backup found for backup policy %s",
+			verification.Spec.BackupPolicyName))
+	}
+	return backup, nil
+}
+
+// getLatestFullBackup returns the completed full backup with the latest completion time.
+func getLatestFullBackup(backups []dpv1alpha1.Backup) *dpv1alpha1.Backup {
+	var latest *dpv1alpha1.Backup
+	for i := range backups {
+		backup := &backups[i]
+		if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted || !dputils.IsFullBackup(backup) ||
+			backup.Status.CompletionTimestamp == nil {
+			continue
+		}
+		if latest == nil || latest.Status.CompletionTimestamp.Before(backup.Status.CompletionTimestamp) {
+			latest = backup
+		}
+	}
+	return latest
+}
+
+// handleRestoringPhase creates the scratch cluster and waits for it to be running.
+func (r *BackupVerificationReconciler) handleRestoringPhase(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (ctrl.Result, error) {
+	if res, timedOut, err := r.checkTimeout(reqCtx, verification); timedOut {
+		return res, err
+	}
+	backup, err := r.getVerifiedBackup(reqCtx, verification)
+	if err != nil {
+		return r.handleError(reqCtx, verification, err)
+	}
+	cluster := &appsv1alpha1.Cluster{}
+	exists, err := intctrlutil.CheckResourceExists(reqCtx.Ctx, r.Client, client.ObjectKey{
+		Namespace: verification.Namespace, Name: verification.Status.ClusterName}, cluster)
+	if err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	}
+	if !exists {
+		if cluster, err = buildScratchCluster(verification, backup); err != nil {
+			return r.handleError(reqCtx, verification, intctrlutil.NewFatalError(err.Error()))
+		}
+		if err = dputils.SetControllerReference(verification, cluster, r.Scheme); err != nil {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		}
+		if err = r.Client.Create(reqCtx.Ctx, cluster); err != nil && !apierrors.IsAlreadyExists(err) {
+			return RecorderEventAndRequeue(reqCtx, r.Recorder, verification, err)
+		}
+		r.Recorder.Eventf(verification, corev1.EventTypeNormal, "CreatedCluster",
+			"created scratch cluster %s from backup %s", cluster.Name, backup.Name)
+		return r.requeueUntilTimeout(reqCtx, verification)
+	}
+	switch cluster.Status.Phase {
+	case appsv1alpha1.RunningClusterPhase:
+		patch := client.MergeFrom(verification.DeepCopy())
+		verification.Status.Phase = dpv1alpha1.BackupVerificationPhaseVerifying
+		if err = r.Client.Status().Patch(reqCtx.Ctx, verification, patch); err != nil {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		}
+		return intctrlutil.Reconciled()
+	case appsv1alpha1.FailedClusterPhase:
+		return r.finish(reqCtx, verification.DeepCopy(), verification, backup, fmt.Sprintf("failed to restore backup %s, the scratch cluster %s is %s",
+			backup.Name, cluster.Name, cluster.Status.Phase))
+	}
+	return r.requeueUntilTimeout(reqCtx, verification)
+}
+
+// handleVerifyingPhase runs the checks one by one against the target pod of the scratch cluster.
+func (r *BackupVerificationReconciler) handleVerifyingPhase(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (ctrl.Result, error) {
+	if res, timedOut, err := r.checkTimeout(reqCtx, verification); timedOut {
+		return res, err
+	}
+	backup, err := r.getVerifiedBackup(reqCtx, verification)
+	if err != nil {
+		return r.handleError(reqCtx, verification, err)
+	}
+	targetPod, err := r.getTargetPod(reqCtx, verification, backup)
+	if err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	}
+	if targetPod == nil {
+		return r.finish(reqCtx, verification.DeepCopy(), verification, backup, fmt.Sprintf("no target pod found in the scratch cluster %s",
+			verification.Status.ClusterName))
+	}
+
+	actionCtx := action.ActionContext{
+		Ctx:              reqCtx.Ctx,
+		Client:           r.Client,
+		Recorder:         r.Recorder,
+		Scheme:           r.Scheme,
+		RestClientConfig: r.RestConfig,
+	}
+	original := verification.DeepCopy()
+	for i := range verification.Spec.Checks {
+		check := &verification.Spec.Checks[i]
+		if i < len(verification.Status.Checks) && verification.Status.Checks[i].Phase == dpv1alpha1.ActionPhaseCompleted {
+			continue
+		}
+		act := r.buildCheckAction(verification, backup, targetPod, i)
+		status, err := act.Execute(actionCtx)
+		if status != nil {
+			status.Name = check.Name
+			status.TargetPodName = targetPod.Name
+			setCheckStatus(verification, i, status)
+		}
+		if err != nil {
+			return RecorderEventAndRequeue(reqCtx, r.Recorder, verification, err)
+		}
+		switch status.Phase {
+		case dpv1alpha1.ActionPhaseFailed:
+			return r.finish(reqCtx, original, verification, backup, fmt.Sprintf("check %s failed: %s", check.Name, status.FailureReason))
+		case dpv1alpha1.ActionPhaseCompleted:
+			continue
+		}
+		// the check is running, wait for it to finish
+		if err = r.Client.Status().Patch(reqCtx.Ctx, verification, client.MergeFrom(original)); err != nil {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		}
+		return r.requeueUntilTimeout(reqCtx, verification)
+	}
+	return r.finish(reqCtx, original, verification, backup, "")
+}
+
+func setCheckStatus(verification *dpv1alpha1.BackupVerification, index int, status *dpv1alpha1.ActionStatus) {
+	if index < len(verification.Status.Checks) {
+		verification.Status.Checks[index] = *status
+		return
+	}
+	verification.Status.Checks = append(verification.Status.Checks, *status)
+}
+
+func (r *BackupVerificationReconciler) getVerifiedBackup(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (*dpv1alpha1.Backup, error) {
+	backup := &dpv1alpha1.Backup{}
+	if err := r.Client.Get(reqCtx.Ctx, client.ObjectKey{Namespace: verification.Namespace,
+		Name: verification.Status.BackupName}, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, intctrlutil.NewFatalError(err.Error())
+		}
+		return nil, err
+	}
+	return backup, nil
+}
+
+// getTargetPod returns the first pod of the component which the backup is taken from in the scratch cluster.
+func (r *BackupVerificationReconciler) getTargetPod(reqCtx intctrlutil.RequestCtx,
+	verification *dpv1alpha1.BackupVerification,
+	backup *dpv1alpha1.Backup) (*corev1.Pod, error) {
+	labels := client.MatchingLabels{constant.AppInstanceLabelKey: verification.Status.ClusterName}
+	if compName := backup.Labels[constant.KBAppComponentLabelKey]; compName != "" {
+		labels[constant.KBAppComponentLabelKey] = compName
+	}
+	podList := &corev1.PodList{}
+	if err := r.Client.List(reqCtx.Ctx, podList, client.InNamespace(verification.Namespace), labels); err != nil {
+		return nil, err
+	}
+	if len(podList.Items) == 0 {
+		return nil, nil
+	}
+	sort.Slice(podList.Items, func(i, j int) bool {
+		return podList.Items[i].Name < podList.Items[j].Name
+	})
+	return &podList.Items[0], nil
+}
+
+func (r *BackupVerificationReconciler) buildCheckAction(verification *dpv1alpha1.BackupVerification,
+	backup *dpv1alpha1.Backup,
+	targetPod *corev1.Pod,
+	index int) action.Action {
+	check := verification.Spec.Checks[index]
+	name := fmt.Sprintf("%s-check-%d", verification.Status.ClusterName, index)
+	objectMeta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: verification.Namespace,
+		Labels: map[string]string{
+			dptypes.BackupVerificationLabelKey: verification.Name,
+			dptypes.BackupNamespaceLabelKey:    verification.Namespace,
+			constant.AppManagedByLabelKey:      dptypes.AppName,
+		},
+	}
+	if check.Exec != nil {
+		objectMeta.Namespace = viper.GetString(constant.CfgKeyCtrlrMgrNS)
+		containerName := check.Exec.Container
+		if containerName == "" {
+			containerName = targetPod.Spec.Containers[0].Name
+		}
+		return &action.ExecAction{
+			JobAction: action.JobAction{
+				Name:       name,
+				ObjectMeta: objectMeta,
+				Owner:      verification,
+			},
+			Command:            check.Exec.Command,
+			Container:          containerName,
+			Namespace:          targetPod.Namespace,
+			PodName:            targetPod.Name,
+			Timeout:            check.Exec.Timeout,
+			ServiceAccountName: viper.GetString(dptypes.CfgKeyExecWorkerServiceAccountName),
+		}
+	}
+	return &action.JobAction{
+		Name:       name,
+		ObjectMeta: objectMeta,
+		Owner:      verification,
+		PodSpec:    buildCheckJobPodSpec(verification, backup, targetPod, name, check.Job),
+	}
+}
+
+func buildCheckJobPodSpec(verification *dpv1alpha1.BackupVerification,
+	backup *dpv1alpha1.Backup,
+	targetPod *corev1.Pod,
+	name string,
+	job *dpv1alpha1.JobActionSpec) *corev1.PodSpec {
+	env := dputils.BuildEnvByCredential(targetPod, getScratchClusterCredential(verification, backup))
+	env = append(env,
+		corev1.EnvVar{Name: dptypes.DPBackupName, Value: backup.Name},
+		corev1.EnvVar{Name: dptypes.DPTargetPodName, Value: targetPod.Name},
+		corev1.EnvVar{Name: constant.KBEnvClusterName, Value: verification.Status.ClusterName},
+		corev1.EnvVar{Name: constant.KBEnvNamespace, Value: verification.Namespace},
+	)
+	container := corev1.Container{
+		Name:            name,
+		Image:           job.Image,
+		ImagePullPolicy: corev1.PullPolicy(viper.GetString(constant.KBImagePullPolicy)),
+		Command:         job.Command,
+		Env:             env,
+	}
+	intctrlutil.InjectZeroResourcesLimitsIfEmpty(&container)
+	podSpec := &corev1.PodSpec{
+		RestartPolicy: corev1.RestartPolicyNever,
+		Containers:    []corev1.Container{container},
+	}
+	if boolptr.IsSetToTrue(job.RunOnTargetPodNode) {
+		podSpec.NodeName = targetPod.Spec.NodeName
+	}
+	return podSpec
+}
+
+// getScratchClusterCredential returns the connection credential of the scratch cluster, which is
+// derived from the credential of the backup target by replacing the original cluster name prefix.
+func getScratchClusterCredential(verification *dpv1alpha1.BackupVerification, backup *dpv1alpha1.Backup) *dpv1alpha1.ConnectionCredential {
+	if backup.Status.Target == nil || backup.Status.Target.ConnectionCredential == nil {
+		return nil
+	}
+	credential := backup.Status.Target.ConnectionCredential.DeepCopy()
+	if clusterName := backup.Labels[constant.AppInstanceLabelKey]; clusterName != "" {
+		if suffix, ok := strings.CutPrefix(credential.SecretName, clusterName); ok {
+			credential.SecretName = verification.Status.ClusterName + suffix
+		}
+	}
+	return credential
+}
+
+// buildScratchCluster builds the scratch cluster from the cluster snapshot of the backup.
+func buildScratchCluster(verification *dpv1alpha1.BackupVerification, backup *dpv1alpha1.Backup) (*appsv1alpha1.Cluster, error) {
+	clusterString, ok := backup.Annotations[constant.ClusterSnapshotAnnotationKey]
+	if !ok {
+		return nil, fmt.Errorf("missing snapshot annotation in backup %s, %s is empty in Annotations",
+			backup.Name, constant.ClusterSnapshotAnnotationKey)
+	}
+	snapshot := &appsv1alpha1.Cluster{}
+	if err := json.Unmarshal([]byte(clusterString), snapshot); err != nil {
+		return nil, err
+	}
+	restoreAnnotation, err := dprestore.GetRestoreFromBackupAnnotation(backup, string(dpv1alpha1.VolumeClaimRestorePolicyParallel), "", false)
+	if err != nil {
+		return nil, err
+	}
+	cluster := &appsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        verification.Status.ClusterName,
+			Namespace:   verification.Namespace,
+			Labels:      map[string]string{dptypes.BackupVerificationLabelKey: verification.Name},
+			Annotations: snapshot.Annotations,
+		},
+		Spec: snapshot.Spec,
+	}
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[constant.RestoreFromBackupAnnotationKey] = restoreAnnotation
+	// the scratch cluster is disposable, wipe out all its data when deleted and
+	// don't take backups or replicate from another cluster.
+	cluster.Spec.TerminationPolicy = appsv1alpha1.WipeOut
+	cluster.Spec.Backup = nil
+	cluster.Spec.Standby = nil
+	// don't expose the scratch cluster outside
+	var services []appsv1alpha1.ClusterService
+	for i := range cluster.Spec.Services {
+		svc := cluster.Spec.Services[i]
+		if svc.Service.Spec.Type == corev1.ServiceTypeLoadBalancer || svc.Service.Spec.Type == corev1.ServiceTypeNodePort {
+			continue
+		}
+		if svc.Service.Spec.Selector != nil {
+			delete(svc.Service.Spec.Selector, constant.AppInstanceLabelKey)
+		}
+		services = append(services, svc)
+	}
+	cluster.Spec.Services = services
+	for i := range cluster.Spec.ComponentSpecs {
+		cluster.Spec.ComponentSpecs[i].OfflineInstances = nil
+	}
+	return cluster, nil
+}
+
+// buildScratchClusterName builds a short name for the scratch cluster, as the cluster name
+// is used as the prefix of the names of the cluster objects.
+func buildScratchClusterName(verification *dpv1alpha1.BackupVerification) string {
+	uid := string(verification.UID)
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	return "verify-" + uid
+}
+
+func (r *BackupVerificationReconciler) deadline(verification *dpv1alpha1.BackupVerification) time.Time {
+	if verification.Status.StartTimestamp == nil || verification.Spec.Timeout.Duration == 0 {
+		return time.Time{}
+	}
+	return verification.Status.StartTimestamp.Add(verification.Spec.Timeout.Duration)
+}
+
+// checkTimeout fails the verification if it has not finished before the deadline.
+func (r *BackupVerificationReconciler) checkTimeout(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (ctrl.Result, bool, error) {
+	deadline := r.deadline(verification)
+	if deadline.IsZero() || time.Now().Before(deadline) {
+		return ctrl.Result{}, false, nil
+	}
+	backup, err := r.getVerifiedBackup(reqCtx, verification)
+	if err != nil && !intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+		res, err := intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		return res, true, err
+	}
+	res, err := r.finish(reqCtx, verification.DeepCopy(), verification, backup, fmt.Sprintf("verification timed out after %s", verification.Spec.Timeout.Duration))
+	return res, true, err
+}
+
+// requeueUntilTimeout requeues the verification at the deadline, progress is driven by the
+// events of the scratch cluster and jobs before that.
+func (r *BackupVerificationReconciler) requeueUntilTimeout(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification) (ctrl.Result, error) {
+	deadline := r.deadline(verification)
+	if deadline.IsZero() {
+		return intctrlutil.Reconciled()
+	}
+	return intctrlutil.RequeueAfter(time.Until(deadline)+reconcileInterval, reqCtx.Log, "")
+}
+
+func (r *BackupVerificationReconciler) handleError(reqCtx intctrlutil.RequestCtx, verification *dpv1alpha1.BackupVerification, err error) (ctrl.Result, error) {
+	if intctrlutil.IsTargetError(err, intctrlutil.ErrorTypeFatal) {
+		return r.finish(reqCtx, verification.DeepCopy(), verification, nil, err.Error())
+	}
+	return RecorderEventAndRequeue(reqCtx, r.Recorder, verification, err)
+}
+
+// finish marks the verification as passed if the message is empty, otherwise failed, and
+// records the result to the backup status. The status is patched against the original object.
+func (r *BackupVerificationReconciler) finish(reqCtx intctrlutil.RequestCtx,
+	original, verification *dpv1alpha1.BackupVerification,
+	backup *dpv1alpha1.Backup,
+	message string) (ctrl.Result, error) {
+	patch := client.MergeFrom(original)
+	now := metav1.Now()
+	verification.Status.Phase = dpv1alpha1.BackupVerificationPhasePassed
+	verification.Status.Message = message
+	if message != "" {
+		verification.Status.Phase = dpv1alpha1.BackupVerificationPhaseFailed
+	}
+	if verification.Status.StartTimestamp == nil {
+		verification.Status.StartTimestamp = &now
+	}
+	verification.Status.CompletionTimestamp = &now
+	verification.Status.Duration = &metav1.Duration{Duration: now.Sub(verification.Status.StartTimestamp.Time).Round(time.Second)}
+
+	if backup != nil {
+		backupPatch := client.MergeFrom(backup.DeepCopy())
+		backup.Status.Verification = &dpv1alpha1.BackupVerificationResult{
+			Name:                verification.Name,
+			Passed:              verification.Status.Phase == dpv1alpha1.BackupVerificationPhasePassed,
+			StartTimestamp:      verification.Status.StartTimestamp,
+			CompletionTimestamp: verification.Status.CompletionTimestamp,
+			Duration:            verification.Status.Duration,
+			FailureReason:       message,
+		}
+		if err := r.Client.Status().Patch(reqCtx.Ctx, backup, backupPatch); err != nil {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+		}
+	}
+	if err := r.Client.Status().Patch(reqCtx.Ctx, verification, patch); err != nil {
+		return intctrlutil.RequeueWithError(err, reqCtx.Log, "")
+	}
+	if message != "" {
+		r.Recorder.Event(verification, corev1.EventTypeWarning, "VerificationFailed", message)
+	} else {
+		r.Recorder.Eventf(verification, corev1.EventTypeNormal, "VerificationPassed",
+			"backup %s passed %d checks", verification.Status.BackupName, len(verification.Spec.Checks))
+	}
+	return intctrlutil.Reconciled()
+}
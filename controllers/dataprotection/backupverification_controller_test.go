@@ -0,0 +1,164 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dataprotection
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	"github.com/apecloud/kubeblocks/pkg/generics"
+	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
+	testdp "github.com/apecloud/kubeblocks/pkg/testutil/dataprotection"
+)
+
+var _ = Describe("BackupVerification Controller test", func() {
+	cleanEnv := func() {
+		By("clean resources")
+		inNS := client.InNamespace(testCtx.DefaultNamespace)
+		ml := client.HasLabels{testCtx.TestObjLabelKey}
+		testapps.ClearResourcesWithRemoveFinalizerOption(&testCtx, generics.BackupVerificationSignature, true, inNS)
+		testapps.ClearResources(&testCtx, generics.ClusterSignature, inNS, ml)
+	}
+
+	BeforeEach(cleanEnv)
+
+	AfterEach(cleanEnv)
+
+	newVerification := func(name string, change func(*dpv1alpha1.BackupVerification)) *dpv1alpha1.BackupVerification {
+		verification := &dpv1alpha1.BackupVerification{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testCtx.DefaultNamespace,
+				Labels:    map[string]string{testCtx.TestObjLabelKey: "true"},
+			},
+			Spec: dpv1alpha1.BackupVerificationSpec{
+				Checks: []dpv1alpha1.VerificationCheck{
+					{
+						Name: "ping",
+						Exec: &dpv1alpha1.ExecActionSpec{Command: []string{"sh", "-c", "exit 0"}},
+					},
+				},
+			},
+		}
+		change(verification)
+		Expect(testCtx.CreateObj(testCtx.Ctx, verification)).Should(Succeed())
+		return verification
+	}
+
+	Context("verify a backup", func() {
+		It("should fail if the backup is not found", func() {
+			verification := newVerification("verify-not-found", func(bv *dpv1alpha1.BackupVerification) {
+				bv.Spec.BackupName = "not-found"
+			})
+			Eventually(testapps.CheckObj(&testCtx, client.ObjectKeyFromObject(verification), func(g Gomega, fetched *dpv1alpha1.BackupVerification) {
+				g.Expect(fetched.Status.Phase).Should(Equal(dpv1alpha1.BackupVerificationPhaseFailed))
+				g.Expect(fetched.Status.CompletionTimestamp).ShouldNot(BeNil())
+				g.Expect(fetched.Status.Message).ShouldNot(BeEmpty())
+			})).Should(Succeed())
+		})
+
+		It("should fail if the backup policy has no completed full backup", func() {
+			verification := newVerification("verify-no-backup", func(bv *dpv1alpha1.BackupVerification) {
+				bv.Spec.BackupPolicyName = testdp.BackupPolicyName
+			})
+			Eventually(testapps.CheckObj(&testCtx, client.ObjectKeyFromObject(verification), func(g Gomega, fetched *dpv1alpha1.BackupVerification) {
+				g.Expect(fetched.Status.Phase).Should(Equal(dpv1alpha1.BackupVerificationPhaseFailed))
+				g.Expect(fetched.Status.Message).Should(ContainSubstring(testdp.BackupPolicyName))
+			})).Should(Succeed())
+		})
+	})
+
+	Context("helpers", func() {
+		It("should choose the latest completed full backup", func() {
+			now := time.Now()
+			newBackup := func(name string, phase dpv1alpha1.BackupPhase, backupType dpv1alpha1.BackupType, completed time.Time) dpv1alpha1.Backup {
+				backup := dpv1alpha1.Backup{}
+				backup.Name = name
+				backup.Labels = map[string]string{dptypes.BackupTypeLabelKey: string(backupType)}
+				backup.Status.Phase = phase
+				backup.Status.CompletionTimestamp = &metav1.Time{Time: completed}
+				return backup
+			}
+			backups := []dpv1alpha1.Backup{
+				newBackup("full-old", dpv1alpha1.BackupPhaseCompleted, dpv1alpha1.BackupTypeFull, now.Add(-2*time.Hour)),
+				newBackup("full-new", dpv1alpha1.BackupPhaseCompleted, dpv1alpha1.BackupTypeFull, now.Add(-time.Hour)),
+				newBackup("full-failed", dpv1alpha1.BackupPhaseFailed, dpv1alpha1.BackupTypeFull, now),
+				newBackup("incremental", dpv1alpha1.BackupPhaseCompleted, dpv1alpha1.BackupTypeIncremental, now),
+			}
+			latest := getLatestFullBackup(backups)
+			Expect(latest).ShouldNot(BeNil())
+			Expect(latest.Name).Should(Equal("full-new"))
+			Expect(getLatestFullBackup(backups[2:])).Should(BeNil())
+		})
+
+		It("should build the scratch cluster from the cluster snapshot", func() {
+			snapshot := &appsv1alpha1.Cluster{
+				Spec: appsv1alpha1.ClusterSpec{
+					TerminationPolicy: appsv1alpha1.Delete,
+					ComponentSpecs: []appsv1alpha1.ClusterComponentSpec{
+						{Name: testdp.ComponentName, OfflineInstances: []string{"pod-0"}},
+					},
+					Services: []appsv1alpha1.ClusterService{
+						{Service: appsv1alpha1.Service{Name: "lb", Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}},
+						{Service: appsv1alpha1.Service{Name: "internal", Spec: corev1.ServiceSpec{
+							Type:     corev1.ServiceTypeClusterIP,
+							Selector: map[string]string{constant.AppInstanceLabelKey: testdp.ClusterName},
+						}}},
+					},
+				},
+			}
+			snapshotBytes, err := json.Marshal(snapshot)
+			Expect(err).Should(Succeed())
+			backup := &dpv1alpha1.Backup{}
+			backup.Name = testdp.BackupName
+			backup.Namespace = testCtx.DefaultNamespace
+			backup.Labels = map[string]string{constant.KBAppComponentLabelKey: testdp.ComponentName}
+			backup.Annotations = map[string]string{constant.ClusterSnapshotAnnotationKey: string(snapshotBytes)}
+
+			verification := &dpv1alpha1.BackupVerification{}
+			verification.Name = "verify"
+			verification.Namespace = testCtx.DefaultNamespace
+			verification.UID = "0123456789abcdef"
+			verification.Status.ClusterName = buildScratchClusterName(verification)
+			Expect(verification.Status.ClusterName).Should(Equal("verify-01234567"))
+
+			cluster, err := buildScratchCluster(verification, backup)
+			Expect(err).Should(Succeed())
+			Expect(cluster.Name).Should(Equal(verification.Status.ClusterName))
+			Expect(cluster.Labels[dptypes.BackupVerificationLabelKey]).Should(Equal(verification.Name))
+			Expect(cluster.Annotations[constant.RestoreFromBackupAnnotationKey]).Should(ContainSubstring(testdp.BackupName))
+			Expect(cluster.Spec.TerminationPolicy).Should(Equal(appsv1alpha1.WipeOut))
+			Expect(cluster.Spec.Services).Should(HaveLen(1))
+			Expect(cluster.Spec.Services[0].Spec.Selector).ShouldNot(HaveKey(constant.AppInstanceLabelKey))
+			Expect(cluster.Spec.ComponentSpecs[0].OfflineInstances).Should(BeEmpty())
+		})
+	})
+})
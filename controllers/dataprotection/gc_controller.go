@@ -21,6 +21,8 @@ package dataprotection
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -106,6 +108,23 @@ func (r *GCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		return intctrlutil.Reconciled()
 	}
 
+	// the backup is still referenced by the incremental or differential backups, keep it until
+	// all the dependent backups are deleted.
+	dependents, err := dputils.GetDependentBackups(reqCtx.Ctx, r.Client, backup)
+	if err != nil {
+		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "")
+	}
+	if len(dependents) > 0 {
+		names := make([]string, 0, len(dependents))
+		for i := range dependents {
+			names = append(names, dependents[i].Name)
+		}
+		reqCtx.Log.Info("backup has expired, but it is depended on by other backups, skipping", "dependents", names)
+		r.Recorder.Event(backup, corev1.EventTypeNormal, "WaitForDependentBackups",
+			fmt.Sprintf("expired backup is kept since it is depended on by backups: %s", strings.Join(names, ",")))
+		return intctrlutil.Reconciled()
+	}
+
 	reqCtx.Log.Info("backup has expired, delete it", "backup", req.String())
 	if err := intctrlutil.BackgroundDeleteObject(r.Client, reqCtx.Ctx, backup); err != nil {
 		reqCtx.Log.Error(err, "failed to delete backup")
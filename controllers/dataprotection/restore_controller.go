@@ -292,6 +292,11 @@ func (r *RestoreReconciler) handleRunningPhase(reqCtx intctrlutil.RequestCtx, re
 	// patch restore status if changes occur
 	if !reflect.DeepEqual(restoreMgr.OriginalRestore.Status, restoreMgr.Restore.Status) {
 		err = r.Client.Status().Patch(reqCtx.Ctx, restoreMgr.Restore, client.MergeFrom(restoreMgr.OriginalRestore))
+		phase := restoreMgr.Restore.Status.Phase
+		if err == nil && restoreMgr.OriginalRestore.Status.Phase != phase &&
+			(phase == dpv1alpha1.RestorePhaseCompleted || phase == dpv1alpha1.RestorePhaseFailed) {
+			recordRestoreMetrics(restoreMgr.Restore)
+		}
 	}
 	if err != nil {
 		r.Recorder.Event(restore, corev1.EventTypeWarning, corev1.EventTypeWarning, err.Error())
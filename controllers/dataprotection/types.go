@@ -23,6 +23,8 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 )
 
 const (
@@ -32,7 +34,7 @@ const (
 const (
 
 	// label keys
-	dataProtectionBackupRepoKey          = "dataprotection.kubeblocks.io/backup-repo-name"
+	dataProtectionBackupRepoKey          = dptypes.BackupRepoLabelKey
 	dataProtectionWaitRepoPreparationKey = "dataprotection.kubeblocks.io/wait-repo-preparation"
 	dataProtectionIsToolConfigKey        = "dataprotection.kubeblocks.io/is-tool-config"
 
@@ -71,6 +73,11 @@ const (
 	ReasonDigestChanged             = "DigestChanged"
 	ReasonUnknownError              = "UnknownError"
 	ReasonSkipped                   = "Skipped"
+
+	// condition reasons for the recoverable windows of the backup policy
+	ReasonRecoverableWindowsContinuous = "RecoverableWindowsContinuous"
+	ReasonRecoverableWindowsHaveGaps   = "RecoverableWindowsHaveGaps"
+	ReasonNoRecoverableWindow          = "NoRecoverableWindow"
 )
 
 // constant  for volume populator
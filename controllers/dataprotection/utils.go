@@ -27,11 +27,13 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -48,6 +50,7 @@ import (
 	dperrors "github.com/apecloud/kubeblocks/pkg/dataprotection/errors"
 	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
 	dputils "github.com/apecloud/kubeblocks/pkg/dataprotection/utils"
+	"github.com/apecloud/kubeblocks/pkg/metrics"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
 
@@ -540,3 +543,30 @@ func fromFlattenName(flatten string) (name string, namespace string) {
 func getPopulatePVCName(pvcUID types.UID) string {
 	return fmt.Sprintf("%s-%s", PopulatePodPrefix, pvcUID)
 }
+
+// recordBackupMetrics records the duration and the size of a finished backup.
+func recordBackupMetrics(backup *dpv1alpha1.Backup) {
+	var duration time.Duration
+	switch {
+	case backup.Status.Duration != nil:
+		duration = backup.Status.Duration.Duration
+	case backup.Status.StartTimestamp != nil:
+		duration = time.Since(backup.Status.StartTimestamp.Time)
+	}
+	size := int64(-1)
+	if backup.Status.Phase == dpv1alpha1.BackupPhaseCompleted && backup.Status.TotalSize != "" {
+		if q, err := resource.ParseQuantity(backup.Status.TotalSize); err == nil {
+			size = q.Value()
+		}
+	}
+	metrics.RecordBackupFinished(backup.Namespace, backup.Spec.BackupMethod, string(backup.Status.Phase), duration, size)
+}
+
+// recordRestoreMetrics records the duration of a finished restore.
+func recordRestoreMetrics(restore *dpv1alpha1.Restore) {
+	var duration time.Duration
+	if restore.Status.Duration != nil {
+		duration = restore.Status.Duration.Duration
+	}
+	metrics.RecordRestoreFinished(restore.Namespace, string(restore.Status.Phase), duration)
+}
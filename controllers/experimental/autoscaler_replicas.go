@@ -0,0 +1,266 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubectl/pkg/util/podutils"
+
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+)
+
+const (
+	// the ratio of the current value to the target value within the tolerance is considered as no change.
+	scalingTolerance = 0.1
+
+	defaultScaleUpStabilizationWindowSeconds   = 0
+	defaultScaleDownStabilizationWindowSeconds = 300
+)
+
+// buildReplicasBounds intersects the min and max replicas of the autoscaler with the ReplicasLimit of the ComponentDefinition.
+func buildReplicasBounds(tree *kubebuilderx.ObjectTree, scaler *experimental.ComponentAutoscaler) (int32, int32, error) {
+	minReplicas, maxReplicas := max(scaler.Spec.MinReplicas, 1), scaler.Spec.MaxReplicas
+	fullName := constant.GenerateClusterComponentName(scaler.Spec.TargetClusterName, scaler.Spec.TargetComponentName)
+	limit, err := getReplicasLimit(tree, scaler.Namespace, fullName)
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit != nil {
+		minReplicas = max(minReplicas, limit.MinReplicas)
+		maxReplicas = min(maxReplicas, limit.MaxReplicas)
+	}
+	return minReplicas, maxReplicas, nil
+}
+
+// computeReplicasForMetrics calculates the proposed replicas of each metric and returns the largest one,
+// the replicas is ceil(current / target * ready pods) as the HorizontalPodAutoscaler does.
+func computeReplicasForMetrics(ctx context.Context, cli metricsClient, scaler *experimental.ComponentAutoscaler,
+	pods []*corev1.Pod, currentReplicas int32) (int32, []experimental.MetricStatus, error) {
+	if len(pods) == 0 {
+		return 0, nil, fmt.Errorf("no ready pods of component %s", scaler.Spec.TargetComponentName)
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		constant.AppInstanceLabelKey:    scaler.Spec.TargetClusterName,
+		constant.KBAppComponentLabelKey: scaler.Spec.TargetComponentName,
+	})
+	var (
+		proposal int32
+		statuses []experimental.MetricStatus
+	)
+	for _, metric := range scaler.Spec.Metrics {
+		var (
+			replicas int32
+			status   *experimental.MetricStatus
+			err      error
+		)
+		switch metric.Type {
+		case experimental.ResourceMetricSourceType:
+			replicas, status, err = computeReplicasForResourceMetric(ctx, cli, scaler.Namespace, selector, metric.Resource, pods, currentReplicas)
+		case experimental.PodsMetricSourceType:
+			replicas, status, err = computeReplicasForPodsMetric(ctx, cli, scaler.Namespace, selector, metric.Pods, pods, currentReplicas)
+		default:
+			err = fmt.Errorf("unknown metric source type %q", metric.Type)
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		proposal = max(proposal, replicas)
+		statuses = append(statuses, *status)
+	}
+	return proposal, statuses, nil
+}
+
+func computeReplicasForResourceMetric(ctx context.Context, cli metricsClient, namespace string, selector labels.Selector,
+	metric *experimental.ResourceMetricSource, pods []*corev1.Pod, currentReplicas int32) (int32, *experimental.MetricStatus, error) {
+	if metric == nil {
+		return 0, nil, fmt.Errorf("resource metric source is not set")
+	}
+	if metric.TargetAverageUtilization == nil && metric.TargetAverageValue == nil {
+		return 0, nil, fmt.Errorf("neither target utilization nor target value is set for resource %s", metric.Name)
+	}
+	values, err := cli.getResourceMetric(ctx, namespace, selector, metric.Name)
+	if err != nil {
+		return 0, nil, err
+	}
+	var usage, request, count int64
+	for _, pod := range pods {
+		value, ok := values[pod.Name]
+		if !ok {
+			continue
+		}
+		podRequest, ok := getPodRequest(pod, metric.Name)
+		if !ok && metric.TargetAverageUtilization != nil {
+			return 0, nil, fmt.Errorf("missing request for %s of pod %s", metric.Name, pod.Name)
+		}
+		usage += value
+		request += podRequest
+		count++
+	}
+	if count == 0 {
+		return 0, nil, fmt.Errorf("no metrics of resource %s returned for the ready pods", metric.Name)
+	}
+
+	format := resource.DecimalSI
+	if metric.Name == corev1.ResourceMemory {
+		format = resource.BinarySI
+	}
+	status := &experimental.MetricStatus{
+		Type:                experimental.ResourceMetricSourceType,
+		Name:                string(metric.Name),
+		CurrentAverageValue: resource.NewMilliQuantity(usage/count, format),
+	}
+	var ratio float64
+	if metric.TargetAverageUtilization != nil {
+		if request == 0 {
+			return 0, nil, fmt.Errorf("the request for %s of the ready pods is zero", metric.Name)
+		}
+		utilization := int32(usage * 100 / request)
+		status.CurrentAverageUtilization = &utilization
+		ratio = float64(utilization) / float64(*metric.TargetAverageUtilization)
+	} else {
+		ratio = float64(usage) / float64(count) / float64(metric.TargetAverageValue.MilliValue())
+	}
+	return replicasForUsageRatio(ratio, count, currentReplicas), status, nil
+}
+
+func computeReplicasForPodsMetric(ctx context.Context, cli metricsClient, namespace string, selector labels.Selector,
+	metric *experimental.PodsMetricSource, pods []*corev1.Pod, currentReplicas int32) (int32, *experimental.MetricStatus, error) {
+	if metric == nil {
+		return 0, nil, fmt.Errorf("pods metric source is not set")
+	}
+	values, err := cli.getPodsMetric(ctx, namespace, selector, metric.MetricName)
+	if err != nil {
+		return 0, nil, err
+	}
+	var sum, count int64
+	for _, pod := range pods {
+		value, ok := values[pod.Name]
+		if !ok {
+			continue
+		}
+		sum += value
+		count++
+	}
+	if count == 0 {
+		return 0, nil, fmt.Errorf("no metrics of %s returned for the ready pods", metric.MetricName)
+	}
+	status := &experimental.MetricStatus{
+		Type:                experimental.PodsMetricSourceType,
+		Name:                metric.MetricName,
+		CurrentAverageValue: resource.NewMilliQuantity(sum/count, resource.DecimalSI),
+	}
+	ratio := float64(sum) / float64(count) / float64(metric.TargetAverageValue.MilliValue())
+	return replicasForUsageRatio(ratio, count, currentReplicas), status, nil
+}
+
+func replicasForUsageRatio(ratio float64, count int64, currentReplicas int32) int32 {
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) || math.Abs(1.0-ratio) <= scalingTolerance {
+		return currentReplicas
+	}
+	return int32(math.Ceil(ratio * float64(count)))
+}
+
+func getPodRequest(pod *corev1.Pod, resourceName corev1.ResourceName) (int64, bool) {
+	var request int64
+	for _, container := range pod.Spec.Containers {
+		quantity, ok := container.Resources.Requests[resourceName]
+		if !ok {
+			return 0, false
+		}
+		request += quantity.MilliValue()
+	}
+	return request, true
+}
+
+// stabilizeRecommendation records the proposal and stabilizes it over the recommendations within the windows:
+// the lowest recommendation within the scale-up window bounds scaling up,
+// and the highest recommendation within the scale-down window bounds scaling down.
+func stabilizeRecommendation(scaler *experimental.ComponentAutoscaler, proposal, currentReplicas int32, now time.Time) int32 {
+	upWindow := stabilizationWindow(scaler.Spec.Behavior, true)
+	downWindow := stabilizationWindow(scaler.Spec.Behavior, false)
+	upCutoff, downCutoff := now.Add(-upWindow), now.Add(-downWindow)
+
+	upRecommendation, downRecommendation := proposal, proposal
+	recommendations := []experimental.Recommendation{{Timestamp: metav1.NewTime(now), Replicas: proposal}}
+	for _, rec := range scaler.Status.Recommendations {
+		inUpWindow, inDownWindow := rec.Timestamp.Time.After(upCutoff), rec.Timestamp.Time.After(downCutoff)
+		if inUpWindow {
+			upRecommendation = min(upRecommendation, rec.Replicas)
+		}
+		if inDownWindow {
+			downRecommendation = max(downRecommendation, rec.Replicas)
+		}
+		if inUpWindow || inDownWindow {
+			recommendations = append(recommendations, rec)
+		}
+	}
+	scaler.Status.Recommendations = recommendations
+
+	recommendation := currentReplicas
+	if recommendation < upRecommendation {
+		recommendation = upRecommendation
+	}
+	if recommendation > downRecommendation {
+		recommendation = downRecommendation
+	}
+	return recommendation
+}
+
+func stabilizationWindow(behavior *experimental.ComponentAutoscalerBehavior, scaleUp bool) time.Duration {
+	var rules *experimental.ScalingRules
+	seconds := int32(defaultScaleDownStabilizationWindowSeconds)
+	if scaleUp {
+		seconds = defaultScaleUpStabilizationWindowSeconds
+	}
+	switch {
+	case behavior == nil:
+	case scaleUp:
+		rules = behavior.ScaleUp
+	default:
+		rules = behavior.ScaleDown
+	}
+	if rules != nil && rules.StabilizationWindowSeconds != nil {
+		seconds = *rules.StabilizationWindowSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// getReadyPods returns the running and ready pods which are not terminating.
+func getReadyPods(tree *kubebuilderx.ObjectTree) []*corev1.Pod {
+	var pods []*corev1.Pod
+	for _, object := range tree.List(&corev1.Pod{}) {
+		pod, _ := object.(*corev1.Pod)
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning || !podutils.IsPodReady(pod) {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+	return pods
+}
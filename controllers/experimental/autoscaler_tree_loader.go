@@ -0,0 +1,115 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+)
+
+type autoscalerTreeLoader struct{}
+
+func (t *autoscalerTreeLoader) Load(ctx context.Context, reader client.Reader, req ctrl.Request, recorder record.EventRecorder, logger logr.Logger) (*kubebuilderx.ObjectTree, error) {
+	tree, err := kubebuilderx.ReadObjectTree[*experimental.ComponentAutoscaler](ctx, reader, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	root := tree.GetRoot()
+	if root == nil {
+		return tree, nil
+	}
+	scaler, _ := root.(*experimental.ComponentAutoscaler)
+
+	// the missing targets are reported in the status, so the not found errors are ignored.
+	getAndAdd := func(key types.NamespacedName, obj client.Object) (bool, error) {
+		if err := reader.Get(ctx, key, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, tree.Add(obj)
+	}
+	key := types.NamespacedName{Namespace: scaler.Namespace, Name: scaler.Spec.TargetClusterName}
+	if _, err = getAndAdd(key, &appsv1alpha1.Cluster{}); err != nil {
+		return nil, err
+	}
+	key.Name = constant.GenerateClusterComponentName(scaler.Spec.TargetClusterName, scaler.Spec.TargetComponentName)
+	comp := &appsv1alpha1.Component{}
+	found, err := getAndAdd(key, comp)
+	if err != nil {
+		return nil, err
+	}
+	if found && len(comp.Spec.CompDef) > 0 {
+		if _, err = getAndAdd(types.NamespacedName{Name: comp.Spec.CompDef}, &appsv1alpha1.ComponentDefinition{}); err != nil {
+			return nil, err
+		}
+	}
+
+	// the pods to read metrics from
+	podList := &corev1.PodList{}
+	podLabels := client.MatchingLabels{
+		constant.AppInstanceLabelKey:    scaler.Spec.TargetClusterName,
+		constant.KBAppComponentLabelKey: scaler.Spec.TargetComponentName,
+	}
+	if err = reader.List(ctx, podList, client.InNamespace(scaler.Namespace), podLabels); err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		if err = tree.Add(&podList.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// the OpsRequests created by this autoscaler
+	opsList := &appsv1alpha1.OpsRequestList{}
+	opsLabels := client.MatchingLabels{constant.ComponentAutoscalerLabelKey: scaler.Name}
+	if err = reader.List(ctx, opsList, client.InNamespace(scaler.Namespace), opsLabels); err != nil {
+		return nil, err
+	}
+	for i := range opsList.Items {
+		if err = tree.Add(&opsList.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	tree.EventRecorder = recorder
+	tree.Logger = logger
+
+	return tree, nil
+}
+
+func autoscalerObjectTree() kubebuilderx.TreeLoader {
+	return &autoscalerTreeLoader{}
+}
+
+var _ kubebuilderx.TreeLoader = &autoscalerTreeLoader{}
@@ -0,0 +1,113 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	testutil "github.com/apecloud/kubeblocks/pkg/testutil/k8s"
+)
+
+var _ = Describe("autoscaler tree loader test", func() {
+	Context("Read", func() {
+		It("should work well", func() {
+			ctx := context.Background()
+			logger := logf.FromContext(ctx).WithValues("autoscaler-tree-loader-test", "foo")
+			controller, k8sMock := testutil.SetupK8sMock()
+			defer controller.Finish()
+
+			clusterName := "foo"
+			compName := "bar"
+			root := builder.NewComponentAutoscalerBuilder(namespace, name).
+				SetTargetClusterName(clusterName).
+				SetTargetComponentName(compName).
+				GetObject()
+			cluster := builder.NewClusterBuilder(namespace, clusterName).GetObject()
+			compDef := builder.NewComponentDefinitionBuilder("test-compdef").GetObject()
+			comp := builder.NewComponentBuilder(namespace, constant.GenerateClusterComponentName(clusterName, compName), compDef.Name).GetObject()
+			pod := builder.NewPodBuilder(namespace, "pod-0").GetObject()
+			ops := &appsv1alpha1.OpsRequest{}
+			ops.Namespace, ops.Name = namespace, "ops-0"
+
+			k8sMock.EXPECT().
+				Get(gomock.Any(), gomock.Any(), &experimental.ComponentAutoscaler{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, objKey client.ObjectKey, obj *experimental.ComponentAutoscaler, _ ...client.GetOption) error {
+					*obj = *root
+					return nil
+				}).Times(1)
+			k8sMock.EXPECT().
+				Get(gomock.Any(), gomock.Any(), &appsv1alpha1.Cluster{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, objKey client.ObjectKey, obj *appsv1alpha1.Cluster, _ ...client.GetOption) error {
+					*obj = *cluster
+					return nil
+				}).Times(1)
+			k8sMock.EXPECT().
+				Get(gomock.Any(), gomock.Any(), &appsv1alpha1.Component{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, objKey client.ObjectKey, obj *appsv1alpha1.Component, _ ...client.GetOption) error {
+					Expect(objKey.Name).Should(Equal(comp.Name))
+					*obj = *comp
+					return nil
+				}).Times(1)
+			// the missing component definition is ignored
+			k8sMock.EXPECT().
+				Get(gomock.Any(), gomock.Any(), &appsv1alpha1.ComponentDefinition{}, gomock.Any()).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, compDef.Name)).Times(1)
+			k8sMock.EXPECT().
+				List(gomock.Any(), &corev1.PodList{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, list *corev1.PodList, _ ...client.ListOption) error {
+					list.Items = []corev1.Pod{*pod}
+					return nil
+				}).Times(1)
+			k8sMock.EXPECT().
+				List(gomock.Any(), &appsv1alpha1.OpsRequestList{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, list *appsv1alpha1.OpsRequestList, _ ...client.ListOption) error {
+					list.Items = []appsv1alpha1.OpsRequest{*ops}
+					return nil
+				}).Times(1)
+			req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(root)}
+			loader := autoscalerObjectTree()
+			tree, err := loader.Load(ctx, k8sMock, req, nil, logger)
+			Expect(err).Should(BeNil())
+			Expect(tree.GetRoot()).Should(Equal(root))
+			Expect(tree.GetSecondaryObjects()).Should(HaveLen(4))
+			objectList := []client.Object{cluster, comp, pod, ops}
+			for _, object := range objectList {
+				obj, err := tree.Get(object)
+				Expect(err).Should(BeNil())
+				Expect(obj).Should(Equal(object))
+			}
+		})
+	})
+})
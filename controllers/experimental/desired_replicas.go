@@ -0,0 +1,170 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+)
+
+const (
+	nodeNotReady           = "NotReady"
+	nodeCordoned           = "Cordoned"
+	nodeAffinityMismatched = "NodeAffinityMismatched"
+	nodeTaintNotTolerated  = "TaintNotTolerated"
+)
+
+type desiredReplicas struct {
+	replicas     int32
+	matchedNodes int32
+	reason       string
+	message      string
+}
+
+// buildDesiredReplicas counts the nodes the instances of the component can be scheduled on,
+// and bounds the count by the ReplicasLimit of the ComponentDefinition.
+func buildDesiredReplicas(tree *kubebuilderx.ObjectTree, scaler *experimental.NodeCountScaler, compName string) (*desiredReplicas, error) {
+	fullName := constant.GenerateClusterComponentName(scaler.Spec.TargetClusterName, compName)
+	object, err := tree.Get(builder.NewInstanceSetBuilder(scaler.Namespace, fullName).GetObject())
+	if err != nil || object == nil {
+		return nil, err
+	}
+	its, _ := object.(*workloads.InstanceSet)
+	pod := &corev1.Pod{Spec: its.Spec.Template.Spec}
+
+	nodes := tree.List(&corev1.Node{})
+	excluded := make(map[string]int)
+	matched := int32(0)
+	for _, item := range nodes {
+		node, _ := item.(*corev1.Node)
+		reason, err := checkNode(pod, node)
+		if err != nil {
+			return nil, err
+		}
+		if len(reason) > 0 {
+			excluded[reason]++
+			continue
+		}
+		matched++
+	}
+	result := &desiredReplicas{
+		replicas:     matched,
+		matchedNodes: matched,
+		reason:       experimental.ReasonNodesMatched,
+		message:      fmt.Sprintf("%d of %d nodes matched", matched, len(nodes)),
+	}
+	if len(excluded) > 0 {
+		reasons := maps.Keys(excluded)
+		slices.Sort(reasons)
+		for i, reason := range reasons {
+			reasons[i] = fmt.Sprintf("%d %s", excluded[reason], reason)
+		}
+		result.message = fmt.Sprintf("%s, excluded: %s", result.message, strings.Join(reasons, ", "))
+	}
+	// the nodes may be missing for a while, e.g. the node pool is being replaced, don't scale the instances in to zero.
+	if matched == 0 {
+		result.replicas = 0
+		if its.Spec.Replicas != nil {
+			result.replicas = *its.Spec.Replicas
+		}
+		result.reason = experimental.ReasonNoNodesMatched
+		result.message = fmt.Sprintf("%s, keep the replicas unchanged", result.message)
+		return result, nil
+	}
+
+	limit, err := getReplicasLimit(tree, scaler.Namespace, fullName)
+	if err != nil {
+		return nil, err
+	}
+	if limit != nil && (matched < limit.MinReplicas || matched > limit.MaxReplicas) {
+		result.replicas = min(max(matched, limit.MinReplicas), limit.MaxReplicas)
+		result.reason = experimental.ReasonReplicasLimited
+		result.message = fmt.Sprintf("%s, limited to [%d, %d] by the component definition", result.message, limit.MinReplicas, limit.MaxReplicas)
+	}
+	return result, nil
+}
+
+// checkNode returns the reason why the pod can't be scheduled on the node, empty if it can.
+func checkNode(pod *corev1.Pod, node *corev1.Node) (string, error) {
+	if !isNodeReady(node) {
+		return nodeNotReady, nil
+	}
+	if node.Spec.Unschedulable && !corev1helpers.TolerationsTolerateTaint(pod.Spec.Tolerations, &corev1.Taint{
+		Key:    corev1.TaintNodeUnschedulable,
+		Effect: corev1.TaintEffectNoSchedule,
+	}) {
+		return nodeCordoned, nil
+	}
+	// the node selector is checked along with the required node affinity
+	match, err := nodeaffinity.GetRequiredNodeAffinity(pod).Match(node)
+	if err != nil {
+		return "", err
+	}
+	if !match {
+		return nodeAffinityMismatched, nil
+	}
+	_, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, pod.Spec.Tolerations, func(taint *corev1.Taint) bool {
+		return taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute
+	})
+	if untolerated {
+		return nodeTaintNotTolerated, nil
+	}
+	return "", nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func getReplicasLimit(tree *kubebuilderx.ObjectTree, namespace, fullCompName string) (*appsv1alpha1.ReplicasLimit, error) {
+	comp := &appsv1alpha1.Component{}
+	comp.Namespace, comp.Name = namespace, fullCompName
+	object, err := tree.Get(comp)
+	if err != nil || object == nil {
+		return nil, err
+	}
+	comp, _ = object.(*appsv1alpha1.Component)
+	compDef := &appsv1alpha1.ComponentDefinition{}
+	compDef.Name = comp.Spec.CompDef
+	object, err = tree.Get(compDef)
+	if err != nil || object == nil {
+		return nil, err
+	}
+	compDef, _ = object.(*appsv1alpha1.ComponentDefinition)
+	return compDef.Spec.ReplicasLimit, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	experimentalv1alpha1 "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+)
+
+var _ = Describe("desired replicas test", func() {
+	newNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+	getITS := func(compName string) *workloads.InstanceSet {
+		object, err := tree.Get(builder.NewInstanceSetBuilder(namespace, constant.GenerateClusterComponentName(clusterName, compName)).GetObject())
+		Expect(err).Should(BeNil())
+		return object.(*workloads.InstanceSet)
+	}
+	poolLabels := map[string]string{"pool": "proxy"}
+
+	BeforeEach(func() {
+		tree = mockTestTree()
+
+		cordoned := newNode("node-cordoned", poolLabels)
+		cordoned.Spec.Unschedulable = true
+		notReady := newNode("node-not-ready", poolLabels)
+		notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+		tainted := newNode("node-tainted", poolLabels)
+		tainted.Spec.Taints = []corev1.Taint{{Key: "dedicated", Value: "proxy", Effect: corev1.TaintEffectNoSchedule}}
+		Expect(tree.Add(newNode("node-pool", poolLabels), cordoned, notReady, tainted)).Should(Succeed())
+
+		its := getITS(componentNames[0])
+		its.Spec.Template.Spec.NodeSelector = poolLabels
+	})
+
+	It("should count the nodes the pods can be scheduled on", func() {
+		desired, err := buildDesiredReplicas(tree, ncs, componentNames[0])
+		Expect(err).Should(BeNil())
+		Expect(desired.replicas).Should(BeEquivalentTo(1))
+		Expect(desired.matchedNodes).Should(BeEquivalentTo(1))
+		Expect(desired.reason).Should(Equal(experimentalv1alpha1.ReasonNodesMatched))
+		Expect(desired.message).Should(Equal("1 of 6 nodes matched, excluded: 1 Cordoned, 2 NodeAffinityMismatched, 1 NotReady, 1 TaintNotTolerated"))
+
+		By("tolerate the taint")
+		its := getITS(componentNames[0])
+		its.Spec.Template.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+		desired, err = buildDesiredReplicas(tree, ncs, componentNames[0])
+		Expect(err).Should(BeNil())
+		Expect(desired.replicas).Should(BeEquivalentTo(2))
+
+		By("the pods without constraints")
+		desired, err = buildDesiredReplicas(tree, ncs, componentNames[1])
+		Expect(err).Should(BeNil())
+		Expect(desired.replicas).Should(BeEquivalentTo(3))
+	})
+
+	It("should keep the replicas if no node is matched", func() {
+		its := getITS(componentNames[0])
+		its.Spec.Replicas = pointer.Int32(2)
+		its.Spec.Template.Spec.NodeSelector = map[string]string{"pool": "missing"}
+		desired, err := buildDesiredReplicas(tree, ncs, componentNames[0])
+		Expect(err).Should(BeNil())
+		Expect(desired.replicas).Should(BeEquivalentTo(2))
+		Expect(desired.matchedNodes).Should(BeEquivalentTo(0))
+		Expect(desired.reason).Should(Equal(experimentalv1alpha1.ReasonNoNodesMatched))
+	})
+
+	It("should respect the replicas limit of the component definition", func() {
+		compDef := builder.NewComponentDefinitionBuilder("test-compdef").SetReplicasLimit(2, 2).GetObject()
+		comp := builder.NewComponentBuilder(namespace, constant.GenerateClusterComponentName(clusterName, componentNames[1]), compDef.Name).GetObject()
+		Expect(tree.Add(compDef, comp)).Should(Succeed())
+
+		desired, err := buildDesiredReplicas(tree, ncs, componentNames[1])
+		Expect(err).Should(BeNil())
+		Expect(desired.replicas).Should(BeEquivalentTo(2))
+		Expect(desired.matchedNodes).Should(BeEquivalentTo(3))
+		Expect(desired.reason).Should(Equal(experimentalv1alpha1.ReasonReplicasLimited))
+	})
+})
@@ -0,0 +1,141 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+// metricsClient fetches the metrics of the pods of the target component.
+// All metric values are in milli-units, keyed by the pod name.
+type metricsClient interface {
+	// getResourceMetric returns the resource usage of each pod served by the metrics.k8s.io API.
+	getResourceMetric(ctx context.Context, namespace string, selector labels.Selector, resourceName corev1.ResourceName) (map[string]int64, error)
+
+	// getPodsMetric returns the custom metric of each pod served by the custom.metrics.k8s.io API.
+	getPodsMetric(ctx context.Context, namespace string, selector labels.Selector, metricName string) (map[string]int64, error)
+}
+
+const (
+	resourceMetricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+	customMetricsAPIPath   = "/apis/custom.metrics.k8s.io/v1beta2"
+)
+
+// podMetricsList is the subset of the PodMetricsList of the metrics.k8s.io API used by the autoscaler.
+type podMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Containers []struct {
+			Name  string              `json:"name"`
+			Usage corev1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// metricValueList is the subset of the MetricValueList of the custom.metrics.k8s.io API used by the autoscaler.
+type metricValueList struct {
+	Items []struct {
+		DescribedObject struct {
+			Kind string `json:"kind"`
+			Name string `json:"name"`
+		} `json:"describedObject"`
+		Value resource.Quantity `json:"value"`
+	} `json:"items"`
+}
+
+type restMetricsClient struct {
+	client rest.Interface
+}
+
+func (c *restMetricsClient) getResourceMetric(ctx context.Context, namespace string, selector labels.Selector, resourceName corev1.ResourceName) (map[string]int64, error) {
+	path := fmt.Sprintf("%s/namespaces/%s/pods", resourceMetricsAPIPath, namespace)
+	list := &podMetricsList{}
+	if err := c.get(ctx, path, selector, list); err != nil {
+		return nil, fmt.Errorf("unable to fetch metrics from resource metrics API: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("no metrics returned from resource metrics API")
+	}
+	values := make(map[string]int64, len(list.Items))
+	for _, item := range list.Items {
+		var sum int64
+		missing := len(item.Containers) == 0
+		for _, container := range item.Containers {
+			usage, ok := container.Usage[resourceName]
+			if !ok {
+				missing = true
+				break
+			}
+			sum += usage.MilliValue()
+		}
+		if missing {
+			continue
+		}
+		values[item.Metadata.Name] = sum
+	}
+	return values, nil
+}
+
+func (c *restMetricsClient) getPodsMetric(ctx context.Context, namespace string, selector labels.Selector, metricName string) (map[string]int64, error) {
+	path := fmt.Sprintf("%s/namespaces/%s/pods/*/%s", customMetricsAPIPath, namespace, metricName)
+	list := &metricValueList{}
+	if err := c.get(ctx, path, selector, list); err != nil {
+		return nil, fmt.Errorf("unable to fetch metrics from custom metrics API: %w", err)
+	}
+	if len(list.Items) == 0 {
+		return nil, fmt.Errorf("no metrics returned from custom metrics API")
+	}
+	values := make(map[string]int64, len(list.Items))
+	for _, item := range list.Items {
+		if item.DescribedObject.Kind != "Pod" {
+			continue
+		}
+		values[item.DescribedObject.Name] = item.Value.MilliValue()
+	}
+	return values, nil
+}
+
+func (c *restMetricsClient) get(ctx context.Context, path string, selector labels.Selector, into any) error {
+	data, err := c.client.Get().AbsPath(path).Param("labelSelector", selector.String()).DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, into)
+}
+
+func newMetricsClient(config *rest.Config) (metricsClient, error) {
+	cli, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &restMetricsClient{client: cli.RESTClient()}, nil
+}
+
+var _ metricsClient = &restMetricsClient{}
@@ -0,0 +1,82 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("metrics client test", func() {
+	var (
+		server   *httptest.Server
+		cli      metricsClient
+		selector = labels.SelectorFromSet(labels.Set{"app": "foo"})
+	)
+
+	BeforeEach(func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/apis/metrics.k8s.io/v1beta1/namespaces/default/pods", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("labelSelector")).Should(Equal("app=foo"))
+			_, _ = w.Write([]byte(`{"items":[
+				{"metadata":{"name":"pod-0"},"containers":[{"name":"c0","usage":{"cpu":"250m","memory":"1Mi"}},{"name":"c1","usage":{"cpu":"50m","memory":"1Mi"}}]},
+				{"metadata":{"name":"pod-1"},"containers":[{"name":"c0","usage":{"memory":"1Mi"}}]}]}`))
+		})
+		mux.HandleFunc("/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/pods/*/connections", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("labelSelector")).Should(Equal("app=foo"))
+			_, _ = w.Write([]byte(`{"items":[
+				{"describedObject":{"kind":"Pod","name":"pod-0"},"metric":{"name":"connections"},"value":"10"},
+				{"describedObject":{"kind":"Pod","name":"pod-1"},"metric":{"name":"connections"},"value":"1500m"}]}`))
+		})
+		server = httptest.NewServer(mux)
+
+		var err error
+		cli, err = newMetricsClient(&rest.Config{Host: server.URL})
+		Expect(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should read the resource metrics", func() {
+		values, err := cli.getResourceMetric(context.Background(), "default", selector, corev1.ResourceCPU)
+		Expect(err).Should(BeNil())
+		// pod-1 is skipped for the missing cpu usage
+		Expect(values).Should(Equal(map[string]int64{"pod-0": 300}))
+	})
+
+	It("should read the custom metrics", func() {
+		values, err := cli.getPodsMetric(context.Background(), "default", selector, "connections")
+		Expect(err).Should(BeNil())
+		Expect(values).Should(Equal(map[string]int64{"pod-0": 10000, "pod-1": 1500}))
+
+		_, err = cli.getPodsMetric(context.Background(), "default", selector, "lag")
+		Expect(err).ShouldNot(BeNil())
+	})
+})
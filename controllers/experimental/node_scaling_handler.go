@@ -21,7 +21,9 @@ package experimental
 
 import (
 	"context"
+	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,6 +43,21 @@ func (h *nodeScalingHandler) Create(ctx context.Context, event event.CreateEvent
 }
 
 func (h *nodeScalingHandler) Update(ctx context.Context, event event.UpdateEvent, limitingInterface workqueue.RateLimitingInterface) {
+	oldNode, ok := event.ObjectOld.(*corev1.Node)
+	if !ok {
+		return
+	}
+	newNode, ok := event.ObjectNew.(*corev1.Node)
+	if !ok {
+		return
+	}
+	// the nodes matched by the components may change
+	if !reflect.DeepEqual(oldNode.Labels, newNode.Labels) ||
+		!reflect.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints) ||
+		oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+		isNodeReady(oldNode) != isNodeReady(newNode) {
+		h.mapAndEnqueue(ctx, limitingInterface)
+	}
 }
 
 func (h *nodeScalingHandler) Delete(ctx context.Context, event event.DeleteEvent, limitingInterface workqueue.RateLimitingInterface) {
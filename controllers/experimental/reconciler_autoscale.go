@@ -0,0 +1,205 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+)
+
+const (
+	// the interval to fetch the metrics and re-calculate the desired replicas.
+	autoscalerSyncPeriod = 15 * time.Second
+
+	eventReasonScaleOpsRequestCreated = "ScaleOpsRequestCreated"
+)
+
+type autoscaleReconciler struct {
+	ctx     context.Context
+	metrics metricsClient
+
+	// requeueAfter is the interval to reconcile again, zero if there is no need to.
+	requeueAfter time.Duration
+}
+
+func (r *autoscaleReconciler) PreCondition(tree *kubebuilderx.ObjectTree) *kubebuilderx.CheckResult {
+	if tree.GetRoot() == nil || model.IsObjectDeleting(tree.GetRoot()) {
+		return kubebuilderx.ResultUnsatisfied
+	}
+	return kubebuilderx.ResultSatisfied
+}
+
+func (r *autoscaleReconciler) Reconcile(tree *kubebuilderx.ObjectTree) (*kubebuilderx.ObjectTree, error) {
+	scaler, _ := tree.GetRoot().(*experimental.ComponentAutoscaler)
+	r.requeueAfter = autoscalerSyncPeriod
+
+	cluster, compSpec, err := getTargetComponent(tree, scaler)
+	if err != nil {
+		return nil, err
+	}
+	if compSpec == nil && cluster != nil && cluster.Spec.GetShardingByName(scaler.Spec.TargetComponentName) != nil {
+		// the shards are scaled as a whole, which the metrics of a component can't decide.
+		setCondition(scaler, experimental.ScalingActive, metav1.ConditionFalse, experimental.ReasonShardingNotSupported,
+			fmt.Sprintf("%s of cluster %s is a sharding, which is not supported", scaler.Spec.TargetComponentName, scaler.Spec.TargetClusterName))
+		r.requeueAfter = 0
+		return tree, nil
+	}
+	if compSpec == nil {
+		setCondition(scaler, experimental.ScalingActive, metav1.ConditionFalse, experimental.ReasonTargetNotFound,
+			fmt.Sprintf("component %s of cluster %s not found", scaler.Spec.TargetComponentName, scaler.Spec.TargetClusterName))
+		return tree, nil
+	}
+	currentReplicas := compSpec.Replicas
+	scaler.Status.CurrentReplicas = currentReplicas
+
+	minReplicas, maxReplicas, err := buildReplicasBounds(tree, scaler)
+	if err != nil {
+		return nil, err
+	}
+	if minReplicas > maxReplicas {
+		setCondition(scaler, experimental.ScalingActive, metav1.ConditionFalse, experimental.ReasonInvalidBounds,
+			fmt.Sprintf("the replicas bounds [%d, %d] are empty after applying the ReplicasLimit of the ComponentDefinition", minReplicas, maxReplicas))
+		return tree, nil
+	}
+
+	proposal, metricStatuses, err := computeReplicasForMetrics(r.ctx, r.metrics, scaler, getReadyPods(tree), currentReplicas)
+	if err != nil {
+		setCondition(scaler, experimental.ScalingActive, metav1.ConditionFalse, experimental.ReasonFailedGetMetrics, err.Error())
+		return tree, nil
+	}
+	scaler.Status.CurrentMetrics = metricStatuses
+	setCondition(scaler, experimental.ScalingActive, metav1.ConditionTrue, experimental.ReasonValidMetricFound,
+		fmt.Sprintf("the desired replicas calculated from metrics is %d", proposal))
+
+	desiredReplicas := stabilizeRecommendation(scaler, proposal, currentReplicas, time.Now())
+	desiredReplicas = min(max(desiredReplicas, minReplicas), maxReplicas)
+	scaler.Status.DesiredReplicas = desiredReplicas
+
+	switch {
+	case desiredReplicas == currentReplicas:
+		setCondition(scaler, experimental.AbleToScale, metav1.ConditionTrue, experimental.ReasonReadyForNewScale,
+			"the desired replicas is the same as the current replicas")
+		return tree, nil
+	case cluster.Status.Phase != appsv1alpha1.RunningClusterPhase:
+		setCondition(scaler, experimental.AbleToScale, metav1.ConditionFalse, experimental.ReasonTargetNotReady,
+			fmt.Sprintf("cluster %s is %s", cluster.Name, cluster.Status.Phase))
+		return tree, nil
+	}
+	if ops := getUnfinishedOpsRequest(tree); ops != nil {
+		setCondition(scaler, experimental.AbleToScale, metav1.ConditionFalse, experimental.ReasonOpsRequestInProgress,
+			fmt.Sprintf("OpsRequest %s is %s", ops.Name, ops.Status.Phase))
+		return tree, nil
+	}
+
+	// scale the component through the OpsRequest, so that the queueing, data cloning and member join/leave apply.
+	ops := buildHorizontalScalingOpsRequest(scaler, desiredReplicas)
+	if err = tree.Add(ops); err != nil {
+		return nil, err
+	}
+	now := metav1.Now()
+	scaler.Status.LastScaleTime = &now
+	scaler.Status.LastOpsRequestName = ops.Name
+	message := fmt.Sprintf("scale component %s from %d to %d replicas by OpsRequest %s",
+		scaler.Spec.TargetComponentName, currentReplicas, desiredReplicas, ops.Name)
+	setCondition(scaler, experimental.AbleToScale, metav1.ConditionTrue, experimental.ReasonOpsRequestCreated, message)
+	if tree.EventRecorder != nil {
+		tree.EventRecorder.Event(scaler, corev1.EventTypeNormal, eventReasonScaleOpsRequestCreated, message)
+	}
+	return tree, nil
+}
+
+func getTargetComponent(tree *kubebuilderx.ObjectTree, scaler *experimental.ComponentAutoscaler) (*appsv1alpha1.Cluster, *appsv1alpha1.ClusterComponentSpec, error) {
+	object, err := tree.Get(builder.NewClusterBuilder(scaler.Namespace, scaler.Spec.TargetClusterName).GetObject())
+	if err != nil || object == nil {
+		return nil, nil, err
+	}
+	cluster, _ := object.(*appsv1alpha1.Cluster)
+	return cluster, cluster.Spec.GetComponentByName(scaler.Spec.TargetComponentName), nil
+}
+
+func getUnfinishedOpsRequest(tree *kubebuilderx.ObjectTree) *appsv1alpha1.OpsRequest {
+	for _, object := range tree.List(&appsv1alpha1.OpsRequest{}) {
+		ops, _ := object.(*appsv1alpha1.OpsRequest)
+		switch ops.Status.Phase {
+		case appsv1alpha1.OpsSucceedPhase, appsv1alpha1.OpsCancelledPhase, appsv1alpha1.OpsFailedPhase, appsv1alpha1.OpsAbortedPhase:
+		default:
+			return ops
+		}
+	}
+	return nil
+}
+
+func buildHorizontalScalingOpsRequest(scaler *experimental.ComponentAutoscaler, replicas int32) *appsv1alpha1.OpsRequest {
+	clusterName, compName := scaler.Spec.TargetClusterName, scaler.Spec.TargetComponentName
+	labels := map[string]string{
+		constant.AppInstanceLabelKey:         clusterName,
+		constant.OpsRequestTypeLabelKey:      string(appsv1alpha1.HorizontalScalingType),
+		constant.ComponentAutoscalerLabelKey: scaler.Name,
+	}
+	return &appsv1alpha1.OpsRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-autoscaling-%s", clusterName, compName, rand.String(5)),
+			Namespace: scaler.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1alpha1.OpsRequestSpec{
+			ClusterName: clusterName,
+			Type:        appsv1alpha1.HorizontalScalingType,
+			SpecificOpsRequest: appsv1alpha1.SpecificOpsRequest{
+				HorizontalScalingList: []appsv1alpha1.HorizontalScaling{
+					{
+						ComponentOps: appsv1alpha1.ComponentOps{ComponentName: compName},
+						Replicas:     &replicas,
+					},
+				},
+			},
+		},
+	}
+}
+
+func setCondition(scaler *experimental.ComponentAutoscaler, conditionType experimental.ConditionType,
+	status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&scaler.Status.Conditions, metav1.Condition{
+		Type:               string(conditionType),
+		Status:             status,
+		ObservedGeneration: scaler.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+func autoscale(ctx context.Context, metrics metricsClient) *autoscaleReconciler {
+	return &autoscaleReconciler{ctx: ctx, metrics: metrics}
+}
+
+var _ kubebuilderx.Reconciler = &autoscaleReconciler{}
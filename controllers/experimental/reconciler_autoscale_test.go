@@ -0,0 +1,268 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package experimental
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+)
+
+type fakeMetricsClient struct {
+	resourceMetrics map[corev1.ResourceName]map[string]int64
+	podsMetrics     map[string]map[string]int64
+}
+
+func (c *fakeMetricsClient) getResourceMetric(_ context.Context, _ string, _ labels.Selector, resourceName corev1.ResourceName) (map[string]int64, error) {
+	values, ok := c.resourceMetrics[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("no metrics returned from resource metrics API")
+	}
+	return values, nil
+}
+
+func (c *fakeMetricsClient) getPodsMetric(_ context.Context, _ string, _ labels.Selector, metricName string) (map[string]int64, error) {
+	values, ok := c.podsMetrics[metricName]
+	if !ok {
+		return nil, fmt.Errorf("no metrics returned from custom metrics API")
+	}
+	return values, nil
+}
+
+var _ = Describe("autoscale reconciler test", func() {
+	const (
+		compName    = "mysql"
+		compDefName = "mysql-compdef"
+	)
+
+	var (
+		casTree *kubebuilderx.ObjectTree
+		cas     *experimental.ComponentAutoscaler
+		cluster *appsv1alpha1.Cluster
+		metrics *fakeMetricsClient
+	)
+
+	newPod := func(name string) *corev1.Pod {
+		container := builder.NewContainerBuilder("mysql").
+			SetResources(corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			}).GetObject()
+		pod := builder.NewPodBuilder(namespace, name).
+			AddLabels(constant.AppInstanceLabelKey, clusterName, constant.KBAppComponentLabelKey, compName).
+			AddContainer(*container).
+			GetObject()
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		return pod
+	}
+
+	reconcileAndCheck := func() *experimental.ComponentAutoscaler {
+		reconciler := autoscale(context.Background(), metrics)
+		Expect(reconciler.PreCondition(casTree)).Should(Equal(kubebuilderx.ResultSatisfied))
+		newTree, err := reconciler.Reconcile(casTree)
+		Expect(err).Should(BeNil())
+		Expect(reconciler.requeueAfter).Should(Equal(autoscalerSyncPeriod))
+		casTree = newTree
+		newCAS, ok := casTree.GetRoot().(*experimental.ComponentAutoscaler)
+		Expect(ok).Should(BeTrue())
+		return newCAS
+	}
+
+	listOps := func() []*appsv1alpha1.OpsRequest {
+		var opsList []*appsv1alpha1.OpsRequest
+		for _, object := range casTree.List(&appsv1alpha1.OpsRequest{}) {
+			opsList = append(opsList, object.(*appsv1alpha1.OpsRequest))
+		}
+		return opsList
+	}
+
+	BeforeEach(func() {
+		cas = builder.NewComponentAutoscalerBuilder(namespace, name).
+			SetTargetClusterName(clusterName).
+			SetTargetComponentName(compName).
+			SetReplicas(1, 10).
+			AddMetrics(experimental.MetricSpec{
+				Type: experimental.ResourceMetricSourceType,
+				Resource: &experimental.ResourceMetricSource{
+					Name:                     corev1.ResourceCPU,
+					TargetAverageUtilization: pointer.Int32(50),
+				},
+			}).
+			GetObject()
+		cluster = builder.NewClusterBuilder(namespace, clusterName).
+			SetComponentSpecs([]appsv1alpha1.ClusterComponentSpec{{Name: compName, Replicas: 2}}).
+			GetObject()
+		cluster.Status.Phase = appsv1alpha1.RunningClusterPhase
+		compDef := builder.NewComponentDefinitionBuilder(compDefName).SetReplicasLimit(1, 5).GetObject()
+		comp := builder.NewComponentBuilder(namespace, constant.GenerateClusterComponentName(clusterName, compName), compDefName).GetObject()
+		metrics = &fakeMetricsClient{
+			resourceMetrics: map[corev1.ResourceName]map[string]int64{
+				corev1.ResourceCPU: {"pod-0": 500, "pod-1": 500},
+			},
+		}
+
+		casTree = kubebuilderx.NewObjectTree()
+		casTree.SetRoot(cas)
+		casTree.EventRecorder = record.NewFakeRecorder(10)
+		Expect(casTree.Add(cluster, comp, compDef, newPod("pod-0"), newPod("pod-1"))).Should(Succeed())
+	})
+
+	Context("PreCondition & Reconcile", func() {
+		It("should keep the replicas if the metrics are within the tolerance", func() {
+			newCAS := reconcileAndCheck()
+			Expect(newCAS.Status.CurrentReplicas).Should(BeEquivalentTo(2))
+			Expect(newCAS.Status.DesiredReplicas).Should(BeEquivalentTo(2))
+			Expect(newCAS.Status.CurrentMetrics).Should(HaveLen(1))
+			Expect(*newCAS.Status.CurrentMetrics[0].CurrentAverageUtilization).Should(BeEquivalentTo(50))
+			Expect(meta.IsStatusConditionTrue(newCAS.Status.Conditions, string(experimental.ScalingActive))).Should(BeTrue())
+			condition := meta.FindStatusCondition(newCAS.Status.Conditions, string(experimental.AbleToScale))
+			Expect(condition).ShouldNot(BeNil())
+			Expect(condition.Reason).Should(Equal(experimental.ReasonReadyForNewScale))
+			Expect(listOps()).Should(BeEmpty())
+		})
+
+		It("should scale up through the HorizontalScaling OpsRequest, bounded by the ReplicasLimit", func() {
+			metrics.resourceMetrics[corev1.ResourceCPU] = map[string]int64{"pod-0": 2000, "pod-1": 2000}
+
+			newCAS := reconcileAndCheck()
+			// ceil(400 / 50 * 2) = 16, bounded by the max replicas 5 of the ComponentDefinition
+			Expect(newCAS.Status.DesiredReplicas).Should(BeEquivalentTo(5))
+			opsList := listOps()
+			Expect(opsList).Should(HaveLen(1))
+			ops := opsList[0]
+			Expect(ops.Name).Should(Equal(newCAS.Status.LastOpsRequestName))
+			Expect(ops.Labels).Should(HaveKeyWithValue(constant.ComponentAutoscalerLabelKey, cas.Name))
+			Expect(ops.Labels).Should(HaveKeyWithValue(constant.AppInstanceLabelKey, clusterName))
+			Expect(ops.Spec.ClusterName).Should(Equal(clusterName))
+			Expect(ops.Spec.Type).Should(Equal(appsv1alpha1.HorizontalScalingType))
+			Expect(ops.Spec.HorizontalScalingList).Should(HaveLen(1))
+			Expect(ops.Spec.HorizontalScalingList[0].ComponentName).Should(Equal(compName))
+			Expect(*ops.Spec.HorizontalScalingList[0].Replicas).Should(BeEquivalentTo(5))
+			Expect(newCAS.Status.LastScaleTime).ShouldNot(BeNil())
+
+			By("not create another OpsRequest until the previous one is finished")
+			newCAS = reconcileAndCheck()
+			Expect(listOps()).Should(HaveLen(1))
+			condition := meta.FindStatusCondition(newCAS.Status.Conditions, string(experimental.AbleToScale))
+			Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).Should(Equal(experimental.ReasonOpsRequestInProgress))
+
+			By("create a new OpsRequest once the previous one is finished")
+			ops.Status.Phase = appsv1alpha1.OpsSucceedPhase
+			reconcileAndCheck()
+			Expect(listOps()).Should(HaveLen(2))
+		})
+
+		It("should stabilize scaling down", func() {
+			metrics.resourceMetrics[corev1.ResourceCPU] = map[string]int64{"pod-0": 100, "pod-1": 100}
+			cas.Status.Recommendations = []experimental.Recommendation{
+				{Timestamp: metav1.NewTime(time.Now().Add(-time.Minute)), Replicas: 2},
+				{Timestamp: metav1.NewTime(time.Now().Add(-time.Hour)), Replicas: 10},
+			}
+
+			newCAS := reconcileAndCheck()
+			Expect(newCAS.Status.DesiredReplicas).Should(BeEquivalentTo(2))
+			// the stale recommendation is pruned
+			Expect(newCAS.Status.Recommendations).Should(HaveLen(2))
+			Expect(listOps()).Should(BeEmpty())
+
+			By("scale down once the window is passed")
+			newCAS.Status.Recommendations = nil
+			newCAS = reconcileAndCheck()
+			// ceil(10 / 50 * 2) = 1
+			Expect(newCAS.Status.DesiredReplicas).Should(BeEquivalentTo(1))
+			Expect(listOps()).Should(HaveLen(1))
+		})
+
+		It("should report the failures", func() {
+			By("the bounds are empty")
+			cas.Spec.MinReplicas = 6
+			newCAS := reconcileAndCheck()
+			condition := meta.FindStatusCondition(newCAS.Status.Conditions, string(experimental.ScalingActive))
+			Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).Should(Equal(experimental.ReasonInvalidBounds))
+
+			By("the metrics are unavailable")
+			newCAS.Spec.MinReplicas = 1
+			delete(metrics.resourceMetrics, corev1.ResourceCPU)
+			newCAS = reconcileAndCheck()
+			condition = meta.FindStatusCondition(newCAS.Status.Conditions, string(experimental.ScalingActive))
+			Expect(condition.Status).Should(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).Should(Equal(experimental.ReasonFailedGetMetrics))
+
+			By("the target component is not found")
+			newCAS.Spec.TargetComponentName = "foo"
+			newCAS = reconcileAndCheck()
+			condition = meta.FindStatusCondition(newCAS.Status.Conditions, string(experimental.ScalingActive))
+			Expect(condition.Reason).Should(Equal(experimental.ReasonTargetNotFound))
+			Expect(listOps()).Should(BeEmpty())
+
+			By("the target is a sharding")
+			cluster.Spec.ShardingSpecs = []appsv1alpha1.ShardingSpec{{Name: "foo", Shards: 2}}
+			reconciler := autoscale(context.Background(), metrics)
+			_, err := reconciler.Reconcile(casTree)
+			Expect(err).Should(BeNil())
+			Expect(reconciler.requeueAfter).Should(BeZero())
+			condition = meta.FindStatusCondition(newCAS.Status.Conditions, string(experimental.ScalingActive))
+			Expect(condition.Reason).Should(Equal(experimental.ReasonShardingNotSupported))
+			Expect(listOps()).Should(BeEmpty())
+		})
+	})
+
+	Context("computeReplicasForMetrics", func() {
+		It("should use the largest proposal of the metrics", func() {
+			cas.Spec.Metrics = append(cas.Spec.Metrics, experimental.MetricSpec{
+				Type: experimental.PodsMetricSourceType,
+				Pods: &experimental.PodsMetricSource{
+					MetricName:         "connections",
+					TargetAverageValue: resource.MustParse("100"),
+				},
+			})
+			metrics.podsMetrics = map[string]map[string]int64{
+				"connections": {"pod-0": 300000, "pod-1": 100000},
+			}
+			pods := getReadyPods(casTree)
+			replicas, statuses, err := computeReplicasForMetrics(context.Background(), metrics, cas, pods, 2)
+			Expect(err).Should(BeNil())
+			// ceil(200 / 100 * 2) = 4
+			Expect(replicas).Should(BeEquivalentTo(4))
+			Expect(statuses).Should(HaveLen(2))
+			Expect(statuses[1].Name).Should(Equal("connections"))
+			Expect(statuses[1].CurrentAverageValue.Value()).Should(BeEquivalentTo(200))
+		})
+	})
+})
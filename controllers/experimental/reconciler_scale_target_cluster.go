@@ -23,7 +23,6 @@ import (
 	"time"
 
 	"golang.org/x/exp/slices"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
@@ -50,9 +49,6 @@ func (r *scaleTargetClusterReconciler) Reconcile(tree *kubebuilderx.ObjectTree)
 		return nil, err
 	}
 	cluster, _ := object.(*appsv1alpha1.Cluster)
-	nodes := tree.List(&corev1.Node{})
-	// TODO(free6om): filter nodes that satisfy pod template spec of each component (by nodeSelector, nodeAffinity&nodeAntiAffinity, tolerations)
-	desiredReplicas := int32(len(nodes))
 	scaled := false
 	for i := range cluster.Spec.ComponentSpecs {
 		spec := &cluster.Spec.ComponentSpecs[i]
@@ -61,8 +57,15 @@ func (r *scaleTargetClusterReconciler) Reconcile(tree *kubebuilderx.ObjectTree)
 		}) < 0 {
 			continue
 		}
-		if spec.Replicas != desiredReplicas {
-			spec.Replicas = desiredReplicas
+		desired, err := buildDesiredReplicas(tree, scaler, spec.Name)
+		if err != nil {
+			return nil, err
+		}
+		if desired == nil || desired.reason == experimental.ReasonNoNodesMatched {
+			continue
+		}
+		if spec.Replicas != desired.replicas {
+			spec.Replicas = desired.replicas
 			scaled = true
 		}
 	}
@@ -59,6 +59,16 @@ var _ = Describe("scale target cluster reconciler test", func() {
 			Expect(newCluster.Spec.ComponentSpecs).Should(HaveLen(2))
 			Expect(newCluster.Spec.ComponentSpecs[0].Replicas).Should(Equal(desiredReplicas))
 			Expect(newCluster.Spec.ComponentSpecs[1].Replicas).Should(Equal(desiredReplicas))
+
+			By("not scale in to zero if no node is matched")
+			for _, node := range nodes {
+				Expect(newTree.Delete(node)).Should(Succeed())
+			}
+			newTree, err = reconciler.Reconcile(newTree)
+			Expect(err).Should(BeNil())
+			object, err = newTree.Get(newCluster)
+			Expect(err).Should(BeNil())
+			Expect(object.(*appsv1alpha1.Cluster).Spec.ComponentSpecs[0].Replicas).Should(Equal(desiredReplicas))
 		})
 	})
 })
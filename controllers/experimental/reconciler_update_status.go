@@ -24,7 +24,6 @@ import (
 	"strings"
 
 	"golang.org/x/exp/slices"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,9 +48,6 @@ func (r *updateStatusReconciler) PreCondition(tree *kubebuilderx.ObjectTree) *ku
 func (r *updateStatusReconciler) Reconcile(tree *kubebuilderx.ObjectTree) (*kubebuilderx.ObjectTree, error) {
 	scaler, _ := tree.GetRoot().(*experimental.NodeCountScaler)
 	itsList := tree.List(&workloads.InstanceSet{})
-	nodes := tree.List(&corev1.Node{})
-	// TODO(free6om): filter nodes that satisfy pod template spec of each component (by nodeSelector, nodeAffinity&nodeAntiAffinity, tolerations)
-	desiredReplicas := int32(len(nodes))
 	var statusList []experimental.ComponentStatus
 	for _, name := range scaler.Spec.TargetComponentNames {
 		index := slices.IndexFunc(itsList, func(object client.Object) bool {
@@ -62,12 +58,19 @@ func (r *updateStatusReconciler) Reconcile(tree *kubebuilderx.ObjectTree) (*kube
 			continue
 		}
 		its, _ := itsList[index].(*workloads.InstanceSet)
+		desired, err := buildDesiredReplicas(tree, scaler, name)
+		if err != nil {
+			return nil, err
+		}
 		status := experimental.ComponentStatus{
 			Name:              name,
 			CurrentReplicas:   its.Status.CurrentReplicas,
 			ReadyReplicas:     its.Status.ReadyReplicas,
 			AvailableReplicas: its.Status.AvailableReplicas,
-			DesiredReplicas:   desiredReplicas,
+			DesiredReplicas:   desired.replicas,
+			MatchedNodes:      desired.matchedNodes,
+			Reason:            desired.reason,
+			Message:           desired.message,
 		}
 		statusList = append(statusList, status)
 	}
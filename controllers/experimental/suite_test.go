@@ -77,12 +77,18 @@ func mockTestTree() *kubebuilderx.ObjectTree {
 			Namespace: namespace,
 			Name:      "node-0",
 		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
 	}
 	node1 := &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      "node-1",
 		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
 	}
 
 	tree = kubebuilderx.NewObjectTree()
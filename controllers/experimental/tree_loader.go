@@ -66,6 +66,24 @@ func (t *treeLoader) Load(ctx context.Context, reader client.Reader, req ctrl.Re
 		if err = tree.Add(its); err != nil {
 			return nil, err
 		}
+		// load the component definition for the replicas limit
+		comp := &appsv1alpha1.Component{}
+		if err = reader.Get(ctx, key, comp); err != nil {
+			return nil, err
+		}
+		if err = tree.Add(comp); err != nil {
+			return nil, err
+		}
+		if len(comp.Spec.CompDef) == 0 {
+			continue
+		}
+		compDef := &appsv1alpha1.ComponentDefinition{}
+		if err = reader.Get(ctx, types.NamespacedName{Name: comp.Spec.CompDef}, compDef); err != nil {
+			return nil, err
+		}
+		if err = tree.Add(compDef); err != nil {
+			return nil, err
+		}
 	}
 	nodeList := &corev1.NodeList{}
 	if err = reader.List(ctx, nodeList); err != nil {
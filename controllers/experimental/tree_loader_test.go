@@ -54,6 +54,10 @@ var _ = Describe("tree loader test", func() {
 			cluster := builder.NewClusterBuilder(namespace, clusterName).GetObject()
 			its0 := builder.NewInstanceSetBuilder(namespace, constant.GenerateClusterComponentName(clusterName, componentNames[0])).GetObject()
 			its1 := builder.NewInstanceSetBuilder(namespace, constant.GenerateClusterComponentName(clusterName, componentNames[1])).GetObject()
+			compDef := builder.NewComponentDefinitionBuilder("test-compdef").GetObject()
+			comp0 := builder.NewComponentBuilder(namespace, its0.Name, compDef.Name).GetObject()
+			// the legacy component without the definition
+			comp1 := builder.NewComponentBuilder(namespace, its1.Name, "").GetObject()
 			node0 := &corev1.Node{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: namespace,
@@ -89,6 +93,23 @@ var _ = Describe("tree loader test", func() {
 					}
 					return nil
 				}).Times(2)
+			k8sMock.EXPECT().
+				Get(gomock.Any(), gomock.Any(), &appsv1alpha1.Component{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, objKey client.ObjectKey, obj *appsv1alpha1.Component, _ ...client.GetOption) error {
+					if objKey.Name == comp0.Name {
+						*obj = *comp0
+					} else {
+						*obj = *comp1
+					}
+					return nil
+				}).Times(2)
+			k8sMock.EXPECT().
+				Get(gomock.Any(), gomock.Any(), &appsv1alpha1.ComponentDefinition{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, objKey client.ObjectKey, obj *appsv1alpha1.ComponentDefinition, _ ...client.GetOption) error {
+					Expect(objKey.Name).Should(Equal(compDef.Name))
+					*obj = *compDef
+					return nil
+				}).Times(1)
 			k8sMock.EXPECT().
 				List(gomock.Any(), &corev1.NodeList{}, gomock.Any()).
 				DoAndReturn(func(_ context.Context, list *corev1.NodeList, _ ...client.ListOption) error {
@@ -101,8 +122,8 @@ var _ = Describe("tree loader test", func() {
 			Expect(err).Should(BeNil())
 			Expect(tree.GetRoot()).ShouldNot(BeNil())
 			Expect(tree.GetRoot()).Should(Equal(root))
-			Expect(tree.GetSecondaryObjects()).Should(HaveLen(5))
-			objectList := []client.Object{cluster, its0, its1, node0, node1}
+			Expect(tree.GetSecondaryObjects()).Should(HaveLen(8))
+			objectList := []client.Object{cluster, its0, its1, comp0, comp1, compDef, node0, node1}
 			for _, object := range objectList {
 				obj, err := tree.Get(object)
 				Expect(err).Should(BeNil())
@@ -36,6 +36,10 @@ import (
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
+type eventHandler interface {
+	Handle(client.Client, intctrlutil.RequestCtx, record.EventRecorder, *corev1.Event) error
+}
+
 // EventReconciler reconciles an Event object
 type EventReconciler struct {
 	client.Client
@@ -65,9 +69,14 @@ func (r *EventReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return intctrlutil.CheckedRequeueWithError(err, reqCtx.Log, "getEventError")
 	}
 
-	handler := &instanceset.PodRoleEventHandler{}
-	if err := handler.Handle(r.Client, reqCtx, r.Recorder, event); err != nil && !apierrors.IsNotFound(err) {
-		return intctrlutil.RequeueWithError(err, reqCtx.Log, "handleEventError")
+	handlers := []eventHandler{
+		&instanceset.PodRoleEventHandler{},
+		&VolumeAutoscalingEventHandler{},
+	}
+	for _, handler := range handlers {
+		if err := handler.Handle(r.Client, reqCtx, r.Recorder, event); err != nil && !apierrors.IsNotFound(err) {
+			return intctrlutil.RequeueWithError(err, reqCtx.Log, "handleEventError")
+		}
 	}
 	return intctrlutil.Reconciled()
 }
@@ -0,0 +1,232 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package k8score
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/multicluster"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	lorryutil "github.com/apecloud/kubeblocks/pkg/lorry/util"
+)
+
+const (
+	// volumeAutoscalingAnnotKey is used to mark the volume autoscaling event has been handled.
+	volumeAutoscalingAnnotKey = "apps.kubeblocks.io/volume-autoscaling-handled"
+)
+
+// VolumeAutoscalingEventHandler handles the events sent by lorry when the space usage of volumes crosses
+// the autoscaling threshold, and creates VolumeExpansion OpsRequests to expand the volumes.
+type VolumeAutoscalingEventHandler struct{}
+
+// +kubebuilder:rbac:groups=apps.kubeblocks.io,resources=opsrequests,verbs=get;list;watch;create
+
+func (h *VolumeAutoscalingEventHandler) Handle(cli client.Client, reqCtx intctrlutil.RequestCtx, recorder record.EventRecorder, event *corev1.Event) error {
+	if event.InvolvedObject.FieldPath != lorryutil.LorryEventFieldPath || event.Reason != lorryutil.VolumeAutoscalingEventReason {
+		return nil
+	}
+	if event.Annotations[volumeAutoscalingAnnotKey] == "true" {
+		return nil
+	}
+
+	if err := h.handleVolumeAutoscalingEvent(cli, reqCtx, recorder, event); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(event.DeepCopy())
+	if event.Annotations == nil {
+		event.Annotations = map[string]string{}
+	}
+	event.Annotations[volumeAutoscalingAnnotKey] = "true"
+	return cli.Patch(reqCtx.Ctx, event, patch, multicluster.InDataContextUnspecified())
+}
+
+func (h *VolumeAutoscalingEventHandler) handleVolumeAutoscalingEvent(cli client.Client, reqCtx intctrlutil.RequestCtx,
+	recorder record.EventRecorder, event *corev1.Event) error {
+	volumes := parseVolumeAutoscalingEventMessage(event.Message)
+	if len(volumes) == 0 {
+		reqCtx.Log.Info("no volume to expand in the event", "message", event.Message)
+		return nil
+	}
+
+	pod := &corev1.Pod{}
+	podKey := types.NamespacedName{Namespace: event.InvolvedObject.Namespace, Name: event.InvolvedObject.Name}
+	if err := cli.Get(reqCtx.Ctx, podKey, pod, multicluster.InDataContextUnspecified()); err != nil {
+		return err
+	}
+	clusterName := pod.Labels[constant.AppInstanceLabelKey]
+	compName := pod.Labels[constant.KBAppShardingNameLabelKey]
+	if compName == "" {
+		compName = pod.Labels[constant.KBAppComponentLabelKey]
+	}
+	if clusterName == "" || compName == "" {
+		return nil
+	}
+	cluster := &appsv1alpha1.Cluster{}
+	if err := cli.Get(reqCtx.Ctx, types.NamespacedName{Namespace: pod.Namespace, Name: clusterName}, cluster); err != nil {
+		return err
+	}
+
+	opsList := &appsv1alpha1.OpsRequestList{}
+	if err := cli.List(reqCtx.Ctx, opsList, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		constant.AppInstanceLabelKey:    cluster.Name,
+		constant.OpsRequestTypeLabelKey: string(appsv1alpha1.VolumeExpansionType),
+	}); err != nil {
+		return err
+	}
+	for _, ops := range opsList.Items {
+		// the volumes are being expanded, wait for it
+		if !ops.IsComplete() {
+			reqCtx.Log.V(1).Info("volume expansion is in progress", "cluster", cluster.Name, "opsRequest", ops.Name)
+			return nil
+		}
+	}
+
+	var vcts []appsv1alpha1.OpsRequestVolumeClaimTemplate
+	for _, vct := range getComponentVolumeClaimTemplates(cluster, compName) {
+		if vct.Autoscaling == nil || !slices.Contains(volumes, vct.Name) {
+			continue
+		}
+		if inVolumeAutoscalingCooldown(opsList.Items, compName, vct.Name, vct.Autoscaling) {
+			reqCtx.Log.V(1).Info("volume autoscaling is in cooldown", "cluster", cluster.Name, "component", compName, "volume", vct.Name)
+			continue
+		}
+		storage, ok := buildVolumeAutoscalingStorage(vct)
+		if !ok {
+			continue
+		}
+		vcts = append(vcts, appsv1alpha1.OpsRequestVolumeClaimTemplate{Name: vct.Name, Storage: storage})
+	}
+	if len(vcts) == 0 {
+		return nil
+	}
+
+	ops := buildVolumeAutoscalingOpsRequest(cluster, compName, vcts)
+	if err := cli.Create(reqCtx.Ctx, ops); err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("created OpsRequest %s to expand volumes of component %s", ops.Name, compName)
+	reqCtx.Log.Info(msg, "cluster", cluster.Name)
+	if recorder != nil {
+		recorder.Event(cluster, corev1.EventTypeNormal, lorryutil.VolumeAutoscalingEventReason, msg)
+	}
+	return nil
+}
+
+func parseVolumeAutoscalingEventMessage(message string) []string {
+	data := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(message), &data); err != nil {
+		return nil
+	}
+	var volumes []string
+	if raw, ok := data[lorryutil.VolumeAutoscalingEventDataKey]; ok {
+		_ = json.Unmarshal(raw, &volumes)
+	}
+	return volumes
+}
+
+func getComponentVolumeClaimTemplates(cluster *appsv1alpha1.Cluster, compName string) []appsv1alpha1.ClusterComponentVolumeClaimTemplate {
+	if compSpec := cluster.Spec.GetComponentByName(compName); compSpec != nil {
+		return compSpec.VolumeClaimTemplates
+	}
+	if shardingSpec := cluster.Spec.GetShardingByName(compName); shardingSpec != nil {
+		return shardingSpec.Template.VolumeClaimTemplates
+	}
+	return nil
+}
+
+// inVolumeAutoscalingCooldown checks whether the volume has been expanded by the autoscaling within the cooldown.
+func inVolumeAutoscalingCooldown(opsList []appsv1alpha1.OpsRequest, compName, vctName string, autoscaling *appsv1alpha1.VolumeAutoscaling) bool {
+	cooldown := time.Duration(autoscaling.CooldownSeconds) * time.Second
+	for _, ops := range opsList {
+		if ops.Labels[constant.VolumeAutoscalingLabelKey] != "true" || time.Since(ops.CreationTimestamp.Time) >= cooldown {
+			continue
+		}
+		for _, volumeExpansion := range ops.Spec.VolumeExpansionList {
+			if volumeExpansion.ComponentName != compName {
+				continue
+			}
+			for _, vct := range volumeExpansion.VolumeClaimTemplates {
+				if vct.Name == vctName {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// buildVolumeAutoscalingStorage returns the storage size after expanding the volume by a step, it's capped by the max size.
+func buildVolumeAutoscalingStorage(vct appsv1alpha1.ClusterComponentVolumeClaimTemplate) (resource.Quantity, bool) {
+	current, ok := vct.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok || vct.Autoscaling.Step.IsZero() {
+		return resource.Quantity{}, false
+	}
+	maxSize := vct.Autoscaling.MaxSize
+	if current.Cmp(maxSize) >= 0 {
+		return resource.Quantity{}, false
+	}
+	storage := current.DeepCopy()
+	storage.Add(vct.Autoscaling.Step)
+	if storage.Cmp(maxSize) > 0 {
+		storage = maxSize.DeepCopy()
+	}
+	return storage, true
+}
+
+func buildVolumeAutoscalingOpsRequest(cluster *appsv1alpha1.Cluster, compName string,
+	vcts []appsv1alpha1.OpsRequestVolumeClaimTemplate) *appsv1alpha1.OpsRequest {
+	labels := map[string]string{
+		constant.AppInstanceLabelKey:       cluster.Name,
+		constant.OpsRequestTypeLabelKey:    string(appsv1alpha1.VolumeExpansionType),
+		constant.VolumeAutoscalingLabelKey: "true",
+	}
+	return &appsv1alpha1.OpsRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-autoscaling-", cluster.Name, compName),
+			Namespace:    cluster.Namespace,
+			Labels:       labels,
+		},
+		Spec: appsv1alpha1.OpsRequestSpec{
+			ClusterName: cluster.Name,
+			Type:        appsv1alpha1.VolumeExpansionType,
+			SpecificOpsRequest: appsv1alpha1.SpecificOpsRequest{
+				VolumeExpansionList: []appsv1alpha1.VolumeExpansion{
+					{
+						ComponentOps:         appsv1alpha1.ComponentOps{ComponentName: compName},
+						VolumeClaimTemplates: vcts,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package k8score
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/sethvargo/go-password/password"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/generics"
+	lorryutil "github.com/apecloud/kubeblocks/pkg/lorry/util"
+	testapps "github.com/apecloud/kubeblocks/pkg/testutil/apps"
+)
+
+var _ = Describe("Volume Autoscaling Event Handler", func() {
+	const (
+		clusterDefName = "test-clusterdef"
+		compName       = "mysql"
+		compDefName    = "mysql"
+	)
+
+	cleanEnv := func() {
+		By("clean resources")
+		testapps.ClearClusterResources(&testCtx)
+
+		inNS := client.InNamespace(testCtx.DefaultNamespace)
+		ml := client.HasLabels{testCtx.TestObjLabelKey}
+		testapps.ClearResources(&testCtx, generics.EventSignature, inNS, ml)
+		testapps.ClearResources(&testCtx, generics.PodSignature, inNS, ml)
+		testapps.ClearResources(&testCtx, generics.OpsRequestSignature, inNS, client.HasLabels{constant.VolumeAutoscalingLabelKey})
+	}
+
+	BeforeEach(cleanEnv)
+
+	AfterEach(cleanEnv)
+
+	autoscaling := &appsv1alpha1.VolumeAutoscaling{
+		Threshold:       80,
+		Step:            resource.MustParse("5Gi"),
+		MaxSize:         resource.MustParse("12Gi"),
+		CooldownSeconds: 600,
+	}
+
+	createVolumeAutoscalingEvent := func(podName, volume string) *corev1.Event {
+		seq, _ := password.Generate(16, 16, 0, true, true)
+		message := fmt.Sprintf("{\"%s\":[\"%s\"]}", lorryutil.VolumeAutoscalingEventDataKey, volume)
+		return builder.NewEventBuilder(testCtx.DefaultNamespace, fmt.Sprintf("%s.%s", podName, seq)).
+			SetInvolvedObject(corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Namespace:  testCtx.DefaultNamespace,
+				Name:       podName,
+				FieldPath:  lorryutil.LorryEventFieldPath,
+			}).
+			SetMessage(message).
+			SetReason(lorryutil.VolumeAutoscalingEventReason).
+			SetType(corev1.EventTypeNormal).
+			SetFirstTimestamp(metav1.Now()).
+			SetLastTimestamp(metav1.Now()).
+			SetEventTime(metav1.NowMicro()).
+			SetReportingController("lorry").
+			SetReportingInstance(podName).
+			SetAction(lorryutil.VolumeAutoscalingEventReason).
+			GetObject()
+	}
+
+	Context("helpers", func() {
+		It("should parse the volumes from the event message", func() {
+			Expect(parseVolumeAutoscalingEventMessage(`{"autoscaling":["data","log"],"highWatermark":"90"}`)).
+				Should(Equal([]string{"data", "log"}))
+			Expect(parseVolumeAutoscalingEventMessage(`{"highWatermark":"90"}`)).Should(BeEmpty())
+			Expect(parseVolumeAutoscalingEventMessage(`invalid`)).Should(BeEmpty())
+		})
+
+		It("should expand the volume by a step and cap it by the max size", func() {
+			vct := appsv1alpha1.ClusterComponentVolumeClaimTemplate{
+				Name:        testapps.DataVolumeName,
+				Spec:        testapps.NewPVCSpec("1Gi"),
+				Autoscaling: autoscaling,
+			}
+			storage, ok := buildVolumeAutoscalingStorage(vct)
+			Expect(ok).Should(BeTrue())
+			Expect(storage.Cmp(resource.MustParse("6Gi"))).Should(Equal(0))
+
+			vct.Spec = testapps.NewPVCSpec("10Gi")
+			storage, ok = buildVolumeAutoscalingStorage(vct)
+			Expect(ok).Should(BeTrue())
+			Expect(storage.Cmp(autoscaling.MaxSize)).Should(Equal(0))
+
+			vct.Spec = testapps.NewPVCSpec("12Gi")
+			_, ok = buildVolumeAutoscalingStorage(vct)
+			Expect(ok).Should(BeFalse())
+		})
+
+		It("should check the cooldown of the volume", func() {
+			cluster := &appsv1alpha1.Cluster{}
+			cluster.Name = "test"
+			cluster.Namespace = testCtx.DefaultNamespace
+			ops := buildVolumeAutoscalingOpsRequest(cluster, compName, []appsv1alpha1.OpsRequestVolumeClaimTemplate{
+				{Name: testapps.DataVolumeName, Storage: resource.MustParse("6Gi")},
+			})
+			ops.CreationTimestamp = metav1.Now()
+			opsList := []appsv1alpha1.OpsRequest{*ops}
+			Expect(inVolumeAutoscalingCooldown(opsList, compName, testapps.DataVolumeName, autoscaling)).Should(BeTrue())
+			Expect(inVolumeAutoscalingCooldown(opsList, compName, "log", autoscaling)).Should(BeFalse())
+			Expect(inVolumeAutoscalingCooldown(opsList, "other", testapps.DataVolumeName, autoscaling)).Should(BeFalse())
+
+			opsList[0].CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			Expect(inVolumeAutoscalingCooldown(opsList, compName, testapps.DataVolumeName, autoscaling)).Should(BeFalse())
+		})
+	})
+
+	Context("When receiving volume autoscaling event", func() {
+		It("should create a VolumeExpansion OpsRequest", func() {
+			By("create cluster with volume autoscaling")
+			clusterDefObj := testapps.NewClusterDefFactory(clusterDefName).
+				AddComponentDef(testapps.StatefulMySQLComponent, compDefName).
+				Create(&testCtx).GetObject()
+			clusterObj := testapps.NewClusterFactory(testCtx.DefaultNamespace, "", clusterDefObj.Name, "").
+				WithRandomName().
+				AddComponent(compName, compDefName).
+				AddVolumeClaimTemplate(testapps.DataVolumeName, testapps.NewPVCSpec("1Gi")).
+				Apply(func(cluster *appsv1alpha1.Cluster) {
+					cluster.Spec.ComponentSpecs[0].VolumeClaimTemplates[0].Autoscaling = autoscaling
+				}).
+				Create(&testCtx).GetObject()
+
+			By("create involved pod")
+			podName := fmt.Sprintf("%s-%s-0", clusterObj.Name, compName)
+			pod := builder.NewPodBuilder(testCtx.DefaultNamespace, podName).
+				AddLabels(constant.AppInstanceLabelKey, clusterObj.Name).
+				AddLabels(constant.KBAppComponentLabelKey, compName).
+				AddLabels(testCtx.TestObjLabelKey, "true").
+				SetContainers([]corev1.Container{{Image: "foo", Name: "bar"}}).
+				GetObject()
+			Expect(testCtx.CreateObj(ctx, pod)).Should(Succeed())
+
+			By("send volume autoscaling event")
+			event := createVolumeAutoscalingEvent(podName, testapps.DataVolumeName)
+			Expect(testCtx.CreateObj(ctx, event)).Should(Succeed())
+
+			By("check the OpsRequest created")
+			Eventually(func(g Gomega) {
+				opsList := &appsv1alpha1.OpsRequestList{}
+				g.Expect(k8sClient.List(ctx, opsList, client.InNamespace(testCtx.DefaultNamespace),
+					client.MatchingLabels{constant.AppInstanceLabelKey: clusterObj.Name, constant.VolumeAutoscalingLabelKey: "true"})).Should(Succeed())
+				g.Expect(opsList.Items).Should(HaveLen(1))
+				ops := opsList.Items[0]
+				g.Expect(ops.Spec.Type).Should(Equal(appsv1alpha1.VolumeExpansionType))
+				g.Expect(ops.Spec.VolumeExpansionList).Should(HaveLen(1))
+				g.Expect(ops.Spec.VolumeExpansionList[0].VolumeClaimTemplates[0].Storage.String()).Should(Equal("6Gi"))
+			}).Should(Succeed())
+
+			Eventually(testapps.CheckObj(&testCtx, client.ObjectKeyFromObject(event), func(g Gomega, e *corev1.Event) {
+				g.Expect(e.Annotations[volumeAutoscalingAnnotKey]).Should(Equal("true"))
+			})).Should(Succeed())
+		})
+	})
+})
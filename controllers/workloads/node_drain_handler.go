@@ -0,0 +1,82 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package workloads
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/controller/instanceset"
+)
+
+// nodeDrainHandler enqueues the InstanceSets having pods on the nodes being cordoned or tainted,
+// so that their leaders can be switched over before the nodes are drained.
+type nodeDrainHandler struct {
+	client.Client
+}
+
+func (h *nodeDrainHandler) Create(ctx context.Context, event event.CreateEvent, limitingInterface workqueue.RateLimitingInterface) {
+}
+
+func (h *nodeDrainHandler) Update(ctx context.Context, event event.UpdateEvent, limitingInterface workqueue.RateLimitingInterface) {
+	oldNode, ok := event.ObjectOld.(*corev1.Node)
+	if !ok {
+		return
+	}
+	newNode, ok := event.ObjectNew.(*corev1.Node)
+	if !ok {
+		return
+	}
+	if oldNode.Spec.Unschedulable == newNode.Spec.Unschedulable && reflect.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints) {
+		return
+	}
+	h.mapAndEnqueue(ctx, newNode.Name, limitingInterface)
+}
+
+func (h *nodeDrainHandler) Delete(ctx context.Context, event event.DeleteEvent, limitingInterface workqueue.RateLimitingInterface) {
+}
+
+func (h *nodeDrainHandler) Generic(ctx context.Context, event event.GenericEvent, limitingInterface workqueue.RateLimitingInterface) {
+}
+
+func (h *nodeDrainHandler) mapAndEnqueue(ctx context.Context, nodeName string, q workqueue.RateLimitingInterface) {
+	podList := &corev1.PodList{}
+	if err := h.Client.List(ctx, podList, client.MatchingLabels{instanceset.WorkloadsManagedByLabelKey: workloads.Kind}); err != nil {
+		return
+	}
+	for _, pod := range podList.Items {
+		itsName, ok := pod.Labels[instanceset.WorkloadsInstanceLabelKey]
+		if !ok || pod.Spec.NodeName != nodeName {
+			continue
+		}
+		q.Add(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: itsName}})
+	}
+}
+
+var _ handler.EventHandler = &nodeDrainHandler{}
@@ -0,0 +1,195 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	scheme "github.com/apecloud/kubeblocks/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// BackupVerificationsGetter has a method to return a BackupVerificationInterface.
+// A group's client should implement this interface.
+type BackupVerificationsGetter interface {
+	BackupVerifications(namespace string) BackupVerificationInterface
+}
+
+// BackupVerificationInterface has methods to work with BackupVerification resources.
+type BackupVerificationInterface interface {
+	Create(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.CreateOptions) (*v1alpha1.BackupVerification, error)
+	Update(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.UpdateOptions) (*v1alpha1.BackupVerification, error)
+	UpdateStatus(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.UpdateOptions) (*v1alpha1.BackupVerification, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.BackupVerification, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.BackupVerificationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BackupVerification, err error)
+	BackupVerificationExpansion
+}
+
+// backupverifications implements BackupVerificationInterface
+type backupverifications struct {
+	client rest.Interface
+	ns     string
+}
+
+// newBackupVerifications returns a BackupVerifications
+func newBackupVerifications(c *DataprotectionV1alpha1Client, namespace string) *backupverifications {
+	return &backupverifications{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the backupVerification, and returns the corresponding backupVerification object, and an error if there is any.
+func (c *backupverifications) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.BackupVerification, err error) {
+	result = &v1alpha1.BackupVerification{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of BackupVerifications that match those selectors.
+func (c *backupverifications) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BackupVerificationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.BackupVerificationList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested backupverifications.
+func (c *backupverifications) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a backupVerification and creates it.  Returns the server's representation of the backupVerification, and an error, if there is any.
+func (c *backupverifications) Create(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.CreateOptions) (result *v1alpha1.BackupVerification, err error) {
+	result = &v1alpha1.BackupVerification{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(backupVerification).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a backupVerification and updates it. Returns the server's representation of the backupVerification, and an error, if there is any.
+func (c *backupverifications) Update(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.UpdateOptions) (result *v1alpha1.BackupVerification, err error) {
+	result = &v1alpha1.BackupVerification{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		Name(backupVerification.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(backupVerification).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *backupverifications) UpdateStatus(ctx context.Context, backupVerification *v1alpha1.BackupVerification, opts v1.UpdateOptions) (result *v1alpha1.BackupVerification, err error) {
+	result = &v1alpha1.BackupVerification{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		Name(backupVerification.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(backupVerification).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the backupVerification and deletes it. Returns an error if one occurs.
+func (c *backupverifications) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *backupverifications) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("backupverifications").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched backupVerification.
+func (c *backupverifications) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.BackupVerification, err error) {
+	result = &v1alpha1.BackupVerification{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("backupverifications").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
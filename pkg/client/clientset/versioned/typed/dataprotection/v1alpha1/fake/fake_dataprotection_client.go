@@ -48,6 +48,10 @@ func (c *FakeDataprotectionV1alpha1) BackupSchedules(namespace string) v1alpha1.
 	return &FakeBackupSchedules{c, namespace}
 }
 
+func (c *FakeDataprotectionV1alpha1) BackupVerifications(namespace string) v1alpha1.BackupVerificationInterface {
+	return &FakeBackupVerifications{c, namespace}
+}
+
 func (c *FakeDataprotectionV1alpha1) Restores(namespace string) v1alpha1.RestoreInterface {
 	return &FakeRestores{c, namespace}
 }
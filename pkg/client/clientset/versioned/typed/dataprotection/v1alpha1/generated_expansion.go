@@ -28,6 +28,8 @@ type BackupRepoExpansion interface{}
 
 type BackupScheduleExpansion interface{}
 
+type BackupVerificationExpansion interface{}
+
 type RestoreExpansion interface{}
 
 type StorageProviderExpansion interface{}
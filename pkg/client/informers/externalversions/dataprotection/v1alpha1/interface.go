@@ -34,6 +34,8 @@ type Interface interface {
 	BackupRepos() BackupRepoInformer
 	// BackupSchedules returns a BackupScheduleInformer.
 	BackupSchedules() BackupScheduleInformer
+	// BackupVerifications returns a BackupVerificationInformer.
+	BackupVerifications() BackupVerificationInformer
 	// Restores returns a RestoreInformer.
 	Restores() RestoreInformer
 	// StorageProviders returns a StorageProviderInformer.
@@ -76,6 +78,11 @@ func (v *version) BackupSchedules() BackupScheduleInformer {
 	return &backupScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BackupVerifications returns a BackupVerificationInformer.
+func (v *version) BackupVerifications() BackupVerificationInformer {
+	return &backupVerificationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Restores returns a RestoreInformer.
 func (v *version) Restores() RestoreInformer {
 	return &restoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,99 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// BackupVerificationLister helps list BackupVerifications.
+// All objects returned here must be treated as read-only.
+type BackupVerificationLister interface {
+	// List lists all BackupVerifications in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BackupVerification, err error)
+	// BackupVerifications returns an object that can list and get BackupVerifications.
+	BackupVerifications(namespace string) BackupVerificationNamespaceLister
+	BackupVerificationListerExpansion
+}
+
+// backupVerificationLister implements the BackupVerificationLister interface.
+type backupVerificationLister struct {
+	indexer cache.Indexer
+}
+
+// NewBackupVerificationLister returns a new BackupVerificationLister.
+func NewBackupVerificationLister(indexer cache.Indexer) BackupVerificationLister {
+	return &backupVerificationLister{indexer: indexer}
+}
+
+// List lists all BackupVerifications in the indexer.
+func (s *backupVerificationLister) List(selector labels.Selector) (ret []*v1alpha1.BackupVerification, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BackupVerification))
+	})
+	return ret, err
+}
+
+// BackupVerifications returns an object that can list and get BackupVerifications.
+func (s *backupVerificationLister) BackupVerifications(namespace string) BackupVerificationNamespaceLister {
+	return backupVerificationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// BackupVerificationNamespaceLister helps list and get BackupVerifications.
+// All objects returned here must be treated as read-only.
+type BackupVerificationNamespaceLister interface {
+	// List lists all BackupVerifications in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.BackupVerification, err error)
+	// Get retrieves the BackupVerification from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.BackupVerification, error)
+	BackupVerificationNamespaceListerExpansion
+}
+
+// backupVerificationNamespaceLister implements the BackupVerificationNamespaceLister
+// interface.
+type backupVerificationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all BackupVerifications in the indexer for a given namespace.
+func (s backupVerificationNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.BackupVerification, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.BackupVerification))
+	})
+	return ret, err
+}
+
+// Get retrieves the BackupVerification from the indexer for a given namespace and name.
+func (s backupVerificationNamespaceLister) Get(name string) (*v1alpha1.BackupVerification, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("backupVerification"), name)
+	}
+	return obj.(*v1alpha1.BackupVerification), nil
+}
@@ -0,0 +1,363 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+const (
+	bearerPrefix = "Bearer "
+
+	// the reviewed service account tokens are trusted for a while, so not every request is reviewed by the API server.
+	serviceAccountTokenCacheTTL = time.Minute
+	tokenReviewTimeout          = 10 * time.Second
+)
+
+// SecureAccessConfig holds the TLS and authentication settings of the HTTP servers of Lorry and kb-agent.
+// All the files are reloaded once they change, so the rotated certificates and tokens take effect without restart.
+type SecureAccessConfig struct {
+	// CertFile and KeyFile are the serving certificate and private key, the server serves TLS if they are set.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is the bundle of CAs to verify the client certificates, the requests with a verified
+	// client certificate are authenticated.
+	ClientCAFile string
+	// TokenFile holds the bearer token, the requests with the token in the Authorization header are authenticated.
+	TokenFile string
+	// ServiceAccounts are the service accounts in the form of "namespace:name", the requests with their tokens in the
+	// Authorization header are authenticated. The tokens must be issued to LorryServiceAccountTokenAudience.
+	ServiceAccounts []string
+	// TokenReviewer reviews the service account tokens, it's required if ServiceAccounts is set.
+	TokenReviewer authenticationv1client.TokenReviewInterface
+}
+
+// TLSEnabled returns true if the server serves TLS.
+func (c *SecureAccessConfig) TLSEnabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// AuthEnabled returns true if the requests must be authenticated.
+func (c *SecureAccessConfig) AuthEnabled() bool {
+	return c.ClientCAFile != "" || c.TokenFile != "" || len(c.ServiceAccounts) != 0
+}
+
+// Validate checks whether the settings are consistent.
+func (c *SecureAccessConfig) Validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("the TLS certificate and private key should be set together")
+	}
+	if c.ClientCAFile != "" && !c.TLSEnabled() {
+		return fmt.Errorf("the client CA requires the TLS certificate and private key to be set")
+	}
+	for _, sa := range c.ServiceAccounts {
+		if namespace, name, ok := strings.Cut(sa, ":"); !ok || namespace == "" || name == "" {
+			return fmt.Errorf("invalid service account %q, it should be in the form of \"namespace:name\"", sa)
+		}
+	}
+	if len(c.ServiceAccounts) != 0 && c.TokenReviewer == nil {
+		return fmt.Errorf("the service accounts require the token reviewer to be set")
+	}
+	return nil
+}
+
+// NewServerTLSConfig returns the TLS config of the server. The client certificates are requested but not verified
+// in the handshake, they are verified by the authentication handler, so the clients holding an unknown certificate,
+// e.g. the ones just renewed, can still be authenticated by the token.
+func NewServerTLSConfig(c SecureAccessConfig) *tls.Config {
+	cert := &reloadableFile{path: c.CertFile}
+	key := &reloadableFile{path: c.KeyFile}
+	clientAuth := tls.NoClientCert
+	if c.ClientCAFile != "" {
+		clientAuth = tls.RequestClientCert
+	}
+
+	var (
+		mu          sync.Mutex
+		lastCertPEM []byte
+		lastKeyPEM  []byte
+		lastConfig  *tls.Config
+	)
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certPEM, err := cert.read()
+			if err != nil {
+				return nil, err
+			}
+			keyPEM, err := key.read()
+			if err != nil {
+				return nil, err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if lastConfig != nil && bytes.Equal(certPEM, lastCertPEM) && bytes.Equal(keyPEM, lastKeyPEM) {
+				return lastConfig, nil
+			}
+			keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+			if err != nil {
+				return nil, err
+			}
+			lastCertPEM, lastKeyPEM = certPEM, keyPEM
+			lastConfig = &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{keyPair},
+				ClientAuth:   clientAuth,
+			}
+			return lastConfig, nil
+		},
+	}
+}
+
+// NewClientTLSConfig returns the TLS config to access the servers with the certificates issued for Lorry,
+// the client presents the certificate and verifies the server against the CA bundle.
+func NewClientTLSConfig(caPEM, certPEM, keyPEM []byte) (*tls.Config, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no valid CA certificate found")
+	}
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+		// the servers are accessed by the pod IPs, the certificates are issued to a well-known name instead.
+		ServerName: constant.LorrySecureAccessServerName,
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{keyPair}
+	}
+	return config, nil
+}
+
+// NewAuthenticationHandler wraps the handler to reject the unauthenticated requests. A request is authenticated
+// if it carries a client certificate verified by the client CA, the bearer token in the token file, or the token
+// of an authorized service account.
+// The GET requests to the anonymous paths are always allowed, they are used by the kubelet probes, which
+// can't carry any credential.
+func NewAuthenticationHandler(c SecureAccessConfig, next fasthttp.RequestHandler, anonymousPaths ...string) fasthttp.RequestHandler {
+	if !c.AuthEnabled() {
+		return next
+	}
+	var clientCA *certPoolLoader
+	if c.ClientCAFile != "" {
+		clientCA = &certPoolLoader{file: reloadableFile{path: c.ClientCAFile}}
+	}
+	var token *reloadableFile
+	if c.TokenFile != "" {
+		token = &reloadableFile{path: c.TokenFile}
+	}
+	var serviceAccounts *serviceAccountAuthenticator
+	if len(c.ServiceAccounts) != 0 {
+		serviceAccounts = newServiceAccountAuthenticator(c.TokenReviewer, c.ServiceAccounts)
+	}
+	anonymous := make(map[string]bool, len(anonymousPaths))
+	for _, path := range anonymousPaths {
+		anonymous[path] = true
+	}
+
+	authenticated := func(ctx *fasthttp.RequestCtx) bool {
+		if clientCA != nil && clientCA.verify(ctx.TLSConnectionState()) {
+			return true
+		}
+		auth := ctx.Request.Header.Peek(fasthttp.HeaderAuthorization)
+		if !bytes.HasPrefix(auth, []byte(bearerPrefix)) {
+			return false
+		}
+		bearer := bytes.TrimPrefix(auth, []byte(bearerPrefix))
+		if token != nil {
+			expected, err := token.read()
+			if err == nil {
+				expected = bytes.TrimSpace(expected)
+				if len(expected) > 0 && subtle.ConstantTimeCompare(bearer, expected) == 1 {
+					return true
+				}
+			}
+		}
+		return serviceAccounts != nil && serviceAccounts.authenticate(ctx, string(bearer))
+	}
+
+	return func(ctx *fasthttp.RequestCtx) {
+		if ctx.IsGet() && anonymous[string(ctx.Path())] {
+			next(ctx)
+			return
+		}
+		if !authenticated(ctx) {
+			ctx.Response.Header.Set(fasthttp.HeaderWWWAuthenticate, "Bearer")
+			ctx.Error(http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next(ctx)
+	}
+}
+
+// serviceAccountAuthenticator authenticates the tokens of the service accounts by the token reviews,
+// the authenticated tokens are cached for a while.
+type serviceAccountAuthenticator struct {
+	reviewer  authenticationv1client.TokenReviewInterface
+	usernames map[string]bool
+	mu        sync.Mutex
+	cache     map[[sha256.Size]byte]time.Time
+}
+
+func newServiceAccountAuthenticator(reviewer authenticationv1client.TokenReviewInterface, serviceAccounts []string) *serviceAccountAuthenticator {
+	a := &serviceAccountAuthenticator{
+		reviewer:  reviewer,
+		usernames: make(map[string]bool, len(serviceAccounts)),
+		cache:     map[[sha256.Size]byte]time.Time{},
+	}
+	for _, sa := range serviceAccounts {
+		namespace, name, _ := strings.Cut(sa, ":")
+		a.usernames[fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)] = true
+	}
+	return a
+}
+
+func (a *serviceAccountAuthenticator) authenticate(ctx context.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	a.mu.Lock()
+	expiry, ok := a.cache[key]
+	a.mu.Unlock()
+	if ok && now.Before(expiry) {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenReviewTimeout)
+	defer cancel()
+	review, err := a.reviewer.Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{constant.LorryServiceAccountTokenAudience},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil || !review.Status.Authenticated || !a.usernames[review.Status.User.Username] ||
+		!slices.Contains(review.Status.Audiences, constant.LorryServiceAccountTokenAudience) {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k, expiry := range a.cache {
+		if now.After(expiry) {
+			delete(a.cache, k)
+		}
+	}
+	a.cache[key] = now.Add(serviceAccountTokenCacheTTL)
+	return true
+}
+
+// certPoolLoader verifies the client certificates against the CA bundle in the file.
+type certPoolLoader struct {
+	file    reloadableFile
+	mu      sync.Mutex
+	lastPEM []byte
+	pool    *x509.CertPool
+}
+
+func (l *certPoolLoader) verify(state *tls.ConnectionState) bool {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return false
+	}
+	pool, err := l.load()
+	if err != nil {
+		return false
+	}
+	opts := x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range state.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err = state.PeerCertificates[0].Verify(opts)
+	return err == nil
+}
+
+func (l *certPoolLoader) load() (*x509.CertPool, error) {
+	data, err := l.file.read()
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.pool != nil && bytes.Equal(data, l.lastPEM) {
+		return l.pool, nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no valid certificate found in the client CA file %s", l.file.path)
+	}
+	l.lastPEM, l.pool = data, pool
+	return pool, nil
+}
+
+// reloadableFile caches the content of a file, and reloads it once the file is modified.
+type reloadableFile struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	data    []byte
+}
+
+func (f *reloadableFile) read() ([]byte, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.data != nil && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.data, nil
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+	f.data, f.modTime, f.size = data, info.ModTime(), info.Size()
+	return f.data, nil
+}
@@ -0,0 +1,281 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+func generateTestCert(t *testing.T, dnsNames ...string) ([]byte, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test"},
+		DNSNames:              dnsNames,
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func writeTestFile(t *testing.T, path string, data []byte) {
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSecureAccessConfigValidate(t *testing.T) {
+	cases := []struct {
+		config SecureAccessConfig
+		valid  bool
+	}{
+		{SecureAccessConfig{}, true},
+		{SecureAccessConfig{TokenFile: "token"}, true},
+		{SecureAccessConfig{CertFile: "tls.crt", KeyFile: "tls.key", ClientCAFile: "ca.crt"}, true},
+		{SecureAccessConfig{CertFile: "tls.crt"}, false},
+		{SecureAccessConfig{ClientCAFile: "ca.crt"}, false},
+	}
+	for _, c := range cases {
+		if err := c.config.Validate(); (err == nil) != c.valid {
+			t.Errorf("config %+v: expected valid %v, got error %v", c.config, c.valid, err)
+		}
+	}
+}
+
+func TestSecureAccess(t *testing.T) {
+	dir := t.TempDir()
+	certPEM, keyPEM := generateTestCert(t, constant.LorrySecureAccessServerName)
+	config := SecureAccessConfig{
+		CertFile:     filepath.Join(dir, "tls.crt"),
+		KeyFile:      filepath.Join(dir, "tls.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+		TokenFile:    filepath.Join(dir, "token"),
+	}
+	writeTestFile(t, config.CertFile, certPEM)
+	writeTestFile(t, config.KeyFile, keyPEM)
+	writeTestFile(t, config.ClientCAFile, certPEM)
+	writeTestFile(t, config.TokenFile, []byte("secret-token\n"))
+
+	handler := NewAuthenticationHandler(config, func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(http.StatusOK)
+	}, "/v1.0/checkrole")
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &fasthttp.Server{Handler: handler}
+	go func() {
+		_ = srv.Serve(tls.NewListener(l, NewServerTLSConfig(config)))
+	}()
+	defer func() {
+		_ = srv.Shutdown()
+	}()
+	url := "https://" + l.Addr().String()
+
+	do := func(tlsConfig *tls.Config, method, path, token string) int {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		req, err := http.NewRequest(method, url+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	mtls, err := NewClientTLSConfig(certPEM, certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverOnly, err := NewClientTLSConfig(certPEM, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCertPEM, otherKeyPEM := generateTestCert(t)
+	untrusted, err := NewClientTLSConfig(certPEM, otherCertPEM, otherKeyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		tlsConfig *tls.Config
+		method    string
+		path      string
+		token     string
+		expected  int
+	}{
+		{"client certificate", mtls, http.MethodPost, "/v1.0/createuser", "", http.StatusOK},
+		{"bearer token", serverOnly, http.MethodPost, "/v1.0/createuser", "secret-token", http.StatusOK},
+		{"wrong token", serverOnly, http.MethodPost, "/v1.0/createuser", "wrong-token", http.StatusUnauthorized},
+		{"untrusted client certificate", untrusted, http.MethodPost, "/v1.0/createuser", "", http.StatusUnauthorized},
+		{"untrusted client certificate with token", untrusted, http.MethodPost, "/v1.0/createuser", "secret-token", http.StatusOK},
+		{"no credential", serverOnly, http.MethodPost, "/v1.0/createuser", "", http.StatusUnauthorized},
+		{"anonymous path", serverOnly, http.MethodGet, "/v1.0/checkrole", "", http.StatusOK},
+		{"anonymous path with other method", serverOnly, http.MethodPost, "/v1.0/checkrole", "", http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if code := do(c.tlsConfig, c.method, c.path, c.token); code != c.expected {
+				t.Errorf("expected status %d, got %d", c.expected, code)
+			}
+		})
+	}
+
+	t.Run("rotated token", func(t *testing.T) {
+		writeTestFile(t, config.TokenFile, []byte("rotated-token"))
+		// make sure the modification is observed even if the file system has a coarse time resolution
+		if err := os.Chtimes(config.TokenFile, time.Now(), time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if code := do(serverOnly, http.MethodPost, "/v1.0/createuser", "secret-token"); code != http.StatusUnauthorized {
+			t.Errorf("expected the old token to be rejected, got status %d", code)
+		}
+		if code := do(serverOnly, http.MethodPost, "/v1.0/createuser", "rotated-token"); code != http.StatusOK {
+			t.Errorf("expected the rotated token to be accepted, got status %d", code)
+		}
+	})
+}
+
+type fakeTokenReviewer struct {
+	authenticationv1client.TokenReviewInterface
+	tokens  map[string]authenticationv1.TokenReviewStatus
+	reviews int
+}
+
+func (r *fakeTokenReviewer) Create(_ context.Context, review *authenticationv1.TokenReview, _ metav1.CreateOptions) (*authenticationv1.TokenReview, error) {
+	r.reviews++
+	result := review.DeepCopy()
+	result.Status = r.tokens[review.Spec.Token]
+	return result, nil
+}
+
+func TestServiceAccountAuthentication(t *testing.T) {
+	reviewer := &fakeTokenReviewer{tokens: map[string]authenticationv1.TokenReviewStatus{
+		"kubeblocks-token": {
+			Authenticated: true,
+			User:          authenticationv1.UserInfo{Username: "system:serviceaccount:kb-system:kubeblocks"},
+			Audiences:     []string{constant.LorryServiceAccountTokenAudience},
+		},
+		"other-sa-token": {
+			Authenticated: true,
+			User:          authenticationv1.UserInfo{Username: "system:serviceaccount:default:default"},
+			Audiences:     []string{constant.LorryServiceAccountTokenAudience},
+		},
+		"api-server-token": {
+			Authenticated: true,
+			User:          authenticationv1.UserInfo{Username: "system:serviceaccount:kb-system:kubeblocks"},
+		},
+	}}
+	config := SecureAccessConfig{ServiceAccounts: []string{"kb-system:kubeblocks"}, TokenReviewer: reviewer}
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	handler := NewAuthenticationHandler(config, func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(http.StatusOK)
+	})
+	do := func(token string) int {
+		req := &fasthttp.Request{}
+		req.Header.SetMethod(http.MethodPost)
+		req.SetRequestURI("/v1.0/createuser")
+		req.Header.Set(fasthttp.HeaderAuthorization, "Bearer "+token)
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Init(req, nil, nil)
+		handler(ctx)
+		return ctx.Response.StatusCode()
+	}
+
+	cases := []struct {
+		name     string
+		token    string
+		expected int
+	}{
+		{"authorized service account", "kubeblocks-token", http.StatusOK},
+		{"unauthorized service account", "other-sa-token", http.StatusUnauthorized},
+		{"token of other audiences", "api-server-token", http.StatusUnauthorized},
+		{"invalid token", "invalid-token", http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if code := do(c.token); code != c.expected {
+				t.Errorf("expected status %d, got %d", c.expected, code)
+			}
+		})
+	}
+
+	t.Run("cached token", func(t *testing.T) {
+		reviews := reviewer.reviews
+		if code := do("kubeblocks-token"); code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, code)
+		}
+		if reviewer.reviews != reviews {
+			t.Errorf("expected the authenticated token not to be reviewed again")
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		for _, invalid := range []SecureAccessConfig{
+			{ServiceAccounts: []string{"kubeblocks"}, TokenReviewer: reviewer},
+			{ServiceAccounts: []string{"kb-system:kubeblocks"}},
+		} {
+			if err := invalid.Validate(); err == nil {
+				t.Errorf("expected the config %v to be invalid", invalid.ServiceAccounts)
+			}
+		}
+	})
+}
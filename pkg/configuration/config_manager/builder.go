@@ -107,6 +107,10 @@ func getWatchedVolume(volumeDirs []corev1.VolumeMount, buildParams []ConfigSpecM
 				return core.IsWatchModuleForTplTrigger(param.ReloadAction.TPLScriptTrigger)
 			case appsv1beta1.ShellType:
 				return core.IsWatchModuleForShellTrigger(param.ReloadAction.ShellTrigger)
+			case appsv1beta1.SQLType:
+				return core.IsWatchModuleForSQLTrigger(param.ReloadAction.SQLTrigger)
+			case appsv1beta1.HTTPType:
+				return core.IsWatchModuleForHTTPTrigger(param.ReloadAction.HTTPTrigger)
 			default:
 				return true
 			}
@@ -267,9 +271,42 @@ func buildConfigSpecHandleMeta(cli client.Client, ctx context.Context, buildPara
 	if buildParam.ReloadType == appsv1beta1.TPLScriptType {
 		return buildTPLScriptCM(buildParam, cmBuildParam, cli, ctx)
 	}
+	buildReloadCredentialEnvs(buildParam, cmBuildParam)
 	return nil
 }
 
+// buildReloadCredentialEnvs passes the credential of the SQL or HTTP reload action to the config-manager via envs.
+func buildReloadCredentialEnvs(buildParam *ConfigSpecMeta, manager *CfgManagerBuildParams) {
+	credential := getReloadCredential(buildParam.ConfigSpecInfo)
+	if credential == nil {
+		return
+	}
+	secretName := credential.SecretName
+	if credential.SystemAccount != "" {
+		secretName = constant.GenerateAccountSecretName(manager.Cluster.Name, manager.ComponentName, credential.SystemAccount)
+	}
+	secretKeyRef := func(key, defaultKey string) *corev1.EnvVarSource {
+		if key == "" {
+			key = defaultKey
+		}
+		return &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		}
+	}
+	manager.Envs = append(manager.Envs,
+		corev1.EnvVar{
+			Name:      reloadCredentialEnvName(buildParam.ConfigSpec.Name, reloadCredentialUsername),
+			ValueFrom: secretKeyRef(credential.UsernameKey, constant.AccountNameForSecret),
+		},
+		corev1.EnvVar{
+			Name:      reloadCredentialEnvName(buildParam.ConfigSpec.Name, reloadCredentialPassword),
+			ValueFrom: secretKeyRef(credential.PasswordKey, constant.AccountPasswdForSecret),
+		})
+}
+
 func buildTPLScriptCM(configSpecBuildMeta *ConfigSpecMeta, manager *CfgManagerBuildParams, cli client.Client, ctx context.Context) error {
 	var (
 		options      = configSpecBuildMeta.TPLScriptTrigger
@@ -594,6 +594,10 @@ func CreateCombinedHandler(config string, backupPath string) (ConfigHandler, err
 			h, err = signalHandler(configMeta.ReloadAction.UnixSignalTrigger, configMeta.MountPoint)
 		case appsv1beta1.TPLScriptType:
 			h, err = tplHandler(configMeta.ReloadAction.TPLScriptTrigger, configMeta, tmpPath)
+		case appsv1beta1.SQLType:
+			h, err = CreateSQLHandler(configMeta, tmpPath)
+		case appsv1beta1.HTTPType:
+			h, err = CreateHTTPHandler(configMeta, tmpPath)
 		}
 		if err != nil {
 			return nil, err
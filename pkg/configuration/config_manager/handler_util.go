@@ -21,8 +21,10 @@ package configmanager
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/fsnotify/fsnotify"
 	corev1 "k8s.io/api/core/v1"
@@ -72,7 +74,9 @@ func isValidReloadPolicy(reload appsv1beta1.ReloadAction) bool {
 	return reload.AutoTrigger != nil ||
 		reload.ShellTrigger != nil ||
 		reload.TPLScriptTrigger != nil ||
-		reload.UnixSignalTrigger != nil
+		reload.UnixSignalTrigger != nil ||
+		reload.SQLTrigger != nil ||
+		reload.HTTPTrigger != nil
 }
 
 func IsAutoReload(reload *appsv1beta1.ReloadAction) bool {
@@ -87,6 +91,10 @@ func FromReloadTypeConfig(reloadAction *appsv1beta1.ReloadAction) appsv1beta1.Dy
 		return appsv1beta1.ShellType
 	case reloadAction.TPLScriptTrigger != nil:
 		return appsv1beta1.TPLScriptType
+	case reloadAction.SQLTrigger != nil:
+		return appsv1beta1.SQLType
+	case reloadAction.HTTPTrigger != nil:
+		return appsv1beta1.HTTPType
 	case reloadAction.AutoTrigger != nil:
 		return appsv1beta1.AutoType
 	}
@@ -101,6 +109,10 @@ func ValidateReloadOptions(reloadAction *appsv1beta1.ReloadAction, cli client.Cl
 		return checkShellTrigger(reloadAction.ShellTrigger)
 	case reloadAction.TPLScriptTrigger != nil:
 		return checkTPLScriptTrigger(reloadAction.TPLScriptTrigger, cli, ctx)
+	case reloadAction.SQLTrigger != nil:
+		return checkSQLTrigger(reloadAction.SQLTrigger)
+	case reloadAction.HTTPTrigger != nil:
+		return checkHTTPTrigger(reloadAction.HTTPTrigger)
 	case reloadAction.AutoTrigger != nil:
 		return nil
 	}
@@ -122,6 +134,38 @@ func checkShellTrigger(options *appsv1beta1.ShellTrigger) error {
 	return nil
 }
 
+func checkSQLTrigger(options *appsv1beta1.SQLTrigger) error {
+	if options.DSN == "" || options.StatementTemplate == "" {
+		return core.MakeError("required dsn and statement template of sql trigger")
+	}
+	if err := checkTPLScript("statement", options.StatementTemplate); err != nil {
+		return core.WrapError(err, "invalid statement template of sql trigger")
+	}
+	return checkReloadCredential(options.Credential)
+}
+
+func checkHTTPTrigger(options *appsv1beta1.HTTPTrigger) error {
+	if options.URL == "" {
+		return core.MakeError("required url of http trigger")
+	}
+	if options.BodyTemplate != "" {
+		if err := checkTPLScript("body", options.BodyTemplate); err != nil {
+			return core.WrapError(err, "invalid body template of http trigger")
+		}
+	}
+	return checkReloadCredential(options.Credential)
+}
+
+func checkReloadCredential(credential *appsv1beta1.ReloadCredential) error {
+	if credential == nil {
+		return nil
+	}
+	if (credential.SystemAccount == "") == (credential.SecretName == "") {
+		return core.MakeError("only one of the systemAccount and secretName of the credential can be specified")
+	}
+	return nil
+}
+
 func checkSignalTrigger(options *appsv1beta1.UnixSignalTrigger) error {
 	signal := options.Signal
 	if !IsValidUnixSignal(signal) {
@@ -223,5 +267,28 @@ func isSubPathMount(v *corev1.VolumeMount) bool {
 func isSyncReloadAction(meta ConfigSpecInfo) bool {
 	// If synchronous reloadAction is supported, kubelet limitations can be ignored.
 	return meta.ReloadType == appsv1beta1.TPLScriptType && !core.IsWatchModuleForTplTrigger(meta.TPLScriptTrigger) ||
-		meta.ReloadType == appsv1beta1.ShellType && !core.IsWatchModuleForShellTrigger(meta.ShellTrigger)
+		meta.ReloadType == appsv1beta1.ShellType && !core.IsWatchModuleForShellTrigger(meta.ShellTrigger) ||
+		meta.ReloadType == appsv1beta1.SQLType && !core.IsWatchModuleForSQLTrigger(meta.SQLTrigger) ||
+		meta.ReloadType == appsv1beta1.HTTPType && !core.IsWatchModuleForHTTPTrigger(meta.HTTPTrigger)
+}
+
+// getReloadCredential returns the credential used by the reload action.
+func getReloadCredential(meta ConfigSpecInfo) *appsv1beta1.ReloadCredential {
+	switch {
+	case meta.ReloadAction == nil:
+		return nil
+	case meta.ReloadType == appsv1beta1.SQLType && meta.SQLTrigger != nil:
+		return meta.SQLTrigger.Credential
+	case meta.ReloadType == appsv1beta1.HTTPType && meta.HTTPTrigger != nil:
+		return meta.HTTPTrigger.Credential
+	}
+	return nil
 }
+
+// reloadCredentialEnvName returns the name of the env in the config-manager container, which holds the credential field.
+func reloadCredentialEnvName(configSpecName, field string) string {
+	name := strings.ToUpper(envNameRegexp.ReplaceAllString(configSpecName, "_"))
+	return fmt.Sprintf("KB_RELOAD_%s_%s", name, field)
+}
+
+var envNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]`)
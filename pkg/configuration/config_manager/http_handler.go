@@ -0,0 +1,171 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package configmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
+	cfgcore "github.com/apecloud/kubeblocks/pkg/configuration/core"
+	"github.com/apecloud/kubeblocks/pkg/gotemplate"
+)
+
+// for testing
+var httpRetryInterval = time.Second
+
+type httpHandler struct {
+	configVolumeHandleMeta
+
+	trigger    appsv1beta1.HTTPTrigger
+	url        string
+	username   string
+	password   string
+	fileFilter regexFilter
+	backupPath string
+}
+
+func (h *httpHandler) OnlineUpdate(ctx context.Context, _ string, updatedParams map[string]string) error {
+	logger.Info(fmt.Sprintf("updated parameters: %v", updatedParams))
+	body, err := renderHTTPBody(ctx, h.trigger, updatedParams)
+	if err != nil {
+		return err
+	}
+	for i := int32(0); ; i++ {
+		if err = h.sendRequest(ctx, body); err == nil {
+			return nil
+		}
+		logger.Error(err, fmt.Sprintf("failed to send reload request to [%s], retries: %d", h.url, i))
+		if i >= h.trigger.Retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(httpRetryInterval * time.Duration(i+1)):
+		}
+	}
+}
+
+func (h *httpHandler) sendRequest(ctx context.Context, body string) error {
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+
+	method := h.trigger.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, h.url, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if h.trigger.BodyTemplate == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range h.trigger.Headers {
+		req.Header.Set(k, v)
+	}
+	if h.username != "" {
+		req.SetBasicAuth(h.username, h.password)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	response, _ := io.ReadAll(resp.Body)
+	logger.V(1).Info(fmt.Sprintf("reload request: [%s %s], status: [%d], response: [%s]", method, h.url, resp.StatusCode, string(response)))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return cfgcore.MakeError("reload request failed, status: %d, response: %s", resp.StatusCode, string(response))
+	}
+	return nil
+}
+
+func (h *httpHandler) VolumeHandle(ctx context.Context, event fsnotify.Event) error {
+	if !isOwnerEvent(h.MountPoint(), event) {
+		logger.Info(fmt.Sprintf("ignore event: %s, current watch volume: %s", event.String(), h.mountPoint))
+		return nil
+	}
+	updatedParams, files, err := h.prepare(h.backupPath, h.fileFilter, event)
+	if err != nil {
+		return err
+	}
+	if len(updatedParams) == 0 {
+		logger.Info("not parameter updated, skip")
+		return nil
+	}
+	if err := h.OnlineUpdate(ctx, event.Name, updatedParams); err != nil {
+		return err
+	}
+	return backupLastConfigFiles(files, h.backupPath)
+}
+
+// renderHTTPBody renders the body template with the updated parameters, or encodes them as a JSON object by default.
+func renderHTTPBody(ctx context.Context, trigger appsv1beta1.HTTPTrigger, updatedParams map[string]string) (string, error) {
+	if trigger.BodyTemplate == "" {
+		b, err := json.Marshal(updatedParams)
+		return string(b), err
+	}
+	values := gotemplate.TplValues{}
+	for k, v := range updatedParams {
+		values[k] = v
+	}
+	engine := gotemplate.NewTplEngine(&values, nil, "render-http-body", nil, ctx)
+	return engine.Render(trigger.BodyTemplate)
+}
+
+func CreateHTTPHandler(configMeta ConfigSpecInfo, backupPath string) (ConfigHandler, error) {
+	if configMeta.ReloadAction == nil || configMeta.HTTPTrigger == nil {
+		return nil, cfgcore.MakeError("http trigger is nil")
+	}
+	trigger := *configMeta.HTTPTrigger
+	filter, err := createFileRegex(fromConfigSpecInfo(&configMeta))
+	if err != nil {
+		return nil, err
+	}
+	url, err := renderCredentialTemplate("render-url", trigger.URL, configMeta.ConfigSpec.Name)
+	if err != nil {
+		return nil, err
+	}
+	dirs := []string{configMeta.MountPoint}
+	if backupPath != "" {
+		if err := checkAndBackup(configMeta, dirs, filter, backupPath); err != nil {
+			return nil, err
+		}
+	}
+	return &httpHandler{
+		configVolumeHandleMeta: createConfigVolumeMeta(configMeta.ConfigSpec.Name, appsv1beta1.HTTPType, dirs, &configMeta.FormatterConfig),
+		trigger:                trigger,
+		url:                    strings.TrimSpace(url),
+		username:               os.Getenv(reloadCredentialEnvName(configMeta.ConfigSpec.Name, reloadCredentialUsername)),
+		password:               os.Getenv(reloadCredentialEnvName(configMeta.ConfigSpec.Name, reloadCredentialPassword)),
+		fileFilter:             filter,
+		backupPath:             backupPath,
+	}, nil
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
 
 	cfgcore "github.com/apecloud/kubeblocks/pkg/configuration/core"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
@@ -52,6 +53,7 @@ const (
 	connectTimeout = 30 * time.Second
 
 	mysql             = "mysql"
+	postgresql        = "postgresql"
 	patroni           = "patroni"
 	mysqlDsnEnv       = "DATA_SOURCE_NAME"
 	patroniRestAPIURL = "PATRONI_REST_API_URL"
@@ -68,6 +70,8 @@ func NewCommandChannel(ctx context.Context, dataType, dsn string) (DynamicParamU
 	switch strings.ToLower(dataType) {
 	case mysql:
 		return newMysqlConnection(ctx, dsn)
+	case postgresql:
+		return newPostgresConnection(ctx, dsn)
 	case patroni:
 		return newPGPatroniConnection(dsn)
 	default:
@@ -95,6 +99,17 @@ func newMysqlConnection(ctx context.Context, dsn string) (DynamicParamUpdater, e
 	return newDynamicParamUpdater(ctx, db)
 }
 
+func newPostgresConnection(ctx context.Context, dsn string) (DynamicParamUpdater, error) {
+	if dsn == "" {
+		return nil, cfgcore.MakeError("require dsn to connect to postgresql.")
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, cfgcore.WrapError(err, "failed to opening connection to postgresql.")
+	}
+	return newDynamicParamUpdater(ctx, db)
+}
+
 func newDynamicParamUpdater(ctx context.Context, db *sql.DB) (DynamicParamUpdater, error) {
 	logger.V(1).Info("connecting mysql.")
 	db.SetMaxOpenConns(1)
@@ -0,0 +1,150 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package configmanager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/fsnotify/fsnotify"
+
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
+	cfgcore "github.com/apecloud/kubeblocks/pkg/configuration/core"
+	"github.com/apecloud/kubeblocks/pkg/gotemplate"
+)
+
+const (
+	reloadCredentialUsername = "USERNAME"
+	reloadCredentialPassword = "PASSWORD"
+)
+
+type sqlHandler struct {
+	configVolumeHandleMeta
+
+	trigger    appsv1beta1.SQLTrigger
+	dsn        string
+	fileFilter regexFilter
+	backupPath string
+}
+
+func (s *sqlHandler) OnlineUpdate(ctx context.Context, _ string, updatedParams map[string]string) error {
+	logger.Info(fmt.Sprintf("updated parameters: %v", updatedParams))
+	statements, err := renderSQLStatements(ctx, s.trigger, updatedParams)
+	if err != nil {
+		return err
+	}
+	commandChannel, err := newCommandChannel(ctx, s.trigger.DataType, s.dsn)
+	if err != nil {
+		return err
+	}
+	defer commandChannel.Close()
+
+	for _, statement := range statements {
+		r, err := commandChannel.ExecCommand(ctx, statement)
+		logger.V(1).Info(fmt.Sprintf("sql: [%s], result: [%v], err: [%+v]", statement, r, err))
+		if err != nil {
+			return cfgcore.WrapError(err, "failed to execute sql: [%s]", statement)
+		}
+	}
+	return nil
+}
+
+func (s *sqlHandler) VolumeHandle(ctx context.Context, event fsnotify.Event) error {
+	if !isOwnerEvent(s.MountPoint(), event) {
+		logger.Info(fmt.Sprintf("ignore event: %s, current watch volume: %s", event.String(), s.mountPoint))
+		return nil
+	}
+	updatedParams, files, err := s.prepare(s.backupPath, s.fileFilter, event)
+	if err != nil {
+		return err
+	}
+	if len(updatedParams) == 0 {
+		logger.Info("not parameter updated, skip")
+		return nil
+	}
+	if err := s.OnlineUpdate(ctx, event.Name, updatedParams); err != nil {
+		return err
+	}
+	return backupLastConfigFiles(files, s.backupPath)
+}
+
+// renderSQLStatements renders the statement template for each updated parameter in order, followed by the post statements.
+func renderSQLStatements(ctx context.Context, trigger appsv1beta1.SQLTrigger, updatedParams map[string]string) ([]string, error) {
+	names := make([]string, 0, len(updatedParams))
+	for name := range updatedParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statements := make([]string, 0, len(updatedParams)+len(trigger.PostStatements))
+	for _, name := range names {
+		values := gotemplate.TplValues{
+			"name":  name,
+			"value": updatedParams[name],
+		}
+		engine := gotemplate.NewTplEngine(&values, nil, "render-sql-statement", nil, ctx)
+		statement, err := engine.Render(trigger.StatementTemplate)
+		if err != nil {
+			return nil, cfgcore.WrapError(err, "failed to render statement for parameter [%s]", name)
+		}
+		statements = append(statements, statement)
+	}
+	return append(statements, trigger.PostStatements...), nil
+}
+
+// renderCredentialTemplate renders the template with the credential passed by the envs of the config-manager.
+func renderCredentialTemplate(name, tpl, configSpecName string) (string, error) {
+	values := gotemplate.TplValues{
+		"username": os.Getenv(reloadCredentialEnvName(configSpecName, reloadCredentialUsername)),
+		"password": os.Getenv(reloadCredentialEnvName(configSpecName, reloadCredentialPassword)),
+	}
+	engine := gotemplate.NewTplEngine(&values, nil, name, nil, nil)
+	return engine.Render(tpl)
+}
+
+func CreateSQLHandler(configMeta ConfigSpecInfo, backupPath string) (ConfigHandler, error) {
+	if configMeta.ReloadAction == nil || configMeta.SQLTrigger == nil {
+		return nil, cfgcore.MakeError("sql trigger is nil")
+	}
+	trigger := *configMeta.SQLTrigger
+	filter, err := createFileRegex(fromConfigSpecInfo(&configMeta))
+	if err != nil {
+		return nil, err
+	}
+	dsn, err := renderCredentialTemplate("render-dsn", trigger.DSN, configMeta.ConfigSpec.Name)
+	if err != nil {
+		return nil, err
+	}
+	dirs := []string{configMeta.MountPoint}
+	if backupPath != "" {
+		if err := checkAndBackup(configMeta, dirs, filter, backupPath); err != nil {
+			return nil, err
+		}
+	}
+	return &sqlHandler{
+		configVolumeHandleMeta: createConfigVolumeMeta(configMeta.ConfigSpec.Name, appsv1beta1.SQLType, dirs, &configMeta.FormatterConfig),
+		trigger:                trigger,
+		dsn:                    dsn,
+		fileFilter:             filter,
+		backupPath:             backupPath,
+	}, nil
+}
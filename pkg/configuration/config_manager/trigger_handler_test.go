@@ -0,0 +1,183 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package configmanager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	appsv1beta1 "github.com/apecloud/kubeblocks/apis/apps/v1beta1"
+)
+
+type recordCommandChannel struct {
+	statements []string
+}
+
+func (r *recordCommandChannel) ExecCommand(_ context.Context, command string, _ ...string) (string, error) {
+	r.statements = append(r.statements, command)
+	return "", nil
+}
+
+func (r *recordCommandChannel) Close() {
+}
+
+func newTriggerConfigSpecInfo(action *appsv1beta1.ReloadAction, reloadType appsv1beta1.DynamicReloadType) ConfigSpecInfo {
+	return ConfigSpecInfo{
+		ReloadAction: action,
+		ReloadType:   reloadType,
+		MountPoint:   "/etc/mysql",
+		ConfigSpec: appsv1alpha1.ComponentConfigSpec{
+			ComponentTemplateSpec: appsv1alpha1.ComponentTemplateSpec{
+				Name:       "mysql-config",
+				VolumeName: "mysql-config",
+			},
+		},
+		FormatterConfig: appsv1beta1.FileFormatConfig{
+			Format: appsv1beta1.Ini,
+		},
+	}
+}
+
+func TestRenderSQLStatements(t *testing.T) {
+	trigger := appsv1beta1.SQLTrigger{
+		StatementTemplate: `SET GLOBAL {{ .name }} = '{{ .value }}'`,
+		PostStatements:    []string{"FLUSH PRIVILEGES"},
+	}
+	statements, err := renderSQLStatements(context.TODO(), trigger, map[string]string{
+		"max_connections":    "666",
+		"innodb_io_capacity": "200",
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{
+		"SET GLOBAL innodb_io_capacity = '200'",
+		"SET GLOBAL max_connections = '666'",
+		"FLUSH PRIVILEGES",
+	}, statements)
+}
+
+func TestSQLHandlerOnlineUpdate(t *testing.T) {
+	t.Setenv(reloadCredentialEnvName("mysql-config", reloadCredentialUsername), "root")
+	t.Setenv(reloadCredentialEnvName("mysql-config", reloadCredentialPassword), "secret")
+
+	channel := &recordCommandChannel{}
+	var dataType, dsn string
+	oldCommandChannel := newCommandChannel
+	newCommandChannel = func(ctx context.Context, t, d string) (DynamicParamUpdater, error) {
+		dataType, dsn = t, d
+		return channel, nil
+	}
+	defer func() { newCommandChannel = oldCommandChannel }()
+
+	configMeta := newTriggerConfigSpecInfo(&appsv1beta1.ReloadAction{
+		SQLTrigger: &appsv1beta1.SQLTrigger{
+			DataType:          "mysql",
+			DSN:               `{{ .username }}:{{ .password }}@tcp(127.0.0.1:3306)/`,
+			StatementTemplate: `SET GLOBAL {{ .name }} = {{ .value }}`,
+		},
+	}, appsv1beta1.SQLType)
+	handler, err := CreateSQLHandler(configMeta, "")
+	require.Nil(t, err)
+	require.Nil(t, handler.OnlineUpdate(context.TODO(), "", map[string]string{"max_connections": "666"}))
+	require.Equal(t, "mysql", dataType)
+	require.Equal(t, "root:secret@tcp(127.0.0.1:3306)/", dsn)
+	require.Equal(t, []string{"SET GLOBAL max_connections = 666"}, channel.statements)
+}
+
+func TestHTTPHandlerOnlineUpdate(t *testing.T) {
+	t.Setenv(reloadCredentialEnvName("mysql-config", reloadCredentialUsername), "admin")
+	t.Setenv(reloadCredentialEnvName("mysql-config", reloadCredentialPassword), "secret")
+
+	var received map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if r.Method != http.MethodPost || !ok || username != "admin" || password != "secret" ||
+			r.Header.Get("Content-Type") != "application/json" || r.Header.Get("X-Reload") != "true" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	configMeta := newTriggerConfigSpecInfo(&appsv1beta1.ReloadAction{
+		HTTPTrigger: &appsv1beta1.HTTPTrigger{
+			URL:     server.URL + "/config",
+			Headers: map[string]string{"X-Reload": "true"},
+		},
+	}, appsv1beta1.HTTPType)
+	handler, err := CreateHTTPHandler(configMeta, "")
+	require.Nil(t, err)
+	require.Nil(t, handler.OnlineUpdate(context.TODO(), "", map[string]string{"max_connections": "666"}))
+	require.Equal(t, map[string]string{"max_connections": "666"}, received)
+}
+
+func TestHTTPHandlerRetries(t *testing.T) {
+	oldInterval := httpRetryInterval
+	httpRetryInterval = time.Millisecond
+	defer func() { httpRetryInterval = oldInterval }()
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		if string(b) != "max_connections=666" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	newHandler := func(retries int32) ConfigHandler {
+		configMeta := newTriggerConfigSpecInfo(&appsv1beta1.ReloadAction{
+			HTTPTrigger: &appsv1beta1.HTTPTrigger{
+				URL:          server.URL,
+				Method:       http.MethodPut,
+				BodyTemplate: `{{- range $k, $v := $ }}{{ printf "%s=%s" $k $v }}{{- end }}`,
+				Retries:      retries,
+			},
+		}, appsv1beta1.HTTPType)
+		handler, err := CreateHTTPHandler(configMeta, "")
+		require.Nil(t, err)
+		return handler
+	}
+
+	params := map[string]string{"max_connections": "666"}
+	require.NotNil(t, newHandler(1).OnlineUpdate(context.TODO(), "", params))
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	atomic.StoreInt32(&requests, 0)
+	require.Nil(t, newHandler(3).OnlineUpdate(context.TODO(), "", params))
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
@@ -46,6 +46,22 @@ const (
 	DisableHAAnnotationKey                   = "kubeblocks.io/disable-ha"
 	OpsDependentOnSuccessfulOpsAnnoKey       = "ops.kubeblocks.io/dependent-on-successful-ops" // OpsDependentOnSuccessfulOpsAnnoKey wait for the dependent ops to succeed before executing the current ops. If it fails, this ops will also fail.
 	RelatedOpsAnnotationKey                  = "ops.kubeblocks.io/related-ops"
+	OpsRequestCreatorAnnotationKey           = "ops.kubeblocks.io/creator"          // OpsRequestCreatorAnnotationKey records the user who created the OpsRequest, it is set by the mutating webhook
+	DryRunAnnotationKey                      = "apps.kubeblocks.io/dry-run"         // DryRunAnnotationKey specifies whether to preview the reconciliation plan instead of executing it
+	StandbySourceAnnotationKey               = "apps.kubeblocks.io/standby-source"  // StandbySourceAnnotationKey specifies the "host:port" of the primary component that a standby component replicates from
+	TLSCertSerialAnnotationKey               = "apps.kubeblocks.io/tls-cert-serial" // TLSCertSerialAnnotationKey records the serial number of the TLS certificate loaded by the pods
+
+	// PasswordRotatedAtAnnotationKey records when the password in an account secret was rotated.
+	PasswordRotatedAtAnnotationKey = "apps.kubeblocks.io/password-rotated-at"
+	// PasswordAppliedAnnotationKey marks that the pending password of an account has been set in the database engine.
+	PasswordAppliedAnnotationKey = "apps.kubeblocks.io/password-applied"
+	// PreviousPasswordValidUntilAnnotationKey records when the previous password of a rotated account will be discarded.
+	PreviousPasswordValidUntilAnnotationKey = "apps.kubeblocks.io/previous-password-valid-until"
+	// RotatePasswordAnnotationPrefix is the prefix of the component annotations requesting to rotate the password of an account,
+	// the value is the time of the request.
+	RotatePasswordAnnotationPrefix = "apps.kubeblocks.io/rotate-password-"
+	// CredentialRotatedAtAnnotationKey records the latest rotation time of the account secrets used by the pods, pods are restarted when it changes
+	CredentialRotatedAtAnnotationKey = "apps.kubeblocks.io/credential-rotated-at"
 )
 
 // annotations for multi-cluster
@@ -92,6 +92,8 @@ const (
 	KBEnvLorryHTTPPort   = "LORRY_HTTP_PORT"
 	KBEnvLorryGRPCPort   = "LORRY_GRPC_PORT"
 	KBEnvLorryLogLevel   = "LORRY_LOG_LEVEL"
+	// KBEnvLorrySecureAccessEnabled enables the mTLS and token authentication of Lorry.
+	KBEnvLorrySecureAccessEnabled = "LORRY_SECURE_ACCESS_ENABLED"
 	// KBEnvServiceRoles defines the Roles configured in the cluster definition that are visible to users.
 	KBEnvServiceRoles = "KB_SERVICE_ROLES"
 
@@ -110,10 +112,28 @@ const (
 	// KBEnvEnableHA Whether to enable high availability, true by default.
 	KBEnvEnableHA = "KB_ENABLE_HA"
 
+	// KBEnvDCSType defines the type of the DCS store used for high availability, "kubernetes" by default, or "etcd".
+	KBEnvDCSType = "KB_DCS_TYPE"
+
+	// KBEnvDCSEtcdEndpoints defines the comma-separated endpoints of the external etcd used as the DCS store.
+	KBEnvDCSEtcdEndpoints = "KB_DCS_ETCD_ENDPOINTS"
+
+	// KBEnvDCSEtcdPrefix defines the key prefix of the DCS store in etcd, "/kubeblocks" by default.
+	KBEnvDCSEtcdPrefix = "KB_DCS_ETCD_PREFIX"
+
+	// KBEnvStandbySourceHost defines the host of the external primary to replicate from if the cluster is a standby cluster.
+	KBEnvStandbySourceHost = "KB_STANDBY_SOURCE_HOST"
+
+	// KBEnvStandbySourcePort defines the port of the external primary to replicate from if the cluster is a standby cluster.
+	KBEnvStandbySourcePort = "KB_STANDBY_SOURCE_PORT"
+
 	// KBEnvRsmRoleUpdateMechanism defines the method to send events: DirectAPIServerEventUpdate(through lorry service), ReadinessProbeEventUpdate(through kubelet service)
 	KBEnvRsmRoleUpdateMechanism = "KB_RSM_ROLE_UPDATE_MECHANISM"
 	KBEnvRoleProbeTimeout       = "KB_RSM_ROLE_PROBE_TIMEOUT"
 	KBEnvRoleProbePeriod        = "KB_RSM_ROLE_PROBE_PERIOD"
 
 	KBEnvVolumeProtectionSpec = "KB_VOLUME_PROTECTION_SPEC"
+
+	// KBEnvVolumeAutoscalingSpec defines the autoscaling policies of the volumes, keyed by the volume name.
+	KBEnvVolumeAutoscalingSpec = "KB_VOLUME_AUTOSCALING_SPEC"
 )
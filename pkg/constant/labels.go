@@ -63,6 +63,8 @@ const (
 	OpsRequestTypeLabelKey                 = "ops.kubeblocks.io/ops-type"
 	OpsRequestNameLabelKey                 = "ops.kubeblocks.io/ops-name"
 	OpsRequestNamespaceLabelKey            = "ops.kubeblocks.io/ops-namespace"
+	VolumeAutoscalingLabelKey              = "ops.kubeblocks.io/volume-autoscaling"   // marks the OpsRequest created by the volume autoscaling
+	ComponentAutoscalerLabelKey            = "ops.kubeblocks.io/component-autoscaler" // marks the OpsRequest created by the ComponentAutoscaler, the value is its name
 	ServiceDescriptorNameLabelKey          = "servicedescriptor.kubeblocks.io/name"
 )
 
@@ -32,6 +32,22 @@ const (
 	LorryVolumeProtectPath             = "/v1.0/volumeprotection"
 )
 
+// Lorry secure access
+const (
+	LorrySecureAccessVolumeName = "lorry-secure-access"
+	LorrySecureAccessMountPath  = "/etc/kubeblocks/lorry"
+	// LorrySecureAccessServerName is the server name of the certificates issued for Lorry, the clients
+	// verify the server certificate against it since Lorry is accessed by the pod IP.
+	LorrySecureAccessServerName = "lorry.kubeblocks.io"
+	// LorryTokenName is the key of the bearer token in the secure access secret.
+	LorryTokenName = "token"
+	// LorryServiceAccountTokenAudience is the audience of the service account tokens to access Lorry,
+	// the tokens of the other audiences, e.g. the API server, are rejected by Lorry.
+	LorryServiceAccountTokenAudience = "lorry.kubeblocks.io"
+	// LorryServiceAccountTokenPath is the projected service account token of KubeBlocks to access Lorry.
+	LorryServiceAccountTokenPath = "/var/run/secrets/kubeblocks.io/lorry/token"
+)
+
 const (
 	PostgreSQLCharacterType = "postgresql"
 	MySQLCharacterType      = "mysql"
@@ -54,6 +70,7 @@ const (
 	PreTerminateAction  = "preTerminate"
 	DataDumpAction      = "dataDump"
 	DataLoadAction      = "dataLoad"
+	ReconfigureAction   = "reconfigure"
 )
 
 // action envs
@@ -35,6 +35,16 @@ func GenerateAccountSecretName(clusterName, compName, name string) string {
 	return fmt.Sprintf("%s-%s-account-%s", clusterName, compName, replacedName)
 }
 
+// GenerateAccountRotatingSecretName generates the name of the secret holding the pending password of a rotating account.
+func GenerateAccountRotatingSecretName(clusterName, compName, name string) string {
+	return fmt.Sprintf("%s-rotating", GenerateAccountSecretName(clusterName, compName, name))
+}
+
+// GenerateLorrySecureAccessSecretName generates the name of the secret holding the certificates and token to access Lorry.
+func GenerateLorrySecureAccessSecretName(clusterName, compName string) string {
+	return fmt.Sprintf("%s-%s-lorry-secure-access", clusterName, compName)
+}
+
 // GenerateClusterServiceName generates the service name for cluster.
 func GenerateClusterServiceName(clusterName, svcName string) string {
 	if len(svcName) > 0 {
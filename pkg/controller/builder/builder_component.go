@@ -90,6 +90,11 @@ func (builder *ComponentBuilder) SetDisableExporter(disableExporter *bool) *Comp
 	return builder
 }
 
+func (builder *ComponentBuilder) SetDisruptionBudget(budget *appsv1alpha1.ComponentDisruptionBudget) *ComponentBuilder {
+	builder.get().Spec.DisruptionBudget = budget
+	return builder
+}
+
 func (builder *ComponentBuilder) SetEnabledLogs(logNames []string) *ComponentBuilder {
 	builder.get().Spec.EnabledLogs = logNames
 	return builder
@@ -0,0 +1,78 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package builder
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	experimental "github.com/apecloud/kubeblocks/apis/experimental/v1alpha1"
+)
+
+var _ = Describe("component_autoscaler builder", func() {
+	It("should work well", func() {
+		const (
+			name = "foo"
+			ns   = "default"
+		)
+		clusterName := "target-cluster-name"
+		componentName := "comp-1"
+		minReplicas, maxReplicas := int32(2), int32(5)
+		cpuMetric := experimental.MetricSpec{
+			Type: experimental.ResourceMetricSourceType,
+			Resource: &experimental.ResourceMetricSource{
+				Name:                     corev1.ResourceCPU,
+				TargetAverageUtilization: pointer.Int32(70),
+			},
+		}
+		connMetric := experimental.MetricSpec{
+			Type: experimental.PodsMetricSourceType,
+			Pods: &experimental.PodsMetricSource{
+				MetricName:         "connections",
+				TargetAverageValue: resource.MustParse("100"),
+			},
+		}
+		behavior := &experimental.ComponentAutoscalerBehavior{
+			ScaleDown: &experimental.ScalingRules{StabilizationWindowSeconds: pointer.Int32(600)},
+		}
+
+		cas := NewComponentAutoscalerBuilder(ns, name).
+			SetTargetClusterName(clusterName).
+			SetTargetComponentName(componentName).
+			SetReplicas(minReplicas, maxReplicas).
+			AddMetrics(cpuMetric).
+			AddMetrics(connMetric).
+			SetBehavior(behavior).
+			GetObject()
+
+		Expect(cas.Name).Should(Equal(name))
+		Expect(cas.Namespace).Should(Equal(ns))
+		Expect(cas.Spec.TargetClusterName).Should(Equal(clusterName))
+		Expect(cas.Spec.TargetComponentName).Should(Equal(componentName))
+		Expect(cas.Spec.MinReplicas).Should(Equal(minReplicas))
+		Expect(cas.Spec.MaxReplicas).Should(Equal(maxReplicas))
+		Expect(cas.Spec.Metrics).Should(Equal([]experimental.MetricSpec{cpuMetric, connMetric}))
+		Expect(cas.Spec.Behavior).Should(Equal(behavior))
+	})
+})
@@ -87,6 +87,7 @@ func BuildComponent(cluster *appsv1alpha1.Cluster, compSpec *appsv1alpha1.Cluste
 		SetEnv(compSpec.Env).
 		SetSchedulingPolicy(schedulingPolicy).
 		SetDisableExporter(compSpec.GetDisableExporter()).
+		SetDisruptionBudget(compSpec.DisruptionBudget).
 		SetReplicas(compSpec.Replicas).
 		SetResources(compSpec.Resources).
 		SetServiceAccountName(compSpec.ServiceAccountName).
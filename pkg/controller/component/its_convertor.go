@@ -333,8 +333,41 @@ func (c *itsCredentialConvertor) convert(args ...any) (any, error) {
 }
 
 func (c *itsMembershipReconfigurationConvertor) convert(args ...any) (any, error) {
-	// synthesizeComp, err := parseITSConvertorArgs(args...)
-	return "", nil // TODO
+	synthesizeComp, err := parseITSConvertorArgs(args...)
+	if err != nil {
+		return nil, err
+	}
+	if !HasSwitchoverAction(synthesizeComp) {
+		return nil, nil
+	}
+	// only the switchover action is derived from the component definition, which is used by the InstanceSet
+	// to move the leader out of the nodes being drained.
+	action := synthesizeComp.LifecycleActions.Switchover.WithCandidate
+	image := action.Image
+	if len(image) == 0 && synthesizeComp.PodSpec != nil {
+		for _, container := range synthesizeComp.PodSpec.Containers {
+			if len(action.Container) == 0 || container.Name == action.Container {
+				image = container.Image
+				break
+			}
+		}
+	}
+	return &workloads.MembershipReconfiguration{
+		SwitchoverAction: &workloads.Action{
+			Image:   image,
+			Command: action.Exec.Command,
+			Args:    action.Exec.Args,
+		},
+	}, nil
+}
+
+// HasSwitchoverAction checks whether the component defines an exec switchover action with a candidate.
+func HasSwitchoverAction(synthesizeComp *SynthesizedComponent) bool {
+	actions := synthesizeComp.LifecycleActions
+	if actions == nil || actions.Switchover == nil || actions.Switchover.WithCandidate == nil {
+		return false
+	}
+	return actions.Switchover.WithCandidate.Exec != nil && len(actions.Switchover.WithCandidate.Exec.Command) > 0
 }
 
 // ConvertSynthesizeCompRoleToInstanceSetRole converts the component.SynthesizedComponent.Roles to workloads.ReplicaRole.
@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
+
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	workloadsalpha1 "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
 )
@@ -59,5 +61,31 @@ var _ = Describe("Test InstanceSet Convertor", func() {
 			Expect(probe.CustomHandler[0].Command).Should(BeEquivalentTo(command))
 			Expect(probe.CustomHandler[0].Args).Should(BeEquivalentTo(args))
 		})
+
+		It("convert membership reconfiguration", func() {
+			convertor := &itsMembershipReconfigurationConvertor{}
+			res, err := convertor.convert(synComp)
+			Expect(err).Should(Succeed())
+			Expect(res).Should(BeNil())
+
+			synComp.PodSpec = &corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "main", Image: "foo:latest"}},
+			}
+			synComp.LifecycleActions.Switchover = &appsv1alpha1.ComponentSwitchover{
+				WithCandidate: &appsv1alpha1.Action{
+					Exec: &appsv1alpha1.ExecAction{
+						Command: command,
+						Args:    args,
+					},
+				},
+			}
+			res, err = convertor.convert(synComp)
+			Expect(err).Should(Succeed())
+			reconfiguration := res.(*workloadsalpha1.MembershipReconfiguration)
+			Expect(reconfiguration.SwitchoverAction).ShouldNot(BeNil())
+			Expect(reconfiguration.SwitchoverAction.Image).Should(Equal("foo:latest"))
+			Expect(reconfiguration.SwitchoverAction.Command).Should(BeEquivalentTo(command))
+			Expect(reconfiguration.SwitchoverAction.Args).Should(BeEquivalentTo(args))
+		})
 	})
 })
@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"path/filepath"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -108,6 +110,9 @@ func buildLorryContainers(reqCtx intctrlutil.RequestCtx, synthesizeComp *Synthes
 
 	buildLorryServiceContainer(synthesizeComp, &lorryContainers[0], int(lorryHTTPPort), int(lorryGRPCPort), clusterCompSpec)
 	adaptLorryIfCustomHandlerDefined(synthesizeComp, &lorryContainers[0], int(lorryHTTPPort), int(lorryGRPCPort))
+	if viper.GetBool(constant.KBEnvLorrySecureAccessEnabled) {
+		buildLorrySecureAccess(synthesizeComp, lorryContainers)
+	}
 
 	reqCtx.Log.V(1).Info("lorry", "containers", lorryContainers)
 	synthesizeComp.PodSpec.Containers = append(synthesizeComp.PodSpec.Containers, lorryContainers...)
@@ -213,6 +218,72 @@ func buildLorryServiceContainer(synthesizeComp *SynthesizedComponent, container
 	}
 }
 
+// buildLorrySecureAccess serves the Lorry HTTP API over TLS and requires the requests to be authenticated,
+// the credentials are issued into a secret of the component by the component controller.
+func buildLorrySecureAccess(synthesizeComp *SynthesizedComponent, lorryContainers []corev1.Container) {
+	dir := constant.LorrySecureAccessMountPath
+	lorryContainer := &lorryContainers[0]
+	lorryContainer.Command = append(lorryContainer.Command,
+		"--tls-cert-file", filepath.Join(dir, constant.CertName),
+		"--tls-key-file", filepath.Join(dir, constant.KeyName),
+		"--client-ca-file", filepath.Join(dir, constant.CAName),
+		"--auth-token-file", filepath.Join(dir, constant.LorryTokenName),
+		// KubeBlocks can also be authenticated by its service account token
+		"--authorized-service-accounts", fmt.Sprintf("%s:%s", viper.GetString(constant.CfgKeyCtrlrMgrNS), viper.GetString(constant.KBServiceAccountName)),
+	)
+	lorryContainer.VolumeMounts = append(lorryContainer.VolumeMounts, corev1.VolumeMount{
+		Name:      constant.LorrySecureAccessVolumeName,
+		MountPath: dir,
+		ReadOnly:  true,
+	})
+
+	// the probes of kubelet are left anonymous by Lorry, they only need to switch to HTTPS.
+	for i := range lorryContainers {
+		if probe := lorryContainers[i].ReadinessProbe; probe != nil && probe.HTTPGet != nil {
+			probe.HTTPGet.Scheme = corev1.URISchemeHTTPS
+		}
+	}
+
+	mode := lorrySecureAccessFileMode(synthesizeComp.PodSpec, lorryContainer)
+	synthesizeComp.PodSpec.Volumes = append(synthesizeComp.PodSpec.Volumes, corev1.Volume{
+		Name: constant.LorrySecureAccessVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  constant.GenerateLorrySecureAccessSecretName(synthesizeComp.ClusterName, synthesizeComp.Name),
+				DefaultMode: &mode,
+			},
+		},
+	})
+}
+
+// lorrySecureAccessFileMode returns the mode of the credential files, which must be readable by the user running Lorry.
+// The files are owned by root, and by the fsGroup of the pod if it's set, so they are readable by the group if the pod
+// has a fsGroup, and readable by others if Lorry runs as a non-root user without it.
+func lorrySecureAccessFileMode(podSpec *corev1.PodSpec, lorryContainer *corev1.Container) int32 {
+	var (
+		runAsUser    *int64
+		runAsNonRoot *bool
+	)
+	if podSpec.SecurityContext != nil {
+		if podSpec.SecurityContext.FSGroup != nil {
+			return 0440
+		}
+		runAsUser, runAsNonRoot = podSpec.SecurityContext.RunAsUser, podSpec.SecurityContext.RunAsNonRoot
+	}
+	if lorryContainer.SecurityContext != nil {
+		if lorryContainer.SecurityContext.RunAsUser != nil {
+			runAsUser = lorryContainer.SecurityContext.RunAsUser
+		}
+		if lorryContainer.SecurityContext.RunAsNonRoot != nil {
+			runAsNonRoot = lorryContainer.SecurityContext.RunAsNonRoot
+		}
+	}
+	if (runAsUser != nil && *runAsUser != 0) || (runAsUser == nil && runAsNonRoot != nil && *runAsNonRoot) {
+		return 0444
+	}
+	return 0400
+}
+
 func buildLorryInitContainer() *corev1.Container {
 	container := &corev1.Container{}
 	container.Image = viper.GetString(constant.KBToolsImage)
@@ -273,8 +344,12 @@ func buildLorryEnvs(container *corev1.Container, synthesizeComp *SynthesizedComp
 
 	if volumeProtectionEnabled(synthesizeComp) {
 		envs = append(envs, buildEnv4VolumeProtection(synthesizeComp))
+		if len(synthesizeComp.VolumeAutoscalings) > 0 {
+			envs = append(envs, buildEnv4VolumeAutoscaling(synthesizeComp))
+		}
 	}
 	envs = append(envs, buildEnv4CronJobs(synthesizeComp)...)
+	envs = append(envs, buildEnv4StandbySource(synthesizeComp)...)
 
 	container.Env = append(container.Env, envs...)
 }
@@ -298,6 +373,10 @@ func buildRoleProbeContainer(roleChangedContainer *corev1.Container, roleProbe *
 }
 
 func volumeProtectionEnabled(component *SynthesizedComponent) bool {
+	// the space usage of volumes is also monitored by the volume protection to autoscale them.
+	if len(component.VolumeAutoscalings) > 0 {
+		return true
+	}
 	for _, v := range component.Volumes {
 		if v.HighWatermark > 0 {
 			return true
@@ -392,6 +471,17 @@ func buildEnv4VolumeProtection(synthesizedComp *SynthesizedComponent) corev1.Env
 	}
 }
 
+func buildEnv4VolumeAutoscaling(synthesizedComp *SynthesizedComponent) corev1.EnvVar {
+	value, err := json.Marshal(synthesizedComp.VolumeAutoscalings)
+	if err != nil {
+		panic(fmt.Sprintf("marshal volume autoscaling spec error: %s", err.Error()))
+	}
+	return corev1.EnvVar{
+		Name:  constant.KBEnvVolumeAutoscalingSpec,
+		Value: string(value),
+	}
+}
+
 func buildEnv4CronJobs(_ *SynthesizedComponent) []corev1.EnvVar {
 	return nil
 	// if synthesizeComp.LifecycleActions == nil || synthesizeComp.LifecycleActions.HealthyCheck == nil {
@@ -415,6 +505,28 @@ func buildEnv4CronJobs(_ *SynthesizedComponent) []corev1.EnvVar {
 	// }
 }
 
+// buildEnv4StandbySource builds the envs of the external primary if the component is a standby of another cluster.
+func buildEnv4StandbySource(synthesizeComp *SynthesizedComponent) []corev1.EnvVar {
+	source := synthesizeComp.Annotations[constant.StandbySourceAnnotationKey]
+	if source == "" {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(source)
+	if err != nil {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{
+			Name:  constant.KBEnvStandbySourceHost,
+			Value: host,
+		},
+		{
+			Name:  constant.KBEnvStandbySourcePort,
+			Value: port,
+		},
+	}
+}
+
 // getBuiltinActionHandler gets the built-in handler.
 // The BuiltinActionHandler within the same synthesizeComp LifecycleActions should be consistent, we can take any one of them.
 func getBuiltinActionHandler(synthesizeComp *SynthesizedComponent) appsv1alpha1.BuiltinActionHandlerType {
@@ -459,6 +571,17 @@ func getBuiltinActionHandler(synthesizeComp *SynthesizedComponent) appsv1alpha1.
 	return appsv1alpha1.UnknownBuiltinActionHandler
 }
 
+// IsDualPasswordSupported checks whether the engine can retain the current password while updating it,
+// the custom handlers are trusted to support it.
+func IsDualPasswordSupported(synthesizeComp *SynthesizedComponent) bool {
+	switch getBuiltinActionHandler(synthesizeComp) {
+	case appsv1alpha1.MySQLBuiltinActionHandler, appsv1alpha1.WeSQLBuiltinActionHandler, appsv1alpha1.CustomActionHandler:
+		return true
+	default:
+		return false
+	}
+}
+
 func getActionCommandsWithExecImageOrContainerName(synthesizeComp *SynthesizedComponent) (map[string][]string, string, string) {
 	if synthesizeComp.LifecycleActions == nil {
 		return nil, "", ""
@@ -473,7 +596,7 @@ func getActionCommandsWithExecImageOrContainerName(synthesizeComp *SynthesizedCo
 		constant.ReadWriteAction:     synthesizeComp.LifecycleActions.Readwrite,
 		constant.DataDumpAction:      synthesizeComp.LifecycleActions.DataDump,
 		constant.DataLoadAction:      synthesizeComp.LifecycleActions.DataLoad,
-		// "reconfigure":                synthesizeComp.LifecycleActions.Reconfigure,
+		constant.ReconfigureAction:   synthesizeComp.LifecycleActions.Reconfigure,
 		// "accountProvision": synthesizeComp.LifecycleActions.AccountProvision,
 	}
 
@@ -27,6 +27,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
@@ -221,6 +222,111 @@ var _ = Describe("Lorry Utils", func() {
 			Expect(spec.Volumes).Should(HaveLen(1))
 			Expect(*spec.Volumes[0].HighWatermark).Should(Equal(90))
 		})
+
+		It("build volume protection probe container for volume autoscaling", func() {
+			reqCtx := intctrlutil.RequestCtx{
+				Ctx: ctx,
+				Log: logger,
+			}
+			component.VolumeAutoscalings = map[string]appsv1alpha1.VolumeAutoscaling{
+				"volume-001": {
+					Threshold: 80,
+					Step:      resource.MustParse("5Gi"),
+					MaxSize:   resource.MustParse("100Gi"),
+				},
+			}
+			defaultBuiltInHandler := appsv1alpha1.MySQLBuiltinActionHandler
+			component.LifecycleActions = &appsv1alpha1.ComponentLifecycleActions{
+				RoleProbe: &appsv1alpha1.RoleProbe{
+					LifecycleActionHandler: appsv1alpha1.LifecycleActionHandler{
+						BuiltinHandler: &defaultBuiltInHandler,
+					},
+				},
+			}
+			Expect(buildLorryContainers(reqCtx, component, nil)).Should(Succeed())
+			Expect(component.PodSpec.Containers).Should(HaveLen(2))
+			Expect(component.PodSpec.Containers[1].Name).Should(Equal(constant.VolumeProtectionProbeContainerName))
+			autoscalings := map[string]appsv1alpha1.VolumeAutoscaling{}
+			for _, e := range component.PodSpec.Containers[0].Env {
+				if e.Name == constant.KBEnvVolumeAutoscalingSpec {
+					Expect(json.Unmarshal([]byte(e.Value), &autoscalings)).Should(Succeed())
+					break
+				}
+			}
+			Expect(autoscalings).Should(HaveKey("volume-001"))
+			Expect(autoscalings["volume-001"].Threshold).Should(Equal(80))
+		})
+
+		It("build lorry container of a standby component", func() {
+			reqCtx := intctrlutil.RequestCtx{
+				Ctx: ctx,
+				Log: logger,
+			}
+			defaultBuiltInHandler := appsv1alpha1.MySQLBuiltinActionHandler
+			component.LifecycleActions = &appsv1alpha1.ComponentLifecycleActions{
+				RoleProbe: &appsv1alpha1.RoleProbe{
+					LifecycleActionHandler: appsv1alpha1.LifecycleActionHandler{
+						BuiltinHandler: &defaultBuiltInHandler,
+					},
+				},
+			}
+			component.Annotations = map[string]string{
+				constant.StandbySourceAnnotationKey: "primary-mysql.default.svc:3306",
+			}
+			Expect(buildLorryContainers(reqCtx, component, nil)).Should(Succeed())
+			Expect(component.PodSpec.Containers).Should(HaveLen(1))
+			envs := map[string]string{}
+			for _, e := range component.PodSpec.Containers[0].Env {
+				envs[e.Name] = e.Value
+			}
+			Expect(envs).Should(HaveKeyWithValue(constant.KBEnvStandbySourceHost, "primary-mysql.default.svc"))
+			Expect(envs).Should(HaveKeyWithValue(constant.KBEnvStandbySourcePort, "3306"))
+		})
+
+		It("build lorry container with secure access", func() {
+			reqCtx := intctrlutil.RequestCtx{
+				Ctx: ctx,
+				Log: logger,
+			}
+			viper.Set(constant.KBEnvLorrySecureAccessEnabled, true)
+			defer viper.Set(constant.KBEnvLorrySecureAccessEnabled, false)
+			defaultBuiltInHandler := appsv1alpha1.MySQLBuiltinActionHandler
+			component.ClusterName = "test-cluster"
+			component.Name = "mysql"
+			component.LifecycleActions = &appsv1alpha1.ComponentLifecycleActions{
+				RoleProbe: &appsv1alpha1.RoleProbe{
+					LifecycleActionHandler: appsv1alpha1.LifecycleActionHandler{
+						BuiltinHandler: &defaultBuiltInHandler,
+					},
+				},
+			}
+			Expect(buildLorryContainers(reqCtx, component, nil)).Should(Succeed())
+			Expect(component.PodSpec.Containers).Should(HaveLen(1))
+			lorryContainer := component.PodSpec.Containers[0]
+			Expect(lorryContainer.Command).Should(ContainElements("--tls-cert-file", "--client-ca-file", "--auth-token-file", "--authorized-service-accounts"))
+			Expect(lorryContainer.ReadinessProbe.HTTPGet.Scheme).Should(Equal(corev1.URISchemeHTTPS))
+			Expect(lorryContainer.VolumeMounts).Should(ContainElement(HaveField("Name", constant.LorrySecureAccessVolumeName)))
+			Expect(component.PodSpec.Volumes).Should(HaveLen(1))
+			Expect(component.PodSpec.Volumes[0].Secret.SecretName).Should(Equal("test-cluster-mysql-lorry-secure-access"))
+			Expect(*component.PodSpec.Volumes[0].Secret.DefaultMode).Should(Equal(int32(0400)))
+		})
+
+		It("makes the credentials readable by the non-root lorry", func() {
+			uid, gid := int64(1001), int64(1001)
+			podSpec := &corev1.PodSpec{SecurityContext: &corev1.PodSecurityContext{RunAsUser: &uid}}
+			lorryContainer := &corev1.Container{}
+			Expect(lorrySecureAccessFileMode(podSpec, lorryContainer)).Should(Equal(int32(0444)))
+
+			By("the files are readable by the group with the fsGroup")
+			podSpec.SecurityContext.FSGroup = &gid
+			Expect(lorrySecureAccessFileMode(podSpec, lorryContainer)).Should(Equal(int32(0440)))
+
+			By("lorry runs as root")
+			root := int64(0)
+			podSpec.SecurityContext.FSGroup = nil
+			lorryContainer.SecurityContext = &corev1.SecurityContext{RunAsUser: &root}
+			Expect(lorrySecureAccessFileMode(podSpec, lorryContainer)).Should(Equal(int32(0400)))
+		})
 	})
 })
 
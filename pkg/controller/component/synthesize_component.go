@@ -171,6 +171,7 @@ func buildSynthesizedComponent(reqCtx intctrlutil.RequestCtx,
 		PolicyRules:            compDefObj.Spec.PolicyRules,
 		LifecycleActions:       compDefObj.Spec.LifecycleActions,
 		SystemAccounts:         mergeSystemAccounts(compDefObj.Spec.SystemAccounts, comp.Spec.SystemAccounts),
+		PasswordRotations:      buildPasswordRotations(comp.Spec.SystemAccounts),
 		Replicas:               comp.Spec.Replicas,
 		Resources:              comp.Spec.Resources,
 		TLSConfig:              comp.Spec.TLSConfig,
@@ -178,6 +179,7 @@ func buildSynthesizedComponent(reqCtx intctrlutil.RequestCtx,
 		Instances:              comp.Spec.Instances,
 		OfflineInstances:       comp.Spec.OfflineInstances,
 		DisableExporter:        comp.Spec.DisableExporter,
+		DisruptionBudget:       comp.Spec.DisruptionBudget,
 		PodManagementPolicy:    compDef.Spec.PodManagementPolicy,
 	}
 
@@ -373,6 +375,16 @@ func buildHostNetwork(compDef *appsv1alpha1.ComponentDefinition) *appsv1alpha1.H
 	return nil
 }
 
+func buildPasswordRotations(compAccounts []appsv1alpha1.ComponentSystemAccount) map[string]appsv1alpha1.PasswordRotationPolicy {
+	rotations := make(map[string]appsv1alpha1.PasswordRotationPolicy)
+	for _, account := range compAccounts {
+		if account.RotationPolicy != nil {
+			rotations[account.Name] = *account.RotationPolicy
+		}
+	}
+	return rotations
+}
+
 func mergeSystemAccounts(compDefAccounts []appsv1alpha1.SystemAccount,
 	compAccounts []appsv1alpha1.ComponentSystemAccount) []appsv1alpha1.SystemAccount {
 	if len(compAccounts) == 0 {
@@ -428,6 +440,15 @@ func buildVolumeClaimTemplates(synthesizeComp *SynthesizedComponent, comp *appsv
 	if comp.Spec.VolumeClaimTemplates != nil {
 		synthesizeComp.VolumeClaimTemplates = toVolumeClaimTemplates(&comp.Spec)
 	}
+	for _, t := range comp.Spec.VolumeClaimTemplates {
+		if t.Autoscaling == nil {
+			continue
+		}
+		if synthesizeComp.VolumeAutoscalings == nil {
+			synthesizeComp.VolumeAutoscalings = map[string]appsv1alpha1.VolumeAutoscaling{}
+		}
+		synthesizeComp.VolumeAutoscalings[t.Name] = *t.Autoscaling
+	}
 }
 
 func mergeUserDefinedVolumes(synthesizedComp *SynthesizedComponent, comp *appsv1alpha1.Component) error {
@@ -41,6 +41,7 @@ type SynthesizedComponent struct {
 	Resources            corev1.ResourceRequirements            `json:"resources,omitempty"`
 	PodSpec              *corev1.PodSpec                        `json:"podSpec,omitempty"`
 	VolumeClaimTemplates []corev1.PersistentVolumeClaimTemplate `json:"volumeClaimTemplates,omitempty"`
+	VolumeAutoscalings   map[string]v1alpha1.VolumeAutoscaling  `json:"volumeAutoscalings,omitempty"` // {vctName: autoscaling}
 	LogConfigs           []v1alpha1.LogConfig                   `json:"logConfigs,omitempty"`
 	ConfigTemplates      []v1alpha1.ComponentConfigSpec         `json:"configTemplates,omitempty"`
 	ScriptTemplates      []v1alpha1.ComponentTemplateSpec       `json:"scriptTemplates,omitempty"`
@@ -70,6 +71,10 @@ type SynthesizedComponent struct {
 	MinReadySeconds        int32                               `json:"minReadySeconds,omitempty"`
 	Sidecars               []string                            `json:"sidecars,omitempty"`
 	DisableExporter        *bool                               `json:"disableExporter,omitempty"`
+	DisruptionBudget       *v1alpha1.ComponentDisruptionBudget `json:"disruptionBudget,omitempty"`
+
+	// PasswordRotations holds the password rotation policies of system accounts, keyed by the account name.
+	PasswordRotations map[string]v1alpha1.PasswordRotationPolicy `json:"passwordRotations,omitempty"`
 
 	// TODO(xingran): The following fields will be deprecated after KubeBlocks version 0.8.0
 	ClusterDefName        string                          `json:"clusterDefName,omitempty"`     // the name of the clusterDefinition
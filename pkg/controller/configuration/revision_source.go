@@ -0,0 +1,94 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package configuration
+
+import (
+	"encoding/json"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+// RevisionSource records the OpsRequest that changes a configuration item in a revision of the Configuration.
+type RevisionSource struct {
+	Name       string `json:"name"`
+	Revision   string `json:"revision"`
+	OpsRequest string `json:"opsRequest,omitempty"`
+	Operator   string `json:"operator,omitempty"`
+}
+
+// SetRevisionSource marks the next revision of the configuration item as made by the OpsRequest.
+// The caller must patch the Configuration with an optimistic lock, so that the next revision is exactly the next generation.
+func SetRevisionSource(configuration *appsv1alpha1.Configuration, itemName string, opsRequest *appsv1alpha1.OpsRequest) error {
+	b, err := json.Marshal(RevisionSource{
+		Name:       itemName,
+		Revision:   strconv.FormatInt(configuration.GetGeneration()+1, 10),
+		OpsRequest: opsRequest.Name,
+		Operator:   getOpsRequestOperator(opsRequest),
+	})
+	if err != nil {
+		return err
+	}
+	if configuration.Annotations == nil {
+		configuration.Annotations = make(map[string]string)
+	}
+	configuration.Annotations[constant.ConfigRevisionSourceAnnotationKey] = string(b)
+	return nil
+}
+
+// GetRevisionSource returns the source of the revision of the configuration item, or nil if the revision is not made by an OpsRequest.
+func GetRevisionSource(configuration *appsv1alpha1.Configuration, itemName string, revision string) *RevisionSource {
+	data, ok := configuration.GetAnnotations()[constant.ConfigRevisionSourceAnnotationKey]
+	if !ok {
+		return nil
+	}
+	source := &RevisionSource{}
+	if err := json.Unmarshal([]byte(data), source); err != nil {
+		return nil
+	}
+	if source.Name != itemName || source.Revision != revision {
+		return nil
+	}
+	return source
+}
+
+// getOpsRequestOperator returns the user who created the OpsRequest, which is recorded by the mutating webhook.
+// If the webhook is disabled, the earliest field manager of the OpsRequest is returned instead.
+func getOpsRequestOperator(opsRequest *appsv1alpha1.OpsRequest) string {
+	if creator := opsRequest.GetAnnotations()[constant.OpsRequestCreatorAnnotationKey]; creator != "" {
+		return creator
+	}
+	var earliest *metav1.ManagedFieldsEntry
+	for i, field := range opsRequest.GetManagedFields() {
+		if field.Subresource != "" || field.Time == nil {
+			continue
+		}
+		if earliest == nil || field.Time.Before(earliest.Time) {
+			earliest = &opsRequest.ManagedFields[i]
+		}
+	}
+	if earliest == nil {
+		return ""
+	}
+	return earliest.Manager
+}
@@ -31,8 +31,11 @@ import (
 	"github.com/google/uuid"
 	snapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/klog/v2"
 
@@ -406,6 +409,7 @@ func BuildCfgManagerContainer(sidecarRenderedParam *cfgcm.CfgManagerBuildParams)
 		AddArgs(getSidecarBinaryPath(sidecarRenderedParam)).
 		AddArgs(sidecarRenderedParam.Args...).
 		AddEnv(env...).
+		AddEnv(sidecarRenderedParam.Envs...).
 		AddPorts(corev1.ContainerPort{
 			Name:          constant.ConfigManagerPortName,
 			ContainerPort: sidecarRenderedParam.ContainerPort,
@@ -509,3 +513,83 @@ func BuildClusterRoleBinding(cluster *appsv1alpha1.Cluster, saName string) *rbac
 		}).
 		GetObject()
 }
+
+// BuildPodDisruptionBudget builds the PodDisruptionBudget of the component, nil if the component needs no budget.
+// The budget is always expressed as an integer minAvailable, since the InstanceSet doesn't have the scale subresource
+// which the eviction API requires to resolve maxUnavailable and percentages.
+func BuildPodDisruptionBudget(synthesizedComp *component.SynthesizedComponent) (*policyv1.PodDisruptionBudget, error) {
+	if synthesizedComp.DisruptionBudget != nil && synthesizedComp.DisruptionBudget.Disabled {
+		return nil, nil
+	}
+	// a budget of a single replica blocks the node drains forever
+	if synthesizedComp.Replicas < 2 {
+		return nil, nil
+	}
+	minAvailable, err := buildMinAvailable(synthesizedComp)
+	if err != nil {
+		return nil, err
+	}
+	labels := constant.GetComponentWellKnownLabels(synthesizedComp.ClusterName, synthesizedComp.Name)
+	pdbBuilder := builder.NewPodDisruptionBudgetBuilder(synthesizedComp.Namespace,
+		constant.GenerateWorkloadNamePattern(synthesizedComp.ClusterName, synthesizedComp.Name)).
+		AddLabelsInMap(labels).
+		SetSelector(labels)
+	// the leader which can be switched over is guarded by a budget of its own maintained by the InstanceSet,
+	// which switches it over before it can be evicted. leave it out of the shared budget, as the eviction of
+	// a pod covered by multiple budgets is refused, and let the followers be disrupted within the rest of the budget.
+	// the leader which can't be switched over is kept in the budget.
+	if leaderRoles := buildLeaderRoles(synthesizedComp); len(leaderRoles) > 0 &&
+		component.HasSwitchoverAction(synthesizedComp) && !isMultiClusterPlaced(synthesizedComp) {
+		pdbBuilder.AddMatchExpressions(metav1.LabelSelectorRequirement{
+			Key:      constant.RoleLabelKey,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   leaderRoles,
+		})
+		minAvailable = max(minAvailable-1, 0)
+	}
+	return pdbBuilder.SetMinAvailable(intstr.FromInt32(minAvailable)).GetObject(), nil
+}
+
+func buildLeaderRoles(synthesizedComp *component.SynthesizedComponent) []string {
+	var roles []string
+	for _, role := range synthesizedComp.Roles {
+		if role.Serviceable && role.Writable {
+			roles = append(roles, role.Name)
+		}
+	}
+	return roles
+}
+
+// isMultiClusterPlaced checks whether the component is placed in the data-plane k8s clusters,
+// whose budgets are out of reach of the InstanceSet.
+func isMultiClusterPlaced(synthesizedComp *component.SynthesizedComponent) bool {
+	return len(synthesizedComp.Annotations[constant.KBAppMultiClusterPlacementKey]) > 0
+}
+
+func buildMinAvailable(synthesizedComp *component.SynthesizedComponent) (int32, error) {
+	replicas := int(synthesizedComp.Replicas)
+	minAvailable := func() (int, error) {
+		budget := synthesizedComp.DisruptionBudget
+		switch {
+		case budget != nil && budget.MinAvailable != nil:
+			return intstr.GetScaledValueFromIntOrPercent(budget.MinAvailable, replicas, true)
+		case budget != nil && budget.MaxUnavailable != nil:
+			maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(budget.MaxUnavailable, replicas, false)
+			return replicas - maxUnavailable, err
+		}
+		for _, role := range synthesizedComp.Roles {
+			// keep a quorum of the replicas available for the consensus component
+			if role.Votable {
+				return replicas/2 + 1, nil
+			}
+		}
+		// disrupt one replica at a time, so the primary and its only replica can't be evicted together.
+		// with the guarded primary left out of the budget, the followers are disrupted one at a time.
+		return replicas - 1, nil
+	}
+	value, err := minAvailable()
+	if err != nil {
+		return 0, err
+	}
+	return int32(min(max(value, 0), replicas)), nil
+}
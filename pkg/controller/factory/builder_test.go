@@ -30,6 +30,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
@@ -449,5 +450,87 @@ var _ = Describe("builder", func() {
 			Expect(crb).ShouldNot(BeNil())
 			Expect(crb.Name).Should(Equal(expectName))
 		})
+
+		It("builds PodDisruptionBudget correctly", func() {
+			synthesizedComp := &component.SynthesizedComponent{
+				Namespace:   testCtx.DefaultNamespace,
+				ClusterName: "test-cluster",
+				Name:        "test-comp",
+				Replicas:    5,
+				Roles:       []appsv1alpha1.ReplicaRole{{Name: "leader", Votable: true}, {Name: "follower", Votable: true}},
+			}
+			minAvailable := func() int32 {
+				pdb, err := BuildPodDisruptionBudget(synthesizedComp)
+				Expect(err).Should(BeNil())
+				if pdb == nil {
+					return -1
+				}
+				Expect(pdb.Name).Should(Equal("test-cluster-test-comp"))
+				Expect(pdb.Spec.Selector.MatchLabels).Should(HaveKeyWithValue(constant.KBAppComponentLabelKey, "test-comp"))
+				return pdb.Spec.MinAvailable.IntVal
+			}
+
+			By("keep a quorum for the consensus component")
+			Expect(minAvailable()).Should(BeEquivalentTo(3))
+
+			By("disrupt one replica at a time for the primary-secondary component")
+			synthesizedComp.Roles = []appsv1alpha1.ReplicaRole{{Name: "primary", Writable: true}, {Name: "secondary"}}
+			synthesizedComp.Replicas = 2
+			Expect(minAvailable()).Should(BeEquivalentTo(1))
+
+			By("leave the leader guarded by the instance set out of the budget if it can be switched over")
+			synthesizedComp.Roles = []appsv1alpha1.ReplicaRole{{Name: "primary", Serviceable: true, Writable: true}, {Name: "secondary", Serviceable: true}}
+			synthesizedComp.Replicas = 3
+			synthesizedComp.LifecycleActions = &appsv1alpha1.ComponentLifecycleActions{
+				Switchover: &appsv1alpha1.ComponentSwitchover{
+					WithCandidate: &appsv1alpha1.Action{Exec: &appsv1alpha1.ExecAction{Command: []string{"switchover"}}},
+				},
+			}
+			Expect(minAvailable()).Should(BeEquivalentTo(1))
+			pdb, _ := BuildPodDisruptionBudget(synthesizedComp)
+			Expect(pdb.Spec.Selector.MatchExpressions).Should(ConsistOf(metav1.LabelSelectorRequirement{
+				Key:      constant.RoleLabelKey,
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{"primary"},
+			}))
+			synthesizedComp.Replicas = 2
+			Expect(minAvailable()).Should(BeEquivalentTo(0))
+
+			By("keep the leader in the budget of the multi-cluster component")
+			synthesizedComp.Annotations = map[string]string{constant.KBAppMultiClusterPlacementKey: "cluster-a"}
+			Expect(minAvailable()).Should(BeEquivalentTo(1))
+			synthesizedComp.Annotations = nil
+
+			By("keep the leader in the budget if it can't be switched over")
+			synthesizedComp.LifecycleActions = nil
+			Expect(minAvailable()).Should(BeEquivalentTo(1))
+			synthesizedComp.Replicas = 3
+			Expect(minAvailable()).Should(BeEquivalentTo(2))
+			pdb, _ = BuildPodDisruptionBudget(synthesizedComp)
+			Expect(pdb.Spec.Selector.MatchExpressions).Should(BeEmpty())
+
+			By("no budget for a single replica")
+			synthesizedComp.Replicas = 1
+			Expect(minAvailable()).Should(BeEquivalentTo(-1))
+
+			By("override the budget")
+			synthesizedComp.Replicas = 4
+			maxUnavailable := intstr.FromString("50%")
+			synthesizedComp.DisruptionBudget = &appsv1alpha1.ComponentDisruptionBudget{MaxUnavailable: &maxUnavailable}
+			Expect(minAvailable()).Should(BeEquivalentTo(2))
+			minAvail := intstr.FromString("30%")
+			synthesizedComp.DisruptionBudget = &appsv1alpha1.ComponentDisruptionBudget{MinAvailable: &minAvail}
+			Expect(minAvailable()).Should(BeEquivalentTo(2))
+			synthesizedComp.LifecycleActions = &appsv1alpha1.ComponentLifecycleActions{
+				Switchover: &appsv1alpha1.ComponentSwitchover{
+					WithCandidate: &appsv1alpha1.Action{Exec: &appsv1alpha1.ExecAction{Command: []string{"switchover"}}},
+				},
+			}
+			Expect(minAvailable()).Should(BeEquivalentTo(1))
+			synthesizedComp.Roles = nil
+			Expect(minAvailable()).Should(BeEquivalentTo(2))
+			synthesizedComp.DisruptionBudget = &appsv1alpha1.ComponentDisruptionBudget{Disabled: true}
+			Expect(minAvailable()).Should(BeEquivalentTo(-1))
+		})
 	})
 })
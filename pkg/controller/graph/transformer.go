@@ -22,6 +22,7 @@ package graph
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/client-go/tools/record"
@@ -56,7 +57,8 @@ func (r TransformerChain) ApplyTo(ctx TransformContext, dag *DAG) error {
 	for _, transformer := range r {
 		if err := transformer.Transform(ctx, dag); err != nil {
 			if intctrlutil.IsDelayedRequeueError(err) {
-				if delayedError == nil {
+				// keep the one which requeues soonest
+				if delayedError == nil || requeueAfter(err) < requeueAfter(delayedError) {
 					delayedError = err
 				}
 				continue
@@ -67,6 +69,13 @@ func (r TransformerChain) ApplyTo(ctx TransformContext, dag *DAG) error {
 	return delayedError
 }
 
+func requeueAfter(err error) time.Duration {
+	if re, ok := err.(intctrlutil.RequeueError); ok {
+		return re.RequeueAfter()
+	}
+	return 0
+}
+
 func ignoredIfPrematureStop(err error) error {
 	if err == ErrPrematureStop {
 		return nil
@@ -0,0 +1,82 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package graph
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+)
+
+type testTransformer struct {
+	err    error
+	called *int
+}
+
+func (t *testTransformer) Transform(_ TransformContext, _ *DAG) error {
+	*t.called++
+	return t.err
+}
+
+func TestTransformerChainApplyTo(t *testing.T) {
+	var (
+		// the component transformer of the cluster chain retries immediately when the components are not ready
+		componentsNotReady = intctrlutil.NewDelayedRequeueError(0, "components are not ready")
+		// the standby transformer of the cluster chain probes the replication lag periodically
+		standbyLagProbe = intctrlutil.NewDelayedRequeueError(30*time.Second, "probe lag")
+		// the TLS transformer of the component chain waits to renew the certificates
+		tlsRenewal = intctrlutil.NewDelayedRequeueError(time.Hour, "renew certificates")
+		// the status transformer of the component chain waits for the workload
+		statusCheck = intctrlutil.NewDelayedRequeueError(time.Second, "check status")
+		fatal       = errors.New("fatal")
+	)
+	cases := []struct {
+		name       string
+		errs       []error
+		wantErr    error
+		wantCalled int
+	}{
+		{"no error", []error{nil, nil}, nil, 2},
+		{"cluster chain requeues immediately", []error{componentsNotReady, nil, standbyLagProbe}, componentsNotReady, 3},
+		{"cluster chain requeues to probe lag", []error{nil, standbyLagProbe, nil}, standbyLagProbe, 3},
+		{"component chain keeps the soonest requeue", []error{tlsRenewal, nil, statusCheck}, statusCheck, 3},
+		{"component chain keeps the soonest requeue regardless of order", []error{statusCheck, tlsRenewal}, statusCheck, 2},
+		{"error stops the chain", []error{tlsRenewal, fatal, statusCheck}, fatal, 2},
+		{"premature stop stops the chain", []error{nil, ErrPrematureStop, statusCheck}, nil, 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			called := 0
+			var chain TransformerChain
+			for _, err := range c.errs {
+				chain = append(chain, &testTransformer{err: err, called: &called})
+			}
+			err := chain.ApplyTo(nil, NewDAG())
+			if err != c.wantErr {
+				t.Errorf("unexpected error: %v, expected: %v", err, c.wantErr)
+			}
+			if called != c.wantCalled {
+				t.Errorf("unexpected transformers called: %d, expected: %d", called, c.wantCalled)
+			}
+		})
+	}
+}
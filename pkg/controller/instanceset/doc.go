@@ -33,5 +33,6 @@ InstanceSet provides the following features:
 5. Multiple Instance Templates
 6. Specified Instance Scale In
 7. In-place Instance Update
+8. Leader Switchover on Node Drain
 */
 package instanceset
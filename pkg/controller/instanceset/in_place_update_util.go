@@ -58,6 +58,14 @@ func filterInPlaceFields(src *corev1.PodTemplateSpec) *corev1.PodTemplateSpec {
 		if restart, ok := template.Annotations[constant.RestartAnnotationKey]; ok {
 			annotations[constant.RestartAnnotationKey] = restart
 		}
+		// keep TLS cert serial annotation, the pods should be recreated to load the renewed certificates
+		if serial, ok := template.Annotations[constant.TLSCertSerialAnnotationKey]; ok {
+			annotations[constant.TLSCertSerialAnnotationKey] = serial
+		}
+		// keep credential rotation annotation, the pods should be recreated to load the rotated passwords
+		if rotatedAt, ok := template.Annotations[constant.CredentialRotatedAtAnnotationKey]; ok {
+			annotations[constant.CredentialRotatedAtAnnotationKey] = rotatedAt
+		}
 		// keep Reconfigure annotation
 		for k, v := range template.Annotations {
 			if strings.HasPrefix(k, constant.UpgradeRestartAnnotationKey) {
@@ -0,0 +1,434 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package instanceset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+	"github.com/apecloud/kubeblocks/pkg/controller/model"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
+)
+
+const (
+	// toBeDeletedTaint is the taint set by the cluster-autoscaler on the nodes it is scaling down.
+	toBeDeletedTaint = "ToBeDeletedByClusterAutoscaler"
+
+	switchoverRetryInterval   = 10 * time.Second
+	switchoverJobTTLSeconds   = 300
+	switchoverJobBackoffLimit = 2
+)
+
+// leaderEvictionReconciler protects the leader from being evicted by node drains.
+//
+// The leader is guarded by a PodDisruptionBudget which selects the leader pod only,
+// so the evictions of it are refused and retried by `kubectl drain` and the cluster-autoscaler.
+// Once the node of the leader is cordoned or marked to be scaled down, the leader is switched over
+// to the healthiest follower by the SwitchoverAction, which runs in a Job. If the Job fails, the switchover
+// falls back to the lorry in the leader pod. The old leader then falls out of the budget, and the pending
+// eviction goes through. If neither works, the leader is kept until it is switched over manually.
+type leaderEvictionReconciler struct {
+	ctx    context.Context
+	reader client.Reader
+}
+
+func NewLeaderEvictionReconciler(ctx context.Context, reader client.Reader) kubebuilderx.Reconciler {
+	return &leaderEvictionReconciler{ctx: ctx, reader: reader}
+}
+
+func (r *leaderEvictionReconciler) PreCondition(tree *kubebuilderx.ObjectTree) *kubebuilderx.CheckResult {
+	if tree.GetRoot() == nil || model.IsObjectDeleting(tree.GetRoot()) {
+		return kubebuilderx.ResultUnsatisfied
+	}
+	if model.IsReconciliationPaused(tree.GetRoot()) {
+		return kubebuilderx.ResultUnsatisfied
+	}
+	return kubebuilderx.ResultSatisfied
+}
+
+func (r *leaderEvictionReconciler) Reconcile(tree *kubebuilderx.ObjectTree) (*kubebuilderx.ObjectTree, error) {
+	its, _ := tree.GetRoot().(*workloads.InstanceSet)
+
+	guarded := isLeaderGuarded(its)
+	if err := r.reconcileLeaderPDB(tree, its, guarded); err != nil {
+		return nil, err
+	}
+	if !guarded {
+		return tree, nil
+	}
+	return tree, r.reconcileSwitchover(tree, its)
+}
+
+func (r *leaderEvictionReconciler) reconcileLeaderPDB(tree *kubebuilderx.ObjectTree, its *workloads.InstanceSet, guarded bool) error {
+	pdb := buildLeaderPDB(its)
+	oldObj, err := tree.Get(pdb)
+	if err != nil {
+		return err
+	}
+	switch {
+	case guarded && oldObj == nil:
+		if err = intctrlutil.SetOwnership(its, pdb, model.GetScheme(), finalizer); err != nil {
+			return err
+		}
+		return tree.Add(pdb)
+	case guarded:
+		oldPDB, _ := oldObj.(*policyv1.PodDisruptionBudget)
+		if equality.Semantic.DeepEqual(oldPDB.Spec, pdb.Spec) {
+			return nil
+		}
+		newPDB := oldPDB.DeepCopy()
+		newPDB.Spec = pdb.Spec
+		return tree.Update(newPDB)
+	case oldObj != nil:
+		return tree.Delete(oldObj)
+	}
+	return nil
+}
+
+func (r *leaderEvictionReconciler) reconcileSwitchover(tree *kubebuilderx.ObjectTree, its *workloads.InstanceSet) error {
+	roleMap := composeRoleMap(*its)
+	var pods []*corev1.Pod
+	for _, object := range tree.List(&corev1.Pod{}) {
+		pods = append(pods, object.(*corev1.Pod))
+	}
+	var leader *corev1.Pod
+	for _, pod := range pods {
+		if role, ok := roleMap[getRoleName(pod)]; ok && role.IsLeader && !isTerminating(pod) {
+			leader = pod
+			break
+		}
+	}
+	if leader == nil {
+		return nil
+	}
+	drainingNodes, err := r.getDrainingNodes(pods)
+	if err != nil {
+		return err
+	}
+	if !drainingNodes[leader.Spec.NodeName] {
+		return nil
+	}
+
+	job := &batchv1.Job{}
+	job.Namespace = its.Namespace
+	job.Name = getSwitchoverJobName(leader.Name)
+	object, err := tree.Get(job)
+	if err != nil {
+		return err
+	}
+	// the finished job will be cleaned up after its TTL, the action is retried then if the leader is still there.
+	if object != nil && !isJobFailed(object.(*batchv1.Job)) {
+		return nil
+	}
+
+	candidate := selectSwitchoverCandidate(roleMap, pods, leader, drainingNodes)
+	if candidate == nil {
+		tree.EventRecorder.Eventf(its, corev1.EventTypeWarning, EventReasonSwitchoverFailed,
+			"node %s of the leader %s is being drained, but there is no healthy follower to switch over to", leader.Spec.NodeName, leader.Name)
+		return nil
+	}
+	if object != nil {
+		return r.switchoverByLorry(tree, its, leader, candidate, job.Name)
+	}
+	job = buildSwitchoverJob(its, leader, candidate)
+	if err = intctrlutil.SetOwnership(its, job, model.GetScheme(), ""); err != nil {
+		return err
+	}
+	tree.EventRecorder.Eventf(its, corev1.EventTypeNormal, EventReasonSwitchover,
+		"node %s is being drained, switching the leader %s over to %s", leader.Spec.NodeName, leader.Name, candidate.Name)
+	return tree.Add(job)
+}
+
+// switchoverByLorry switches the leader over by the lorry in the leader pod, once the switchover job failed.
+func (r *leaderEvictionReconciler) switchoverByLorry(tree *kubebuilderx.ObjectTree, its *workloads.InstanceSet, leader, candidate *corev1.Pod, jobName string) error {
+	lorryCli, err := lorry.NewClient(r.ctx, r.reader, *leader)
+	if err != nil {
+		return err
+	}
+	if intctrlutil.IsNil(lorryCli) {
+		tree.EventRecorder.Eventf(its, corev1.EventTypeWarning, EventReasonSwitchoverFailed,
+			"failed to switch the leader %s over, check the job %s for details, and switch it over manually", leader.Name, jobName)
+		return nil
+	}
+	err = lorryCli.Switchover(r.ctx, leader.Name, candidate.Name, false)
+	switch {
+	case err == nil:
+		// the role labels are updated once the switchover is done, the leader falls out of the budget then.
+		tree.EventRecorder.Eventf(its, corev1.EventTypeNormal, EventReasonSwitchover,
+			"the job %s failed, switching the leader %s over to %s by lorry", jobName, leader.Name, candidate.Name)
+		return nil
+	case strings.Contains(err.Error(), "another switchover"):
+		// the switchover is in progress.
+		return nil
+	case err == lorry.NotImplemented || strings.Contains(err.Error(), "cluster's ha is disabled"):
+		tree.EventRecorder.Eventf(its, corev1.EventTypeWarning, EventReasonSwitchoverFailed,
+			"failed to switch the leader %s over, check the job %s for details, and switch it over manually", leader.Name, jobName)
+		return nil
+	default:
+		return intctrlutil.NewDelayedRequeueError(switchoverRetryInterval,
+			fmt.Sprintf("failed to switch the leader %s over to %s: %s", leader.Name, candidate.Name, err.Error()))
+	}
+}
+
+// getDrainingNodes looks the nodes of the pods up, and returns the ones being drained.
+func (r *leaderEvictionReconciler) getDrainingNodes(pods []*corev1.Pod) (map[string]bool, error) {
+	drainingNodes := make(map[string]bool)
+	for _, pod := range pods {
+		nodeName := pod.Spec.NodeName
+		if len(nodeName) == 0 {
+			continue
+		}
+		if _, ok := drainingNodes[nodeName]; ok {
+			continue
+		}
+		node := &corev1.Node{}
+		if err := r.reader.Get(r.ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				drainingNodes[nodeName] = false
+				continue
+			}
+			return nil, err
+		}
+		drainingNodes[nodeName] = isNodeDraining(node)
+	}
+	return drainingNodes, nil
+}
+
+// isLeaderGuarded checks whether the leader of the InstanceSet can be switched over before it is evicted.
+func isLeaderGuarded(its *workloads.InstanceSet) bool {
+	if its.Spec.MembershipReconfiguration == nil || its.Spec.MembershipReconfiguration.SwitchoverAction == nil {
+		return false
+	}
+	// there is nothing to switch over to.
+	if its.Spec.Replicas == nil || *its.Spec.Replicas < 2 {
+		return false
+	}
+	// the nodes and budgets of the data-plane k8s clusters are out of reach.
+	if len(its.Annotations[constant.KBAppMultiClusterPlacementKey]) > 0 {
+		return false
+	}
+	return len(getLeaderRoleNames(its)) > 0
+}
+
+func getLeaderRoleNames(its *workloads.InstanceSet) []string {
+	var roles []string
+	for _, role := range its.Spec.Roles {
+		if role.IsLeader && len(role.Name) > 0 {
+			roles = append(roles, role.Name)
+		}
+	}
+	return roles
+}
+
+func getLeaderPDBName(itsName string) string {
+	return fmt.Sprintf("%s-leader", itsName)
+}
+
+func getSwitchoverJobName(leaderName string) string {
+	return fmt.Sprintf("%s-switchover", leaderName)
+}
+
+func buildLeaderPDB(its *workloads.InstanceSet) *policyv1.PodDisruptionBudget {
+	labels := getMatchLabels(its.Name)
+	return builder.NewPodDisruptionBudgetBuilder(its.Namespace, getLeaderPDBName(its.Name)).
+		AddLabelsInMap(labels).
+		SetSelector(labels).
+		AddMatchExpressions(metav1.LabelSelectorRequirement{
+			Key:      constant.RoleLabelKey,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   getLeaderRoleNames(its),
+		}).
+		SetMinAvailable(intstr.FromInt32(1)).
+		// an unhealthy leader is not worth a switchover, let it go.
+		SetUnhealthyPodEvictionPolicy(policyv1.AlwaysAllow).
+		GetObject()
+}
+
+func buildSwitchoverJob(its *workloads.InstanceSet, leader, candidate *corev1.Pod) *batchv1.Job {
+	action := its.Spec.MembershipReconfiguration.SwitchoverAction
+	image := action.Image
+	if len(image) == 0 {
+		image = defaultActionImage
+	}
+	leaderFQDN := getPodFQDN(its, leader.Name)
+	candidateFQDN := getPodFQDN(its, candidate.Name)
+
+	// run the action with the env, scripts and configs of the leader, the switchover scripts of the engines rely on them.
+	var env []corev1.EnvVar
+	var envFrom []corev1.EnvFromSource
+	var volumeMounts []corev1.VolumeMount
+	volumes := getSwitchoverVolumes(leader)
+	if len(leader.Spec.Containers) > 0 {
+		container := leader.Spec.Containers[0]
+		env = append(env, container.Env...)
+		envFrom = append(envFrom, container.EnvFrom...)
+		for _, mount := range container.VolumeMounts {
+			if volumes[mount.Name] {
+				volumeMounts = append(volumeMounts, mount)
+			}
+		}
+	}
+	if credential := its.Spec.Credential; credential != nil {
+		env = append(env,
+			corev1.EnvVar{Name: usernameCredentialVarName, Value: credential.Username.Value, ValueFrom: credential.Username.ValueFrom},
+			corev1.EnvVar{Name: passwordCredentialVarName, Value: credential.Password.Value, ValueFrom: credential.Password.ValueFrom})
+	}
+	if servicePort := findSvcPort(its); servicePort > 0 {
+		env = append(env, corev1.EnvVar{Name: servicePortVarName, Value: fmt.Sprintf("%d", servicePort)})
+	}
+	env = append(env,
+		corev1.EnvVar{Name: leaderHostVarName, Value: leaderFQDN},
+		corev1.EnvVar{Name: targetHostVarName, Value: candidateFQDN},
+		corev1.EnvVar{Name: leaderPodNameVarName, Value: leader.Name},
+		corev1.EnvVar{Name: leaderPodFQDNVarName, Value: leaderFQDN},
+		corev1.EnvVar{Name: candidateNameVarName, Value: candidate.Name},
+		corev1.EnvVar{Name: candidateFQDNVarName, Value: candidateFQDN})
+
+	var podVolumes []corev1.Volume
+	for _, volume := range leader.Spec.Volumes {
+		if volumes[volume.Name] {
+			podVolumes = append(podVolumes, volume)
+		}
+	}
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			RestartPolicy:      corev1.RestartPolicyNever,
+			ServiceAccountName: leader.Spec.ServiceAccountName,
+			Tolerations:        leader.Spec.Tolerations,
+			Volumes:            podVolumes,
+			Containers: []corev1.Container{{
+				Name:            "switchover",
+				Image:           image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         action.Command,
+				Args:            action.Args,
+				Env:             env,
+				EnvFrom:         envFrom,
+				VolumeMounts:    volumeMounts,
+			}},
+		},
+	}
+	intctrlutil.InjectZeroResourcesLimitsIfEmpty(&template.Spec.Containers[0])
+	return builder.NewJobBuilder(its.Namespace, getSwitchoverJobName(leader.Name)).
+		AddLabelsInMap(getMatchLabels(its.Name)).
+		SetPodTemplateSpec(template).
+		SetBackoffLimit(switchoverJobBackoffLimit).
+		SetTTLSecondsAfterFinished(switchoverJobTTLSeconds).
+		GetObject()
+}
+
+// getSwitchoverVolumes returns the volumes of the leader which can be shared with the switchover job,
+// the scripts and configs are mounted from them, while the data volumes are left to the leader.
+func getSwitchoverVolumes(leader *corev1.Pod) map[string]bool {
+	volumes := make(map[string]bool)
+	for _, volume := range leader.Spec.Volumes {
+		source := volume.VolumeSource
+		if source.ConfigMap != nil || source.Secret != nil || source.Projected != nil || source.DownwardAPI != nil {
+			volumes[volume.Name] = true
+		}
+	}
+	return volumes
+}
+
+func getPodFQDN(its *workloads.InstanceSet, podName string) string {
+	return fmt.Sprintf("%s.%s.%s.svc", podName, getHeadlessSvcName(its.Name), its.Namespace)
+}
+
+// selectSwitchoverCandidate picks the healthiest follower out of the draining nodes,
+// the voters are preferred, and then the ones ready for the longest time.
+func selectSwitchoverCandidate(roleMap map[string]workloads.ReplicaRole, pods []*corev1.Pod, leader *corev1.Pod, drainingNodes map[string]bool) *corev1.Pod {
+	var candidates []*corev1.Pod
+	for _, pod := range pods {
+		if pod.Name == leader.Name || !isHealthy(pod) || drainingNodes[pod.Spec.NodeName] {
+			continue
+		}
+		role, ok := roleMap[getRoleName(pod)]
+		if !ok || role.IsLeader {
+			continue
+		}
+		candidates = append(candidates, pod)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		voterI, voterJ := roleMap[getRoleName(candidates[i])].CanVote, roleMap[getRoleName(candidates[j])].CanVote
+		if voterI != voterJ {
+			return voterI
+		}
+		readyI, readyJ := getReadySince(candidates[i]), getReadySince(candidates[j])
+		if !readyI.Equal(&readyJ) {
+			return readyI.Before(&readyJ)
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates[0]
+}
+
+func getReadySince(pod *corev1.Pod) metav1.Time {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.LastTransitionTime
+		}
+	}
+	return metav1.Time{}
+}
+
+// isNodeDraining checks whether the node is cordoned, or marked to be scaled down by the cluster-autoscaler.
+func isNodeDraining(node *corev1.Node) bool {
+	if node.Spec.Unschedulable || model.IsObjectDeleting(node) {
+		return true
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == toBeDeletedTaint {
+			return true
+		}
+	}
+	return false
+}
+
+func isJobFailed(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+var _ kubebuilderx.Reconciler = &leaderEvictionReconciler{}
@@ -0,0 +1,270 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package instanceset
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workloads "github.com/apecloud/kubeblocks/apis/workloads/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/kubebuilderx"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	lorry "github.com/apecloud/kubeblocks/pkg/lorry/client"
+)
+
+var _ = Describe("leader eviction reconciler test", func() {
+	newPod := func(ordinal, role string, readySince time.Time) *corev1.Pod {
+		p := builder.NewPodBuilder(namespace, name+"-"+ordinal).
+			AddLabelsInMap(getMatchLabels(name)).
+			AddLabels(RoleLabelKey, role).
+			SetNodeName(types.NodeName("node-"+ordinal)).
+			AddContainer(corev1.Container{
+				Name:  "foo",
+				Image: "bar",
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "scripts", MountPath: "/scripts"},
+					{Name: "data", MountPath: "/data"},
+				},
+			}).
+			AddVolumes(
+				corev1.Volume{Name: "scripts", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{}}},
+				corev1.Volume{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{}}},
+			).
+			GetObject()
+		p.Status.Phase = corev1.PodRunning
+		p.Status.Conditions = []corev1.PodCondition{{
+			Type:               corev1.PodReady,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(readySince),
+		}}
+		return p
+	}
+
+	var (
+		tree     *kubebuilderx.ObjectTree
+		recorder *record.FakeRecorder
+		lorryCli *lorry.MockClient
+	)
+
+	BeforeEach(func() {
+		its = builder.NewInstanceSetBuilder(namespace, name).
+			SetUID(uid).
+			SetReplicas(3).
+			AddMatchLabelsInMap(selectors).
+			SetTemplate(template).
+			SetRoles(roles).
+			SetMembershipReconfiguration(&workloads.MembershipReconfiguration{
+				SwitchoverAction: &workloads.Action{Command: []string{"switchover"}},
+			}).
+			SetCredential(credential).
+			GetObject()
+
+		now := time.Now()
+		tree = kubebuilderx.NewObjectTree()
+		tree.SetRoot(its)
+		recorder = record.NewFakeRecorder(10)
+		tree.EventRecorder = recorder
+		Expect(tree.Add(
+			newPod("0", "leader", now.Add(-time.Hour)),
+			newPod("1", "follower", now),
+			newPod("2", "learner", now.Add(-time.Hour)),
+		)).Should(Succeed())
+
+		lorryCli = lorry.NewMockClient(controller)
+		lorry.SetMockClient(lorryCli, nil)
+		DeferCleanup(lorry.UnsetMockClient)
+	})
+
+	// newReconciler builds the reconciler reading the nodes, the ones cordoned are being drained.
+	newReconciler := func(cordoned ...string) kubebuilderx.Reconciler {
+		var nodes []client.Object
+		for _, ordinal := range []string{"0", "1", "2"} {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-" + ordinal}}
+			for _, c := range cordoned {
+				if c == ordinal {
+					node.Spec.Unschedulable = true
+				}
+			}
+			nodes = append(nodes, node)
+		}
+		return NewLeaderEvictionReconciler(ctx, fake.NewClientBuilder().WithObjects(nodes...).Build())
+	}
+	getJob := func(leaderName string) *batchv1.Job {
+		job := builder.NewJobBuilder(namespace, getSwitchoverJobName(leaderName)).GetObject()
+		object, err := tree.Get(job)
+		Expect(err).Should(BeNil())
+		if object == nil {
+			return nil
+		}
+		return object.(*batchv1.Job)
+	}
+	getEnv := func(job *batchv1.Job, name string) string {
+		for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+			if env.Name == name {
+				return env.Value
+			}
+		}
+		return ""
+	}
+	failJob := func(job *batchv1.Job) {
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+		Expect(tree.Update(job)).Should(Succeed())
+	}
+
+	Context("PreCondition & Reconcile", func() {
+		It("should guard the leader with a pod disruption budget", func() {
+			reconciler = newReconciler()
+			Expect(reconciler.PreCondition(tree)).Should(Equal(kubebuilderx.ResultSatisfied))
+
+			_, err := reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			object, err := tree.Get(builder.NewPodDisruptionBudgetBuilder(namespace, getLeaderPDBName(name)).GetObject())
+			Expect(err).Should(BeNil())
+			Expect(object).ShouldNot(BeNil())
+			pdb := object.(*policyv1.PodDisruptionBudget)
+			Expect(pdb.Spec.MinAvailable.IntValue()).Should(Equal(1))
+			Expect(pdb.Spec.Selector.MatchLabels).Should(Equal(getMatchLabels(name)))
+			Expect(pdb.Spec.Selector.MatchExpressions).Should(HaveLen(1))
+			Expect(pdb.Spec.Selector.MatchExpressions[0].Values).Should(Equal([]string{"leader"}))
+			Expect(getJob(name + "-0")).Should(BeNil())
+
+			By("delete the budget if there is nothing to switch over to")
+			its.Spec.Replicas = pointer.Int32(1)
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			object, err = tree.Get(pdb)
+			Expect(err).Should(BeNil())
+			Expect(object).Should(BeNil())
+
+			By("delete the budget if the leader can't be switched over")
+			its.Spec.Replicas = pointer.Int32(3)
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(tree.List(&policyv1.PodDisruptionBudget{})).Should(HaveLen(1))
+			its.Spec.MembershipReconfiguration = nil
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			object, err = tree.Get(pdb)
+			Expect(err).Should(BeNil())
+			Expect(object).Should(BeNil())
+		})
+
+		It("should switch the leader over by the switchover action when its node is being drained", func() {
+			reconciler = newReconciler("0")
+			_, err := reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeNormal + " " + EventReasonSwitchover)))
+			job := getJob(name + "-0")
+			Expect(job).ShouldNot(BeNil())
+			Expect(job.Labels).Should(Equal(getMatchLabels(name)))
+			podSpec := job.Spec.Template.Spec
+			Expect(podSpec.Containers[0].Image).Should(Equal(defaultActionImage))
+			Expect(podSpec.Containers[0].Command).Should(Equal([]string{"switchover"}))
+			// the scripts are mounted, while the data is left to the leader
+			Expect(podSpec.Volumes).Should(HaveLen(1))
+			Expect(podSpec.Volumes[0].Name).Should(Equal("scripts"))
+			Expect(podSpec.Containers[0].VolumeMounts).Should(Equal([]corev1.VolumeMount{{Name: "scripts", MountPath: "/scripts"}}))
+			// the voter is preferred to the learner ready for a longer time
+			Expect(getEnv(job, candidateNameVarName)).Should(Equal(name + "-1"))
+			Expect(getEnv(job, leaderHostVarName)).Should(Equal(getPodFQDN(its, name+"-0")))
+			Expect(getEnv(job, targetHostVarName)).Should(Equal(getPodFQDN(its, name+"-1")))
+
+			By("wait for the running switchover")
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(getJob(name + "-0")).Should(Equal(job))
+			Expect(recorder.Events).ShouldNot(Receive())
+		})
+
+		It("should fall back to lorry once the switchover action failed", func() {
+			reconciler = newReconciler("0")
+			_, err := reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeNormal + " " + EventReasonSwitchover)))
+			failJob(getJob(name + "-0"))
+
+			lorryCli.EXPECT().Switchover(gomock.Any(), name+"-0", name+"-1", false).Return(nil).Times(1)
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeNormal + " " + EventReasonSwitchover)))
+
+			By("wait for the running switchover")
+			lorryCli.EXPECT().Switchover(gomock.Any(), name+"-0", name+"-1", false).
+				Return(errors.New("there is another switchover bar-switchover unfinished")).Times(1)
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).ShouldNot(Receive())
+
+			By("retry the failed switchover")
+			lorryCli.EXPECT().Switchover(gomock.Any(), name+"-0", name+"-1", false).
+				Return(errors.New("candidate bar-1 is unhealthy")).Times(1)
+			_, err = reconciler.Reconcile(tree)
+			Expect(intctrlutil.IsDelayedRequeueError(err)).Should(BeTrue())
+
+			By("leave the leader to be switched over manually if lorry can't do it")
+			lorryCli.EXPECT().Switchover(gomock.Any(), name+"-0", name+"-1", false).
+				Return(errors.New("cluster's ha is disabled")).Times(1)
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeWarning + " " + EventReasonSwitchoverFailed)))
+			lorry.SetMockClient(nil, nil)
+			_, err = reconciler.Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeWarning + " " + EventReasonSwitchoverFailed)))
+		})
+
+		It("should skip the followers on the draining nodes", func() {
+			_, err := newReconciler("0", "1").Reconcile(tree)
+			Expect(err).Should(BeNil())
+			job := getJob(name + "-0")
+			Expect(job).ShouldNot(BeNil())
+			Expect(getEnv(job, candidateNameVarName)).Should(Equal(name + "-2"))
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeNormal + " " + EventReasonSwitchover)))
+
+			By("no switchover without healthy followers")
+			Expect(tree.Delete(job)).Should(Succeed())
+			_, err = newReconciler("0", "1", "2").Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(getJob(name + "-0")).Should(BeNil())
+			Expect(recorder.Events).Should(Receive(HavePrefix(corev1.EventTypeWarning + " " + EventReasonSwitchoverFailed)))
+		})
+
+		It("should leave the leader alone when its node is not being drained", func() {
+			_, err := newReconciler("1").Reconcile(tree)
+			Expect(err).Should(BeNil())
+			Expect(recorder.Events).ShouldNot(Receive())
+		})
+	})
+})
@@ -28,6 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -89,6 +90,11 @@ var _ = Describe("tree loader test", func() {
 				DoAndReturn(func(_ context.Context, list *batchv1.JobList, _ ...client.ListOption) error {
 					return nil
 				}).Times(1)
+			k8sMock.EXPECT().
+				List(gomock.Any(), &policyv1.PodDisruptionBudgetList{}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, list *policyv1.PodDisruptionBudgetList, _ ...client.ListOption) error {
+					return nil
+				}).Times(1)
 			k8sMock.EXPECT().
 				Get(gomock.Any(), gomock.Any(), &corev1.ConfigMap{}, gomock.Any()).
 				DoAndReturn(func(_ context.Context, objKey client.ObjectKey, obj *corev1.ConfigMap, _ ...client.GetOption) error {
@@ -52,6 +52,10 @@ const (
 	actionSvcListVarName         = "KB_RSM_ACTION_SVC_LIST"
 	leaderHostVarName            = "KB_RSM_LEADER_HOST"
 	targetHostVarName            = "KB_RSM_TARGET_HOST"
+	leaderPodNameVarName         = "KB_LEADER_POD_NAME"
+	leaderPodFQDNVarName         = "KB_LEADER_POD_FQDN"
+	candidateNameVarName         = "KB_SWITCHOVER_CANDIDATE_NAME"
+	candidateFQDNVarName         = "KB_SWITCHOVER_CANDIDATE_FQDN"
 	RoleUpdateMechanismVarName   = "KB_RSM_ROLE_UPDATE_MECHANISM"
 	roleProbeTimeoutVarName      = "KB_RSM_ROLE_PROBE_TIMEOUT"
 	readinessProbeEventFieldPath = "spec.containers{" + roleProbeContainerName + "}"
@@ -62,7 +66,9 @@ const (
 )
 
 const (
-	EventReasonInvalidSpec = "InvalidSpec"
+	EventReasonInvalidSpec      = "InvalidSpec"
+	EventReasonSwitchover       = "Switchover"
+	EventReasonSwitchoverFailed = "SwitchoverFailed"
 )
 
 const (
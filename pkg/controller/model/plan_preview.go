@@ -0,0 +1,158 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pmezard/go-difflib/difflib"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+)
+
+// ObjectChange describes a change of an object planned by a reconciliation plan.
+type ObjectChange struct {
+	Action    Action `json:"action"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Diff is the unified diff between the current and the expected object in YAML.
+	Diff string `json:"-"`
+}
+
+// PreviewPlan renders the changes of all object vertices in the DAG without executing them.
+// The current object is read from cli if the vertex doesn't carry the original one.
+// NOOP vertices and updates which change nothing are omitted.
+func PreviewPlan(ctx context.Context, cli client.Reader, dag *graph.DAG) ([]ObjectChange, error) {
+	var changes []ObjectChange
+	for _, v := range dag.Vertices() {
+		vertex, ok := v.(*ObjectVertex)
+		if !ok || vertex.Obj == nil || vertex.Action == nil || *vertex.Action == NOOP {
+			continue
+		}
+		change, err := previewVertex(ctx, cli, vertex)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	SortObjectChanges(changes)
+	return changes, nil
+}
+
+// SortObjectChanges sorts the changes by kind, namespace and name to make the output stable.
+func SortObjectChanges(changes []ObjectChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return changes[i].Kind < changes[j].Kind
+		}
+		if changes[i].Namespace != changes[j].Namespace {
+			return changes[i].Namespace < changes[j].Namespace
+		}
+		return changes[i].Name < changes[j].Name
+	})
+}
+
+func previewVertex(ctx context.Context, cli client.Reader, vertex *ObjectVertex) (*ObjectChange, error) {
+	gvk, err := GetGVKName(vertex.Obj)
+	if err != nil {
+		return nil, err
+	}
+	var oldObj, newObj client.Object
+	switch *vertex.Action {
+	case CREATE:
+		newObj = vertex.Obj
+	case DELETE:
+		// obj represents the old object in Delete action
+		oldObj = vertex.Obj
+	default:
+		newObj = vertex.Obj
+		oldObj = vertex.OriObj
+		if oldObj == nil && cli != nil {
+			current, _ := vertex.Obj.DeepCopyObject().(client.Object)
+			if err = cli.Get(ctx, client.ObjectKeyFromObject(vertex.Obj), current); err == nil {
+				oldObj = current
+			} else if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+	}
+
+	oldYAML, err := objectYAML(oldObj, gvk)
+	if err != nil {
+		return nil, err
+	}
+	newYAML, err := objectYAML(newObj, gvk)
+	if err != nil {
+		return nil, err
+	}
+	if oldYAML == newYAML {
+		return nil, nil
+	}
+
+	fromFile, toFile := "/dev/null", "/dev/null"
+	objName := fmt.Sprintf("%s/%s/%s", gvk.Kind, gvk.Namespace, gvk.Name)
+	if oldObj != nil {
+		fromFile = objName
+	}
+	if newObj != nil {
+		toFile = objName
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(oldYAML),
+		B:        difflib.SplitLines(newYAML),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectChange{
+		Action:    *vertex.Action,
+		Kind:      gvk.Kind,
+		Namespace: gvk.Namespace,
+		Name:      gvk.Name,
+		Diff:      diff,
+	}, nil
+}
+
+// objectYAML marshals the object to YAML, the fields maintained by the API server are omitted.
+func objectYAML(obj client.Object, gvk *GVKNObjKey) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	objCopy, _ := obj.DeepCopyObject().(client.Object)
+	objCopy.GetObjectKind().SetGroupVersionKind(gvk.GroupVersionKind)
+	objCopy.SetManagedFields(nil)
+	objCopy.SetResourceVersion("")
+	out, err := yaml.Marshal(objCopy)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/apecloud/kubeblocks/pkg/controller/builder"
+	"github.com/apecloud/kubeblocks/pkg/controller/graph"
+)
+
+var _ = Describe("plan preview test", func() {
+	const (
+		namespace = "foo"
+		name      = "bar"
+	)
+
+	Context("PreviewPlan function", func() {
+		It("should work well", func() {
+			dag := graph.NewDAG()
+			root := builder.NewStatefulSetBuilder(namespace, name).GetObject()
+			dag.AddVertex(&ObjectVertex{Obj: root, OriObj: root.DeepCopy(), Action: ActionStatusPtr()})
+
+			By("create a new object")
+			svc := builder.NewServiceBuilder(namespace, name).AddSelector("app", name).GetObject()
+			dag.AddConnectRoot(&ObjectVertex{Obj: svc, Action: ActionCreatePtr()})
+
+			By("update an existing object")
+			oldCM := builder.NewConfigMapBuilder(namespace, name).SetData(map[string]string{"key": "old"}).GetObject()
+			newCM := oldCM.DeepCopy()
+			newCM.Data["key"] = "new"
+			dag.AddConnectRoot(&ObjectVertex{Obj: newCM, OriObj: oldCM, Action: ActionUpdatePtr()})
+
+			By("delete an existing object")
+			secret := builder.NewSecretBuilder(namespace, name).GetObject()
+			dag.AddConnectRoot(&ObjectVertex{Obj: secret, Action: ActionDeletePtr()})
+
+			By("noop object")
+			pod := builder.NewPodBuilder(namespace, name).GetObject()
+			dag.AddConnectRoot(&ObjectVertex{Obj: pod, Action: ActionNoopPtr()})
+
+			changes, err := PreviewPlan(context.Background(), nil, dag)
+			Expect(err).Should(BeNil())
+			Expect(changes).Should(HaveLen(3))
+
+			Expect(changes[0].Kind).Should(Equal("ConfigMap"))
+			Expect(changes[0].Action).Should(Equal(UPDATE))
+			Expect(changes[0].Diff).Should(ContainSubstring("-  key: old"))
+			Expect(changes[0].Diff).Should(ContainSubstring("+  key: new"))
+
+			Expect(changes[1].Kind).Should(Equal("Secret"))
+			Expect(changes[1].Action).Should(Equal(DELETE))
+			Expect(changes[1].Diff).Should(ContainSubstring("+++ /dev/null"))
+
+			Expect(changes[2].Kind).Should(Equal("Service"))
+			Expect(changes[2].Action).Should(Equal(CREATE))
+			Expect(changes[2].Diff).Should(ContainSubstring("--- /dev/null"))
+			Expect(changes[2].Diff).Should(ContainSubstring("+    app: bar"))
+		})
+	})
+})
@@ -22,6 +22,7 @@ package plan
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -29,6 +30,8 @@ import (
 	"github.com/golang/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -44,7 +47,7 @@ var _ = Describe("TLSUtilsTest", func() {
 		It("should work well", func() {
 			clusterName := "bar"
 			componentName := "test"
-			secret, err := ComposeTLSSecret(namespace, clusterName, componentName)
+			secret, err := ComposeTLSSecret(namespace, clusterName, componentName, DefaultTLSCertDuration)
 			Expect(err).Should(BeNil())
 			Expect(secret).ShouldNot(BeNil())
 			Expect(secret.Name).Should(Equal(fmt.Sprintf("%s-%s-tls-certs", clusterName, componentName)))
@@ -56,6 +59,116 @@ var _ = Describe("TLSUtilsTest", func() {
 			Expect(secret.StringData[constant.CAName]).ShouldNot(BeZero())
 			Expect(secret.StringData[constant.CertName]).ShouldNot(BeZero())
 			Expect(secret.StringData[constant.KeyName]).ShouldNot(BeZero())
+
+			cert, err := ParseTLSCert(secret, constant.CertName)
+			Expect(err).Should(BeNil())
+			Expect(cert.IsCA).Should(BeTrue())
+			Expect(cert.NotAfter.Sub(cert.NotBefore)).Should(Equal(DefaultTLSCertDuration))
+		})
+
+		It("should reject invalid duration", func() {
+			_, err := ComposeTLSSecret(namespace, "bar", "test", 0)
+			Expect(err).ShouldNot(BeNil())
+		})
+	})
+
+	Context("ComposeLorrySecureAccessSecret function", func() {
+		It("should work well", func() {
+			secret, err := ComposeLorrySecureAccessSecret(namespace, "bar", "test", DefaultTLSCertDuration, nil)
+			Expect(err).Should(BeNil())
+			Expect(secret.Name).Should(Equal("bar-test-lorry-secure-access"))
+			Expect(secret.StringData[constant.CAName]).Should(Equal(secret.StringData[constant.CertName]))
+			Expect(secret.StringData[constant.KeyName]).ShouldNot(BeZero())
+			Expect(secret.StringData[constant.LorryTokenName]).ShouldNot(BeZero())
+
+			cert, err := ParseTLSCert(secret, constant.CertName)
+			Expect(err).Should(BeNil())
+			Expect(cert.DNSNames).Should(ContainElement(constant.LorrySecureAccessServerName))
+		})
+
+		It("should keep the token and trust the former certificate when renewing", func() {
+			former, err := ComposeLorrySecureAccessSecret(namespace, "bar", "test", DefaultTLSCertDuration, nil)
+			Expect(err).Should(BeNil())
+			former.Data = map[string][]byte{}
+			for k, v := range former.StringData {
+				former.Data[k] = []byte(v)
+			}
+			former.StringData = nil
+
+			secret, err := ComposeLorrySecureAccessSecret(namespace, "bar", "test", DefaultTLSCertDuration, former)
+			Expect(err).Should(BeNil())
+			Expect(secret.StringData[constant.LorryTokenName]).Should(Equal(string(former.Data[constant.LorryTokenName])))
+			Expect(secret.StringData[constant.CertName]).ShouldNot(Equal(string(former.Data[constant.CertName])))
+			Expect(secret.StringData[constant.CAName]).Should(ContainSubstring(secret.StringData[constant.CertName]))
+			Expect(secret.StringData[constant.CAName]).Should(ContainSubstring(string(former.Data[constant.CertName])))
+		})
+	})
+
+	Context("TLS certificate lifetime", func() {
+		It("should use the defaults", func() {
+			Expect(GetTLSCertDuration(nil)).Should(Equal(DefaultTLSCertDuration))
+			Expect(GetTLSCertRenewBefore(&appsv1alpha1.Issuer{})).Should(Equal(DefaultTLSCertRenewBefore))
+			Expect(CheckTLSCertLifetime(&appsv1alpha1.Issuer{})).Should(Succeed())
+		})
+
+		It("should reject renewBefore not shorter than duration", func() {
+			issuer := &appsv1alpha1.Issuer{
+				Name:        appsv1alpha1.IssuerKubeBlocks,
+				Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+				RenewBefore: &metav1.Duration{Duration: 24 * time.Hour},
+			}
+			Expect(CheckTLSCertLifetime(issuer)).ShouldNot(Succeed())
+		})
+
+		It("should renew the certificate before it expires", func() {
+			secret, err := ComposeTLSSecret(namespace, "bar", "test", 48*time.Hour)
+			Expect(err).Should(BeNil())
+			cert, err := ParseTLSCert(secret, constant.CertName)
+			Expect(err).Should(BeNil())
+			renewAt := GetTLSCertRenewalTime(cert, 48*time.Hour, 12*time.Hour)
+			Expect(renewAt).Should(Equal(cert.NotAfter.Add(-12 * time.Hour)))
+		})
+
+		It("should renew the certificate at once if it lives longer than the duration", func() {
+			secret, err := ComposeTLSSecret(namespace, "bar", "test", 36500*24*time.Hour)
+			Expect(err).Should(BeNil())
+			cert, err := ParseTLSCert(secret, constant.CertName)
+			Expect(err).Should(BeNil())
+			renewAt := GetTLSCertRenewalTime(cert, DefaultTLSCertDuration, DefaultTLSCertRenewBefore)
+			Expect(renewAt.After(time.Now())).Should(BeFalse())
+		})
+	})
+
+	Context("ComposeTLSCertificate function", func() {
+		It("should work well", func() {
+			issuer := &appsv1alpha1.Issuer{
+				Name: appsv1alpha1.IssuerCertManager,
+				IssuerRef: &appsv1alpha1.CertManagerIssuerRef{
+					Name: "ca-issuer",
+					Kind: "ClusterIssuer",
+				},
+				Duration: &metav1.Duration{Duration: 720 * time.Hour},
+			}
+			cert, err := ComposeTLSCertificate(namespace, "bar", "test", issuer)
+			Expect(err).Should(BeNil())
+			Expect(cert.GroupVersionKind()).Should(Equal(CertManagerCertificateGVK))
+			Expect(cert.GetName()).Should(Equal(GenerateTLSSecretName("bar", "test")))
+
+			secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+			Expect(secretName).Should(Equal(GenerateTLSSecretName("bar", "test")))
+			duration, _, _ := unstructured.NestedString(cert.Object, "spec", "duration")
+			Expect(duration).Should(Equal("720h0m0s"))
+			renewBefore, _, _ := unstructured.NestedString(cert.Object, "spec", "renewBefore")
+			Expect(renewBefore).Should(Equal(DefaultTLSCertRenewBefore.String()))
+			kind, _, _ := unstructured.NestedString(cert.Object, "spec", "issuerRef", "kind")
+			Expect(kind).Should(Equal("ClusterIssuer"))
+			dnsNames, _, _ := unstructured.NestedStringSlice(cert.Object, "spec", "dnsNames")
+			Expect(dnsNames).Should(ContainElement("*.bar-test-headless.foo.svc"))
+		})
+
+		It("should fail without issuerRef", func() {
+			_, err := ComposeTLSCertificate(namespace, "bar", "test", &appsv1alpha1.Issuer{Name: appsv1alpha1.IssuerCertManager})
+			Expect(err).ShouldNot(BeNil())
 		})
 	})
 
@@ -369,6 +369,21 @@ func GetLorryContainer(containers []corev1.Container) *corev1.Container {
 	return nil
 }
 
+// IsLorrySecureAccessEnabled checks whether the lorry container serves over TLS and requires authentication.
+func IsLorrySecureAccessEnabled(container *corev1.Container) bool {
+	if container == nil {
+		return false
+	}
+	for _, args := range [][]string{container.Command, container.Args} {
+		for _, arg := range args {
+			if arg == "--tls-cert-file" || strings.HasPrefix(arg, "--tls-cert-file=") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // PodIsReadyWithLabel checks if pod is ready for ConsensusSet/ReplicationSet component,
 // it will be available when the pod is ready and labeled with role.
 func PodIsReadyWithLabel(pod corev1.Pod) bool {
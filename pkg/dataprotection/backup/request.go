@@ -170,7 +170,7 @@ func (r *Request) buildBackupDataAction(targetPod *corev1.Pod, name string) (act
 
 	backupDataAct := r.ActionSet.Spec.Backup.BackupData
 	switch r.ActionSet.Spec.BackupType {
-	case dpv1alpha1.BackupTypeFull:
+	case dpv1alpha1.BackupTypeFull, dpv1alpha1.BackupTypeIncremental, dpv1alpha1.BackupTypeDifferential:
 		podSpec, err := r.BuildJobActionPodSpec(targetPod, BackupDataContainerName, &backupDataAct.JobActionSpec)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build job action pod spec: %w", err)
@@ -321,7 +321,11 @@ func (r *Request) BuildJobActionPodSpec(targetPod *corev1.Pod,
 			},
 			{
 				Name:  dptypes.DPParentBackupName,
-				Value: r.Backup.Spec.ParentBackupName,
+				Value: utils.GetParentBackupName(r.Backup),
+			},
+			{
+				Name:  dptypes.DPBaseBackupName,
+				Value: r.Backup.Status.BaseBackupName,
 			},
 			{
 				Name:  dptypes.DPTargetPodName,
@@ -97,47 +97,45 @@ func (r *RestoreManager) GetBackupActionSetByNamespaced(reqCtx intctrlutil.Reque
 	return &BackupActionSet{Backup: backup, ActionSet: actionSet, UseVolumeSnapshot: useVolumeSnapshot}, nil
 }
 
-// BuildDifferentialBackupActionSets builds the backupActionSets for specified incremental backup.
+// BuildDifferentialBackupActionSets builds the backupActionSets for specified differential backup.
 func (r *RestoreManager) BuildDifferentialBackupActionSets(reqCtx intctrlutil.RequestCtx, cli client.Client, sourceBackupSet BackupActionSet) error {
-	parentBackupSet, err := r.GetBackupActionSetByNamespaced(reqCtx, cli, sourceBackupSet.Backup.Spec.ParentBackupName, sourceBackupSet.Backup.Namespace)
-	if err != nil || parentBackupSet == nil {
-		return err
-	}
-	r.SetBackupSets(*parentBackupSet, sourceBackupSet)
-	return nil
+	return r.buildBackupChainActionSets(reqCtx, cli, sourceBackupSet)
 }
 
 // BuildIncrementalBackupActionSets builds the backupActionSets for specified incremental backup.
 func (r *RestoreManager) BuildIncrementalBackupActionSets(reqCtx intctrlutil.RequestCtx, cli client.Client, sourceBackupSet BackupActionSet) error {
-	r.SetBackupSets(sourceBackupSet)
-	if sourceBackupSet.ActionSet != nil && sourceBackupSet.ActionSet.Spec.BackupType == dpv1alpha1.BackupTypeIncremental {
-		// get the parent BackupActionSet for incremental.
-		backupSet, err := r.GetBackupActionSetByNamespaced(reqCtx, cli, sourceBackupSet.Backup.Spec.ParentBackupName, sourceBackupSet.Backup.Namespace)
-		if err != nil || backupSet == nil {
+	return r.buildBackupChainActionSets(reqCtx, cli, sourceBackupSet)
+}
+
+// buildBackupChainActionSets builds the backupActionSets of the backup chain, the backups are restored
+// in the order from the base full backup to the source backup.
+func (r *RestoreManager) buildBackupChainActionSets(reqCtx intctrlutil.RequestCtx, cli client.Client, sourceBackupSet BackupActionSet) error {
+	ancestors, err := utils.GetBackupChain(reqCtx.Ctx, cli, sourceBackupSet.Backup)
+	if err != nil {
+		return err
+	}
+	if len(ancestors) == 0 {
+		return intctrlutil.NewFatalError(fmt.Sprintf(`the backup chain of backup "%s" is empty`, sourceBackupSet.Backup.Name))
+	}
+	backupSets := make([]BackupActionSet, 0, len(ancestors)+1)
+	for _, name := range ancestors {
+		backupSet, err := r.GetBackupActionSetByNamespaced(reqCtx, cli, name, sourceBackupSet.Backup.Namespace)
+		if err != nil {
 			return err
 		}
-		return r.BuildIncrementalBackupActionSets(reqCtx, cli, *backupSet)
+		if backupSet.Backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+			return intctrlutil.NewFatalError(fmt.Sprintf(`backup "%s" in the backup chain of "%s" is not completed`,
+				name, sourceBackupSet.Backup.Name))
+		}
+		backupSets = append(backupSets, *backupSet)
 	}
-	// if reaches full backup, sort the BackupActionSets and return
-	sortBackupSets := func(backupSets []BackupActionSet, reverse bool) []BackupActionSet {
-		sort.Slice(backupSets, func(i, j int) bool {
-			if reverse {
-				i, j = j, i
-			}
-			backupI := backupSets[i].Backup
-			backupJ := backupSets[j].Backup
-			if backupI == nil {
-				return false
-			}
-			if backupJ == nil {
-				return true
-			}
-			return CompareWithBackupStopTime(*backupI, *backupJ)
-		})
-		return backupSets
+	backupSets = append(backupSets, sourceBackupSet)
+	// set base backup
+	baseBackup := backupSets[0].Backup
+	for i := 1; i < len(backupSets); i++ {
+		backupSets[i].BaseBackup = baseBackup
 	}
-	r.PrepareDataBackupSets = sortBackupSets(r.PrepareDataBackupSets, false)
-	r.PostReadyBackupSets = sortBackupSets(r.PostReadyBackupSets, false)
+	r.SetBackupSets(backupSets...)
 	return nil
 }
 
@@ -293,30 +293,19 @@ func FormatRestoreTimeAndValidate(restoreTimeStr string, continuousBackup *dpv1a
 	if restoreTimeStr == "" {
 		return restoreTimeStr, nil
 	}
-	layout := "Jan 02,2006 15:04:05 UTC-0700"
-	restoreTime, err := time.Parse(layout, restoreTimeStr)
+	restoreTime, err := dpv1alpha1.ParseRestoreTime(restoreTimeStr)
 	if err != nil {
-		// retry to parse time with RFC3339 format.
-		var errRFC error
-		restoreTime, errRFC = time.Parse(time.RFC3339, restoreTimeStr)
-		if errRFC != nil {
-			// if retry failure, report the error
-			return restoreTimeStr, err
-		}
+		return restoreTimeStr, err
 	}
 	restoreTimeStr = restoreTime.UTC().Format(time.RFC3339)
 	// TODO: check with Recoverable time
-	if !isTimeInRange(restoreTime, continuousBackup.Status.TimeRange.Start.Time, continuousBackup.Status.TimeRange.End.Time) {
+	if !continuousBackup.Status.TimeRange.Contains(restoreTime) {
 		return restoreTimeStr, fmt.Errorf("restore-to-time is out of time range, you can view the recoverable time: \n"+
 			"\tkbcli cluster describe %s -n %s", continuousBackup.Labels[constant.AppInstanceLabelKey], continuousBackup.Namespace)
 	}
 	return restoreTimeStr, nil
 }
 
-func isTimeInRange(t time.Time, start time.Time, end time.Time) bool {
-	return !t.Before(start) && !t.After(end)
-}
-
 func GetRestoreFromBackupAnnotation(backup *dpv1alpha1.Backup, volumeRestorePolicy, restoreTime string, doReadyRestoreAfterClusterRunning bool) (string, error) {
 	componentName := backup.Labels[constant.KBAppShardingNameLabelKey]
 	if len(componentName) == 0 {
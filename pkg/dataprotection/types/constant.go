@@ -84,6 +84,10 @@ const (
 	AutoBackupLabelKey = "dataprotection.kubeblocks.io/autobackup"
 	// BackupTargetPodLabelKey specifies the backup target pod label key.
 	BackupTargetPodLabelKey = "dataprotection.kubeblocks.io/target-pod-name"
+	// BackupVerificationLabelKey specifies the backup verification label key.
+	BackupVerificationLabelKey = "dataprotection.kubeblocks.io/backup-verification"
+	// BackupRepoLabelKey specifies the backup repo label key.
+	BackupRepoLabelKey = "dataprotection.kubeblocks.io/backup-repo-name"
 )
 
 // env names
@@ -108,6 +112,8 @@ const (
 	DPBackupName = "DP_BACKUP_NAME"
 	// DPParentBackupName backup CR name
 	DPParentBackupName = "DP_PARENT_BACKUP_NAME"
+	// DPBaseBackupName the base full backup CR name of the incremental or differential backup
+	DPBaseBackupName = "DP_BASE_BACKUP_NAME"
 	// DPTTL backup time to live, reference the backup.spec.retentionPeriod
 	DPTTL = "DP_TTL"
 	// DPCheckInterval check interval for sync backup progress
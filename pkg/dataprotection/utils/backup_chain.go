@@ -0,0 +1,160 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+	"github.com/apecloud/kubeblocks/pkg/dataprotection/utils/boolptr"
+)
+
+// GetParentBackupName returns the parent backup name of the incremental or differential backup,
+// the one resolved by the controller takes precedence.
+func GetParentBackupName(backup *dpv1alpha1.Backup) string {
+	if backup.Status.ParentBackupName != "" {
+		return backup.Status.ParentBackupName
+	}
+	return backup.Spec.ParentBackupName
+}
+
+// IsFullBackup checks if the backup is a full backup. The backups without the backup type label
+// are created by the old versions, and they are treated as full backups.
+func IsFullBackup(backup *dpv1alpha1.Backup) bool {
+	backupType := backup.Labels[dptypes.BackupTypeLabelKey]
+	return backupType == "" || backupType == string(dpv1alpha1.BackupTypeFull)
+}
+
+// GetBackupChain returns the ancestor backups of the backup, ordered from the base full backup
+// to the parent backup. It returns nil for a full backup.
+func GetBackupChain(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup) ([]string, error) {
+	if IsFullBackup(backup) {
+		return nil, nil
+	}
+	if len(backup.Status.AncestorBackupNames) > 0 {
+		return append([]string{}, backup.Status.AncestorBackupNames...), nil
+	}
+	// the chain is not recorded in the status, walk through the parents until reaching the base backup.
+	var (
+		chain   []string
+		visited = map[string]bool{backup.Name: true}
+		current = backup
+	)
+	for {
+		parentName := GetParentBackupName(current)
+		if parentName == "" {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf(`the parent backup of "%s" is not specified`, current.Name))
+		}
+		if visited[parentName] {
+			return nil, intctrlutil.NewFatalError(fmt.Sprintf(`backup chain of "%s" has a cycle at "%s"`, backup.Name, parentName))
+		}
+		visited[parentName] = true
+		parent := &dpv1alpha1.Backup{}
+		if err := cli.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: parentName}, parent); err != nil {
+			if apierrors.IsNotFound(err) {
+				err = intctrlutil.NewFatalError(fmt.Sprintf(`the parent backup "%s" of "%s" is not found`, parentName, current.Name))
+			}
+			return nil, err
+		}
+		chain = append([]string{parent.Name}, chain...)
+		if IsFullBackup(parent) {
+			return chain, nil
+		}
+		if len(parent.Status.AncestorBackupNames) > 0 {
+			return append(append([]string{}, parent.Status.AncestorBackupNames...), chain...), nil
+		}
+		current = parent
+	}
+}
+
+// SelectParentBackup selects the latest completed backup which can be the parent of the incremental or
+// differential backup created by the backup method, it returns nil if no backup is available.
+func SelectParentBackup(ctx context.Context,
+	cli client.Client,
+	backup *dpv1alpha1.Backup,
+	backupMethod *dpv1alpha1.BackupMethod,
+	backupType dpv1alpha1.BackupType) (*dpv1alpha1.Backup, error) {
+	backupList := &dpv1alpha1.BackupList{}
+	if err := cli.List(ctx, backupList, client.InNamespace(backup.Namespace),
+		client.MatchingLabels{dptypes.BackupPolicyLabelKey: backup.Spec.BackupPolicyName}); err != nil {
+		return nil, err
+	}
+	isCompatibleFullBackup := func(candidate *dpv1alpha1.Backup) bool {
+		if !IsFullBackup(candidate) {
+			return false
+		}
+		if backupMethod.CompatibleMethod != "" {
+			return candidate.Spec.BackupMethod == backupMethod.CompatibleMethod
+		}
+		return candidate.Status.BackupMethod == nil || !boolptr.IsSetToTrue(candidate.Status.BackupMethod.SnapshotVolumes)
+	}
+	var parent *dpv1alpha1.Backup
+	for i := range backupList.Items {
+		candidate := &backupList.Items[i]
+		if candidate.Name == backup.Name || candidate.Status.Phase != dpv1alpha1.BackupPhaseCompleted ||
+			!candidate.DeletionTimestamp.IsZero() || candidate.Status.CompletionTimestamp == nil {
+			continue
+		}
+		compatible := isCompatibleFullBackup(candidate)
+		if backupType == dpv1alpha1.BackupTypeIncremental && candidate.Spec.BackupMethod == backupMethod.Name {
+			compatible = true
+		}
+		if !compatible {
+			continue
+		}
+		if parent == nil || candidate.Status.CompletionTimestamp.After(parent.Status.CompletionTimestamp.Time) {
+			parent = candidate
+		}
+	}
+	return parent, nil
+}
+
+// GetDependentBackups returns the backups which depend on the backup in their backup chains.
+// The backups being deleted are excluded.
+func GetDependentBackups(ctx context.Context, cli client.Client, backup *dpv1alpha1.Backup) ([]dpv1alpha1.Backup, error) {
+	backupList := &dpv1alpha1.BackupList{}
+	if err := cli.List(ctx, backupList, client.InNamespace(backup.Namespace)); err != nil {
+		return nil, err
+	}
+	var dependents []dpv1alpha1.Backup
+	for _, item := range backupList.Items {
+		if item.Name == backup.Name || !item.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if GetParentBackupName(&item) == backup.Name {
+			dependents = append(dependents, item)
+			continue
+		}
+		for _, ancestor := range item.Status.AncestorBackupNames {
+			if ancestor == backup.Name {
+				dependents = append(dependents, item)
+				break
+			}
+		}
+	}
+	return dependents, nil
+}
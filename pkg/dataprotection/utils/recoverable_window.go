@@ -0,0 +1,105 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"sort"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+// BuildRecoverableWindows computes the recoverable windows from the full backups and the continuous backups.
+// A continuous backup can be restored to the time between the completion of a full backup and the end of
+// the continuous backup, the full backup must be completed after the start of the continuous backup.
+// The overlapped windows are merged, and the result is sorted by the start time.
+func BuildRecoverableWindows(backups []dpv1alpha1.Backup) []dpv1alpha1.RecoverableWindow {
+	var (
+		fullBackups       []*dpv1alpha1.Backup
+		continuousBackups []*dpv1alpha1.Backup
+	)
+	for i := range backups {
+		backup := &backups[i]
+		if !backup.DeletionTimestamp.IsZero() {
+			continue
+		}
+		switch {
+		case backup.Labels[dptypes.BackupTypeLabelKey] == string(dpv1alpha1.BackupTypeContinuous):
+			if backup.Status.Phase != dpv1alpha1.BackupPhaseRunning && backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted {
+				continue
+			}
+			if backup.GetStartTime().IsZero() || backup.GetEndTime().IsZero() {
+				continue
+			}
+			continuousBackups = append(continuousBackups, backup)
+		case IsFullBackup(backup):
+			if backup.Status.Phase != dpv1alpha1.BackupPhaseCompleted || backup.GetEndTime().IsZero() {
+				continue
+			}
+			fullBackups = append(fullBackups, backup)
+		}
+	}
+
+	var windows []dpv1alpha1.RecoverableWindow
+	for _, continuousBackup := range continuousBackups {
+		start, end := continuousBackup.GetStartTime(), continuousBackup.GetEndTime()
+		var baseBackup *dpv1alpha1.Backup
+		for _, fullBackup := range fullBackups {
+			fullBackupEnd := fullBackup.GetEndTime()
+			if fullBackupEnd.Before(start) || end.Before(fullBackupEnd) {
+				continue
+			}
+			if baseBackup == nil || fullBackupEnd.Before(baseBackup.GetEndTime()) {
+				baseBackup = fullBackup
+			}
+		}
+		if baseBackup == nil {
+			continue
+		}
+		windows = append(windows, dpv1alpha1.RecoverableWindow{
+			Start:       *baseBackup.GetEndTime(),
+			End:         *end,
+			BackupNames: []string{continuousBackup.Name},
+		})
+	}
+	return mergeRecoverableWindows(windows)
+}
+
+func mergeRecoverableWindows(windows []dpv1alpha1.RecoverableWindow) []dpv1alpha1.RecoverableWindow {
+	if len(windows) == 0 {
+		return nil
+	}
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].Start.Before(&windows[j].Start)
+	})
+	merged := []dpv1alpha1.RecoverableWindow{windows[0]}
+	for _, w := range windows[1:] {
+		last := &merged[len(merged)-1]
+		if w.Start.After(last.End.Time) {
+			merged = append(merged, w)
+			continue
+		}
+		if last.End.Before(&w.End) {
+			last.End = w.End
+		}
+		last.BackupNames = append(last.BackupNames, w.BackupNames...)
+	}
+	return merged
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dpv1alpha1 "github.com/apecloud/kubeblocks/apis/dataprotection/v1alpha1"
+	dptypes "github.com/apecloud/kubeblocks/pkg/dataprotection/types"
+)
+
+func TestBuildRecoverableWindows(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *metav1.Time {
+		return &metav1.Time{Time: base.Add(time.Duration(hours) * time.Hour)}
+	}
+	newBackup := func(name string, backupType dpv1alpha1.BackupType, phase dpv1alpha1.BackupPhase, start, end *metav1.Time) dpv1alpha1.Backup {
+		return dpv1alpha1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{dptypes.BackupTypeLabelKey: string(backupType)},
+			},
+			Status: dpv1alpha1.BackupStatus{
+				Phase:     phase,
+				TimeRange: &dpv1alpha1.BackupTimeRange{Start: start, End: end},
+			},
+		}
+	}
+	full := func(name string, end int) dpv1alpha1.Backup {
+		return newBackup(name, dpv1alpha1.BackupTypeFull, dpv1alpha1.BackupPhaseCompleted, at(end-1), at(end))
+	}
+	continuous := func(name string, start, end int) dpv1alpha1.Backup {
+		return newBackup(name, dpv1alpha1.BackupTypeContinuous, dpv1alpha1.BackupPhaseRunning, at(start), at(end))
+	}
+
+	t.Run("no continuous backup", func(t *testing.T) {
+		assert.Empty(t, BuildRecoverableWindows([]dpv1alpha1.Backup{full("full-1", 1)}))
+	})
+
+	t.Run("continuous backup without base full backup", func(t *testing.T) {
+		windows := BuildRecoverableWindows([]dpv1alpha1.Backup{full("full-1", 1), continuous("log-1", 2, 10)})
+		assert.Empty(t, windows)
+	})
+
+	t.Run("window starts from the earliest base full backup", func(t *testing.T) {
+		windows := BuildRecoverableWindows([]dpv1alpha1.Backup{
+			full("full-1", 1), full("full-2", 3), full("full-3", 5), continuous("log-1", 2, 10),
+		})
+		assert.Len(t, windows, 1)
+		assert.Equal(t, at(3).Time, windows[0].Start.Time)
+		assert.Equal(t, at(10).Time, windows[0].End.Time)
+		assert.Equal(t, []string{"log-1"}, windows[0].BackupNames)
+	})
+
+	t.Run("overlapped windows are merged and gaps are kept", func(t *testing.T) {
+		failedFull := newBackup("full-failed", dpv1alpha1.BackupTypeFull, dpv1alpha1.BackupPhaseFailed, at(20), at(21))
+		windows := BuildRecoverableWindows([]dpv1alpha1.Backup{
+			continuous("log-3", 20, 30), failedFull,
+			full("full-1", 3), continuous("log-1", 2, 10),
+			full("full-2", 9), continuous("log-2", 8, 15),
+		})
+		assert.Len(t, windows, 1)
+		assert.Equal(t, at(3).Time, windows[0].Start.Time)
+		assert.Equal(t, at(15).Time, windows[0].End.Time)
+		assert.Equal(t, []string{"log-1", "log-2"}, windows[0].BackupNames)
+
+		windows = BuildRecoverableWindows([]dpv1alpha1.Backup{
+			full("full-1", 3), continuous("log-1", 2, 10),
+			full("full-3", 22), continuous("log-3", 20, 30),
+		})
+		assert.Len(t, windows, 2)
+		assert.Equal(t, at(10).Time, windows[0].End.Time)
+		assert.Equal(t, at(22).Time, windows[1].Start.Time)
+		assert.True(t, windows[1].Contains(at(25).Time))
+		assert.False(t, windows[0].Contains(at(15).Time))
+	})
+}
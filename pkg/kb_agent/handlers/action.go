@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -53,6 +54,12 @@ func InitHandlers() error {
 		msg := fmt.Sprintf("unmarshal action handlers [%s] failed: %s", actionJSON, err.Error())
 		return errors.New(msg)
 	}
+	for action, spec := range actionHandlerSpecs {
+		// the action name is used as the gRPC method if it is not specified explicitly
+		if action != "" && len(spec.GPRC) != 0 && spec.GPRC[GRPCMethodKey] == "" {
+			spec.GPRC[GRPCMethodKey] = strings.ToUpper(action[:1]) + action[1:]
+		}
+	}
 	execHandler, err = NewExecHandler(nil)
 	if err != nil {
 		return errors.Wrap(err, "new exec handler failed")
@@ -22,9 +22,19 @@ package handlers
 import "fmt"
 
 const (
-	errMsgNotImplemented = "not implemented"
+	errMsgNotImplemented     = "not implemented"
+	errMsgInvalidArgs        = "invalid args"
+	errMsgPreconditionFailed = "precondition failed"
+	errMsgTimeout            = "action timeout"
+	errMsgUnavailable        = "action server unavailable"
+	errMsgActionFailed       = "action failed"
 )
 
 var (
-	ErrNotImplemented = fmt.Errorf(errMsgNotImplemented)
+	ErrNotImplemented     = fmt.Errorf(errMsgNotImplemented)
+	ErrInvalidArgs        = fmt.Errorf(errMsgInvalidArgs)
+	ErrPreconditionFailed = fmt.Errorf(errMsgPreconditionFailed)
+	ErrTimeout            = fmt.Errorf(errMsgTimeout)
+	ErrUnavailable        = fmt.Errorf(errMsgUnavailable)
+	ErrActionFailed       = fmt.Errorf(errMsgActionFailed)
 )
@@ -21,16 +21,49 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/apecloud/kubeblocks/pkg/kb_agent/util"
 )
 
+// keys of the HandlerSpec.GPRC setting.
+const (
+	GRPCHostKey    = "host"
+	GRPCPortKey    = "port"
+	GRPCServiceKey = "service"
+	GRPCMethodKey  = "method"
+)
+
+const (
+	// DefaultGRPCHost is used when the action server runs as a sidecar or inside the engine container.
+	DefaultGRPCHost = "127.0.0.1"
+	// DefaultGRPCService is the fully qualified name of the action service, defined in pkg/kb_agent/proto/action.proto.
+	DefaultGRPCService = "kubeblocks.kbagent.v1.ActionService"
+
+	grpcResponseMessageField = "message"
+)
+
+// GRPCHandler calls the actions served by a long-running gRPC action server.
+// The request is the action args encoded as a google.protobuf.Struct, and the response is
+// a google.protobuf.Struct too, whose "message" field is returned as the action output.
 type GRPCHandler struct {
 	Logger logr.Logger
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
 }
 
 var _ Handler = &GRPCHandler{}
@@ -39,6 +72,7 @@ func NewGRPCHandler(properties map[string]string) (*GRPCHandler, error) {
 	logger := ctrl.Log.WithName("GRPC handler")
 	h := &GRPCHandler{
 		Logger: logger,
+		conns:  map[string]*grpc.ClientConn{},
 	}
 
 	return h, nil
@@ -48,6 +82,121 @@ func (h *GRPCHandler) Do(ctx context.Context, setting util.HandlerSpec, args map
 	if setting.GPRC == nil {
 		return nil, errors.New("grpc setting is nil")
 	}
-	// TODO: implement grpc handler
-	return nil, ErrNotImplemented
+	endpoint, method, err := parseGRPCSetting(setting.GPRC)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := structpb.NewStruct(args)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidArgs, fmt.Sprintf("encode action args failed: %s", err.Error()))
+	}
+
+	conn, err := h.getConn(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "GRPCHandler dials action server failed")
+	}
+
+	if setting.TimeoutSeconds > 0 {
+		timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(setting.TimeoutSeconds)*time.Second)
+		defer cancel()
+		ctx = timeoutCtx
+	}
+
+	h.Logger.Info("call action", "endpoint", endpoint, "method", method)
+	resp := &structpb.Struct{}
+	if err = conn.Invoke(ctx, method, req, resp); err != nil {
+		return nil, convertGRPCError(err)
+	}
+
+	message, err := responseMessage(resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode action response failed")
+	}
+	h.Logger.V(1).Info("call action", "output", message)
+	return &Response{Message: message}, nil
+}
+
+// Close closes all the connections to the action servers.
+func (h *GRPCHandler) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for endpoint, conn := range h.conns {
+		_ = conn.Close()
+		delete(h.conns, endpoint)
+	}
+}
+
+func (h *GRPCHandler) getConn(endpoint string) (*grpc.ClientConn, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if conn, ok := h.conns[endpoint]; ok {
+		return conn, nil
+	}
+	// the dial is non-blocking, the connection is established lazily by the first call.
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	h.conns[endpoint] = conn
+	return conn, nil
+}
+
+// parseGRPCSetting returns the dial target and the full method name of the action.
+func parseGRPCSetting(setting map[string]string) (string, string, error) {
+	port := setting[GRPCPortKey]
+	if port == "" {
+		return "", "", errors.New("grpc port is not specified")
+	}
+	method := setting[GRPCMethodKey]
+	if method == "" {
+		return "", "", errors.New("grpc method is not specified")
+	}
+	host := setting[GRPCHostKey]
+	if host == "" {
+		host = DefaultGRPCHost
+	}
+	service := setting[GRPCServiceKey]
+	if service == "" {
+		service = DefaultGRPCService
+	}
+	return net.JoinHostPort(host, port), fmt.Sprintf("/%s/%s", service, method), nil
+}
+
+func responseMessage(resp *structpb.Struct) (string, error) {
+	if len(resp.GetFields()) == 0 {
+		return "", nil
+	}
+	if v, ok := resp.Fields[grpcResponseMessageField]; ok && len(resp.Fields) == 1 {
+		if s, ok := v.GetKind().(*structpb.Value_StringValue); ok {
+			return s.StringValue, nil
+		}
+	}
+	b, err := protojson.Marshal(resp)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// convertGRPCError maps the gRPC status code returned by the action server to the kb_agent errors.
+func convertGRPCError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return errors.Wrap(ErrActionFailed, err.Error())
+	}
+	switch s.Code() {
+	case codes.Unimplemented:
+		return errors.Wrap(ErrNotImplemented, s.Message())
+	case codes.InvalidArgument, codes.OutOfRange:
+		return errors.Wrap(ErrInvalidArgs, s.Message())
+	case codes.FailedPrecondition, codes.Aborted:
+		return errors.Wrap(ErrPreconditionFailed, s.Message())
+	case codes.DeadlineExceeded, codes.Canceled:
+		return errors.Wrap(ErrTimeout, s.Message())
+	case codes.Unavailable:
+		return errors.Wrap(ErrUnavailable, s.Message())
+	default:
+		return errors.Wrap(ErrActionFailed, fmt.Sprintf("%s: %s", s.Code().String(), s.Message()))
+	}
 }
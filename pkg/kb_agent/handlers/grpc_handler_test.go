@@ -0,0 +1,158 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package handlers
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/apecloud/kubeblocks/pkg/kb_agent/util"
+)
+
+type mockActionServer struct {
+	handlers map[string]func(*structpb.Struct) (*structpb.Struct, error)
+}
+
+func (s *mockActionServer) serviceDesc() *grpc.ServiceDesc {
+	desc := &grpc.ServiceDesc{
+		ServiceName: DefaultGRPCService,
+		HandlerType: (*any)(nil),
+	}
+	for method := range s.handlers {
+		fn := s.handlers[method]
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: method,
+			Handler: func(_ any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				req := &structpb.Struct{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return fn(req)
+			},
+		})
+	}
+	return desc
+}
+
+func startMockActionServer(t *testing.T, s *mockActionServer) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer()
+	server.RegisterService(s.serviceDesc(), s)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestGRPCHandlerDo(t *testing.T) {
+	ctx := context.Background()
+	server := &mockActionServer{
+		handlers: map[string]func(*structpb.Struct) (*structpb.Struct, error){
+			"RoleProbe": func(req *structpb.Struct) (*structpb.Struct, error) {
+				return structpb.NewStruct(map[string]any{"message": "leader"})
+			},
+			"MemberJoin": func(req *structpb.Struct) (*structpb.Struct, error) {
+				if req.Fields["member"].GetStringValue() == "" {
+					return nil, status.Error(codes.InvalidArgument, "member is empty")
+				}
+				return structpb.NewStruct(map[string]any{"member": req.Fields["member"].GetStringValue(), "joined": true})
+			},
+			"Switchover": func(req *structpb.Struct) (*structpb.Struct, error) {
+				return nil, status.Error(codes.FailedPrecondition, "no healthy candidate")
+			},
+			"Readonly": func(req *structpb.Struct) (*structpb.Struct, error) {
+				return nil, status.Error(codes.Internal, "lock failed")
+			},
+		},
+	}
+	port := startMockActionServer(t, server)
+	handler, err := NewGRPCHandler(nil)
+	assert.Nil(t, err)
+	defer handler.Close()
+
+	spec := func(method string) util.HandlerSpec {
+		return util.HandlerSpec{
+			TimeoutSeconds: 5,
+			GPRC: map[string]string{
+				GRPCPortKey:   port,
+				GRPCMethodKey: method,
+			},
+		}
+	}
+
+	t.Run("grpc setting is nil", func(t *testing.T) {
+		resp, err := handler.Do(ctx, util.HandlerSpec{}, nil)
+		assert.Nil(t, resp)
+		assert.Equal(t, "grpc setting is nil", err.Error())
+	})
+
+	t.Run("grpc port is not specified", func(t *testing.T) {
+		resp, err := handler.Do(ctx, util.HandlerSpec{GPRC: map[string]string{GRPCMethodKey: "RoleProbe"}}, nil)
+		assert.Nil(t, resp)
+		assert.Equal(t, "grpc port is not specified", err.Error())
+	})
+
+	t.Run("message response", func(t *testing.T) {
+		resp, err := handler.Do(ctx, spec("RoleProbe"), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "leader", resp.Message)
+	})
+
+	t.Run("struct response", func(t *testing.T) {
+		resp, err := handler.Do(ctx, spec("MemberJoin"), map[string]any{"member": "pod-1"})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"member":"pod-1","joined":true}`, resp.Message)
+	})
+
+	t.Run("invalid args", func(t *testing.T) {
+		resp, err := handler.Do(ctx, spec("MemberJoin"), nil)
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, ErrInvalidArgs))
+	})
+
+	t.Run("precondition failed", func(t *testing.T) {
+		resp, err := handler.Do(ctx, spec("Switchover"), nil)
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, ErrPreconditionFailed))
+	})
+
+	t.Run("action failed", func(t *testing.T) {
+		resp, err := handler.Do(ctx, spec("Readonly"), nil)
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, ErrActionFailed))
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		resp, err := handler.Do(ctx, spec("DataDump"), nil)
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, ErrNotImplemented))
+	})
+}
@@ -76,13 +76,12 @@ func actionHandler(reqCtx *fasthttp.RequestCtx) {
 	resp, err := handlers.Do(ctx, req.Action, req.Parameters)
 	statusCode := fasthttp.StatusOK
 	if err != nil {
-		if errors.Is(err, handlers.ErrNotImplemented) {
-			statusCode = fasthttp.StatusNotImplemented
-		} else {
-			statusCode = fasthttp.StatusInternalServerError
+		var errorCode string
+		statusCode, errorCode = actionErrorStatus(err)
+		if statusCode == fasthttp.StatusInternalServerError {
 			logger.Info("action exec failed", "action", req.Action, "error", err.Error())
 		}
-		msg := NewErrorResponse("ERR_ACTION_FAILED", fmt.Sprintf("action exec failed: %s", err.Error()))
+		msg := NewErrorResponse(errorCode, fmt.Sprintf("action exec failed: %s", err.Error()))
 		respond(reqCtx, withError(statusCode, msg))
 		return
 	}
@@ -95,6 +94,24 @@ func actionHandler(reqCtx *fasthttp.RequestCtx) {
 	}
 }
 
+// actionErrorStatus returns the HTTP status code and the error code of the action error.
+func actionErrorStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, handlers.ErrNotImplemented):
+		return fasthttp.StatusNotImplemented, "ERR_NOT_IMPLEMENTED"
+	case errors.Is(err, handlers.ErrInvalidArgs):
+		return fasthttp.StatusBadRequest, "ERR_INVALID_ARGS"
+	case errors.Is(err, handlers.ErrPreconditionFailed):
+		return fasthttp.StatusPreconditionFailed, "ERR_PRECONDITION_FAILED"
+	case errors.Is(err, handlers.ErrTimeout):
+		return fasthttp.StatusGatewayTimeout, "ERR_ACTION_TIMEOUT"
+	case errors.Is(err, handlers.ErrUnavailable):
+		return fasthttp.StatusServiceUnavailable, "ERR_UNAVAILABLE"
+	default:
+		return fasthttp.StatusInternalServerError, "ERR_ACTION_FAILED"
+	}
+}
+
 // withJSON overrides the content-type with application/json.
 func withJSON(code int, obj []byte) option {
 	return func(ctx *fasthttp.RequestCtx) {
@@ -24,6 +24,8 @@ import (
 
 	"github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/common"
 )
 
 const KBAgentDefaultPort = 3501
@@ -35,6 +37,7 @@ type Config struct {
 	ConCurrency      int
 	UnixDomainSocket string
 	APILogging       bool
+	SecureAccess     common.SecureAccessConfig
 }
 
 var config Config
@@ -47,4 +50,8 @@ func init() {
 	pflag.StringVar(&config.Address, "address", "0.0.0.0", "The HTTP Server listen address for kb-agent service.")
 	pflag.StringVar(&config.UnixDomainSocket, "unix-socket", ".", "The path of the Unix Domain Socket for kb-agent service.")
 	pflag.BoolVar(&config.APILogging, "api-logging", true, "Enable api logging for kb-agent request.")
+	pflag.StringVar(&config.SecureAccess.CertFile, "tls-cert-file", "", "The TLS certificate file, the HTTP Server serves HTTPS for kb-agent service if it's set.")
+	pflag.StringVar(&config.SecureAccess.KeyFile, "tls-key-file", "", "The TLS private key file matching the TLS certificate.")
+	pflag.StringVar(&config.SecureAccess.ClientCAFile, "client-ca-file", "", "The CA bundle to verify the client certificates, the requests with a verified client certificate are authenticated.")
+	pflag.StringVar(&config.SecureAccess.TokenFile, "auth-token-file", "", "The bearer token file, the requests with the token are authenticated.")
 }
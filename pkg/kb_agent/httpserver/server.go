@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package httpserver
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -28,6 +29,8 @@ import (
 
 	fasthttprouter "github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
+
+	"github.com/apecloud/kubeblocks/pkg/common"
 )
 
 // Server is an interface for the kb-agent HTTP server.
@@ -54,7 +57,11 @@ func NewServer() Server {
 // StartNonBlocking starts a new server in a goroutine.
 func (s *server) StartNonBlocking() error {
 	logger.Info("Starting HTTP Server")
+	if err := s.config.SecureAccess.Validate(); err != nil {
+		return err
+	}
 	handler := s.Router()
+	handler = common.NewAuthenticationHandler(s.config.SecureAccess, handler)
 
 	APILogging := s.config.APILogging
 	if APILogging {
@@ -75,6 +82,9 @@ func (s *server) StartNonBlocking() error {
 		if err != nil {
 			logger.Error(err, "listen address", apiListenAddress, "port", s.config.Port)
 		} else {
+			if s.config.SecureAccess.TLSEnabled() {
+				l = tls.NewListener(l, common.NewServerTLSConfig(s.config.SecureAccess))
+			}
 			listeners = append(listeners, l)
 		}
 	}
@@ -24,8 +24,10 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/spf13/cast"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	. "github.com/apecloud/kubeblocks/pkg/lorry/util"
 )
@@ -48,7 +50,8 @@ func GetMockClient() Client {
 	return mockClient
 }
 
-func NewClient(pod corev1.Pod) (Client, error) {
+// NewClient creates the client to access lorry in the pod, the reader reads the credentials if lorry is secured.
+func NewClient(ctx context.Context, reader client.Reader, pod corev1.Pod) (Client, error) {
 	if mockClient != nil || mockClientError != nil {
 		return mockClient, mockClientError
 	}
@@ -68,7 +71,7 @@ func NewClient(pod corev1.Pod) (Client, error) {
 		return nil, nil
 	}
 
-	httpClient, err := NewHTTPClientWithPod(&pod)
+	httpClient, err := NewHTTPClientWithPod(ctx, reader, &pod)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +105,20 @@ func (cli *lorryClient) GetRole(ctx context.Context) (string, error) {
 	return role.(string), nil
 }
 
+func (cli *lorryClient) GetLag(ctx context.Context) (int64, error) {
+	resp, err := cli.Request(ctx, string(GetLagOperation), http.MethodGet, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	lag, ok := resp["lag"]
+	if !ok {
+		return 0, nil
+	}
+
+	return cast.ToInt64E(lag)
+}
+
 func (cli *lorryClient) CreateUser(ctx context.Context, userName, password, roleName string) error {
 	parameters := map[string]any{
 		"userName": userName,
@@ -125,6 +142,28 @@ func (cli *lorryClient) DeleteUser(ctx context.Context, userName string) error {
 	return err
 }
 
+func (cli *lorryClient) UpdateUserPassword(ctx context.Context, userName, password string, retainCurrent bool) error {
+	parameters := map[string]any{
+		"userName": userName,
+		"password": password,
+	}
+	if retainCurrent {
+		parameters["retainCurrentPassword"] = true
+	}
+	req := map[string]any{"parameters": parameters}
+	_, err := cli.Request(ctx, string(UpdateUserPasswordOp), http.MethodPost, req)
+	return err
+}
+
+func (cli *lorryClient) DiscardOldUserPassword(ctx context.Context, userName string) error {
+	parameters := map[string]any{
+		"userName": userName,
+	}
+	req := map[string]any{"parameters": parameters}
+	_, err := cli.Request(ctx, string(DiscardOldUserPasswordOp), http.MethodPost, req)
+	return err
+}
+
 func (cli *lorryClient) DescribeUser(ctx context.Context, userName string) (map[string]any, error) {
 	parameters := map[string]any{
 		"userName": userName,
@@ -243,6 +282,12 @@ func (cli *lorryClient) PreTerminate(ctx context.Context) error {
 	return err
 }
 
+// Reconfigure sends a reconfigure request to Lorry.
+func (cli *lorryClient) Reconfigure(ctx context.Context) error {
+	_, err := cli.Request(ctx, string(ReconfigureOperation), http.MethodPost, nil)
+	return err
+}
+
 // Rebuild sends a slave rebuild request to Lorry.
 func (cli *lorryClient) Rebuild(ctx context.Context) error {
 	_, err := cli.Request(ctx, "rebuild", http.MethodPost, nil)
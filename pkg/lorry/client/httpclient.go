@@ -22,11 +22,14 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
@@ -34,15 +37,22 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/apecloud/kubeblocks/pkg/common"
+	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
 const (
-	urlTemplate = "http://%s:%d/v1.0/"
+	urlTemplate = "%s://%s:%d/v1.0/"
 )
 
+// serviceAccountTokenPath is the service account token of KubeBlocks to access the secured lorry.
+var serviceAccountTokenPath = constant.LorryServiceAccountTokenPath
+
 var NotImplemented = errors.New("NotImplemented")
 
 type HTTPClient struct {
@@ -52,7 +62,9 @@ type HTTPClient struct {
 	CacheTTL         time.Duration
 	ReconcileTimeout time.Duration
 	RequestTimeout   time.Duration
-	logger           logr.Logger
+	// token is the bearer token to authenticate with the secured lorry
+	token  string
+	logger logr.Logger
 }
 
 var _ Client = &HTTPClient{}
@@ -66,7 +78,8 @@ type OperationResult struct {
 
 var cache map[string]*OperationResult = make(map[string]*OperationResult)
 
-func NewHTTPClientWithPod(pod *corev1.Pod) (*HTTPClient, error) {
+// NewHTTPClientWithPod creates the client to access lorry in the pod, the credentials of the secured lorry are read by the reader.
+func NewHTTPClientWithPod(ctx context.Context, reader client.Reader, pod *corev1.Pod) (*HTTPClient, error) {
 	logger := ctrl.Log.WithName("Lorry HTTP client")
 	port, err := intctrlutil.GetLorryHTTPPort(pod)
 	if err != nil {
@@ -79,6 +92,16 @@ func NewHTTPClientWithPod(pod *corev1.Pod) (*HTTPClient, error) {
 		return nil, fmt.Errorf("pod %v has no ip", pod.Name)
 	}
 
+	scheme := "http"
+	var tlsConfig *tls.Config
+	var token string
+	if intctrlutil.IsLorrySecureAccessEnabled(intctrlutil.GetLorryContainer(pod.Spec.Containers)) {
+		scheme = "https"
+		if tlsConfig, token, err = loadSecureAccessCredential(ctx, reader, pod); err != nil {
+			return nil, err
+		}
+	}
+
 	// don't use default http-client
 	dialer := &net.Dialer{
 		Timeout: 5 * time.Second,
@@ -86,6 +109,7 @@ func NewHTTPClientWithPod(pod *corev1.Pod) (*HTTPClient, error) {
 	netTransport := &http.Transport{
 		Dial:                dialer.Dial,
 		TLSHandshakeTimeout: 5 * time.Second,
+		TLSClientConfig:     tlsConfig,
 	}
 	client := &http.Client{
 		Timeout:   time.Second * 30,
@@ -94,10 +118,11 @@ func NewHTTPClientWithPod(pod *corev1.Pod) (*HTTPClient, error) {
 
 	operationClient := &HTTPClient{
 		Client:           client,
-		URL:              fmt.Sprintf(urlTemplate, ip, port),
+		URL:              fmt.Sprintf(urlTemplate, scheme, ip, port),
 		CacheTTL:         1800 * time.Second,
 		RequestTimeout:   300 * time.Second,
 		ReconcileTimeout: 500 * time.Millisecond,
+		token:            token,
 		logger:           ctrl.Log.WithName("Lorry HTTP client"),
 	}
 	operationClient.lorryClient = lorryClient{requester: operationClient}
@@ -109,6 +134,15 @@ func NewHTTPClientWithURL(url string) (*HTTPClient, error) {
 		return nil, fmt.Errorf("no url")
 	}
 
+	// the tools running in the lorry pod use the credentials mounted into it if lorry is secured
+	tlsConfig, token, err := loadLocalSecureAccessCredential()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		url = strings.Replace(url, "http://", "https://", 1)
+	}
+
 	// don't use default http-client
 	dialer := &net.Dialer{
 		Timeout: 5 * time.Second,
@@ -116,6 +150,7 @@ func NewHTTPClientWithURL(url string) (*HTTPClient, error) {
 	netTransport := &http.Transport{
 		Dial:                dialer.Dial,
 		TLSHandshakeTimeout: 5 * time.Second,
+		TLSClientConfig:     tlsConfig,
 	}
 	client := &http.Client{
 		Timeout:   time.Second * 30,
@@ -128,11 +163,69 @@ func NewHTTPClientWithURL(url string) (*HTTPClient, error) {
 		CacheTTL:         1800 * time.Second,
 		RequestTimeout:   300 * time.Second,
 		ReconcileTimeout: 500 * time.Millisecond,
+		token:            token,
 	}
 	operationClient.lorryClient = lorryClient{requester: operationClient}
 	return operationClient, nil
 }
 
+// loadSecureAccessCredential loads the credentials to access the secured lorry in the pod from the secret of its component.
+func loadSecureAccessCredential(ctx context.Context, reader client.Reader, pod *corev1.Pod) (*tls.Config, string, error) {
+	if reader == nil {
+		return nil, "", fmt.Errorf("lorry in pod %s is secured, but no credential is available to access it", pod.Name)
+	}
+	clusterName := pod.Labels[constant.AppInstanceLabelKey]
+	compName := pod.Labels[constant.KBAppComponentLabelKey]
+	if clusterName == "" || compName == "" {
+		return nil, "", fmt.Errorf("pod %s has no cluster or component label to locate the lorry credentials", pod.Name)
+	}
+	secret := &corev1.Secret{}
+	secretKey := types.NamespacedName{Namespace: pod.Namespace, Name: constant.GenerateLorrySecureAccessSecretName(clusterName, compName)}
+	if err := reader.Get(ctx, secretKey, secret); err != nil {
+		return nil, "", errors.Wrap(err, "get lorry credentials failed")
+	}
+	tlsConfig, err := common.NewClientTLSConfig(secret.Data[constant.CAName], secret.Data[constant.CertName], secret.Data[constant.KeyName])
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "invalid lorry credentials in secret %s", secretKey.Name)
+	}
+	token := string(secret.Data[constant.LorryTokenName])
+	// the service account token is preferred to the shared token of the component if it's projected,
+	// the token is rotated by kubelet, so it's read each time.
+	if saToken, err := os.ReadFile(serviceAccountTokenPath); err == nil && len(bytes.TrimSpace(saToken)) != 0 {
+		token = string(bytes.TrimSpace(saToken))
+	}
+	return tlsConfig, token, nil
+}
+
+// loadLocalSecureAccessCredential loads the lorry credentials mounted into the pod, it returns nothing if there is none.
+func loadLocalSecureAccessCredential() (*tls.Config, string, error) {
+	dir := constant.LorrySecureAccessMountPath
+	caPEM, err := os.ReadFile(filepath.Join(dir, constant.CAName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+	certPEM, err := os.ReadFile(filepath.Join(dir, constant.CertName))
+	if err != nil {
+		return nil, "", err
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(dir, constant.KeyName))
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := os.ReadFile(filepath.Join(dir, constant.LorryTokenName))
+	if err != nil {
+		return nil, "", err
+	}
+	tlsConfig, err := common.NewClientTLSConfig(caPEM, certPEM, keyPEM)
+	if err != nil {
+		return nil, "", err
+	}
+	return tlsConfig, strings.TrimSpace(string(token)), nil
+}
+
 func (cli *HTTPClient) Request(ctx context.Context, operation, method string, req map[string]any) (map[string]any, error) {
 	ctxWithReconcileTimeout, cancel := context.WithTimeout(ctx, cli.ReconcileTimeout)
 	defer cancel()
@@ -206,6 +299,9 @@ func (cli *HTTPClient) InvokeComponent(ctxWithReconcileTimeout context.Context,
 		ch <- operationRes
 		return
 	}
+	if cli.token != "" {
+		req.Header.Set("Authorization", "Bearer "+cli.token)
+	}
 
 	mapKey := GetMapKeyFromRequest(req)
 	operationRes, ok := cache[mapKey]
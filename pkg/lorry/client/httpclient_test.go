@@ -21,11 +21,20 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -34,6 +43,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
@@ -81,7 +93,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		It("without lorry service, return nil", func() {
 			podWithoutLorry := pod.DeepCopy()
 			podWithoutLorry.Spec.Containers[0].Ports = nil
-			lorryClient, err := NewHTTPClientWithPod(podWithoutLorry)
+			lorryClient, err := NewHTTPClientWithPod(context.TODO(), nil, podWithoutLorry)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(lorryClient).Should(BeNil())
 		})
@@ -89,17 +101,93 @@ var _ = Describe("Lorry HTTP Client", func() {
 		It("without pod ip, failed", func() {
 			podWithoutPodIP := pod.DeepCopy()
 			podWithoutPodIP.Status.PodIP = ""
-			_, err := NewHTTPClientWithPod(podWithoutPodIP)
+			_, err := NewHTTPClientWithPod(context.TODO(), nil, podWithoutPodIP)
 			Expect(err).Should(HaveOccurred())
 		})
 
 		It("success", func() {
-			lorryClient, err := NewHTTPClientWithPod(pod)
+			lorryClient, err := NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 	})
 
+	Context("secured lorry", func() {
+		var httpServer *httptest.Server
+		var securedPod *corev1.Pod
+		var secretReader client.Reader
+
+		BeforeEach(func() {
+			certPEM, keyPEM := newSelfSignedCert()
+			keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+			Expect(err).ShouldNot(HaveOccurred())
+			httpServer = httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				if len(request.TLS.PeerCertificates) == 0 || request.Header.Get("Authorization") != "Bearer test-token" {
+					writer.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = writer.Write([]byte(`{"role": "leader"}`))
+			}))
+			httpServer.TLS = &tls.Config{
+				Certificates: []tls.Certificate{keyPair},
+				ClientAuth:   tls.RequireAnyClientCert,
+			}
+			httpServer.StartTLS()
+			port := httpServer.Listener.Addr().(*net.TCPAddr).Port
+
+			securedPod = pod.DeepCopy()
+			securedPod.Labels = map[string]string{
+				constant.AppInstanceLabelKey:    "test-cluster",
+				constant.KBAppComponentLabelKey: "test-comp",
+			}
+			securedPod.Spec.Containers[0].Command = append(securedPod.Spec.Containers[0].Command, "--tls-cert-file", "tls.crt")
+			securedPod.Spec.Containers[0].Ports[0].ContainerPort = int32(port)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: securedPod.Namespace,
+					Name:      constant.GenerateLorrySecureAccessSecretName("test-cluster", "test-comp"),
+				},
+				Data: map[string][]byte{
+					constant.CAName:         certPEM,
+					constant.CertName:       certPEM,
+					constant.KeyName:        keyPEM,
+					constant.LorryTokenName: []byte("test-token"),
+				},
+			}
+			secretReader = fake.NewClientBuilder().WithObjects(secret).Build()
+			cache = make(map[string]*OperationResult)
+		})
+
+		AfterEach(func() {
+			httpServer.Close()
+		})
+
+		It("access with the credentials of the component", func() {
+			lorryClient, err := NewHTTPClientWithPod(context.TODO(), secretReader, securedPod)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(lorryClient.URL).Should(HavePrefix("https://"))
+			lorryClient.ReconcileTimeout = 5 * time.Second
+			role, err := lorryClient.GetRole(context.TODO())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(role).Should(Equal("leader"))
+		})
+
+		It("access with the service account token", func() {
+			tokenPath := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenPath, []byte("sa-token\n"), 0600)).Should(Succeed())
+			serviceAccountTokenPath = tokenPath
+			defer func() { serviceAccountTokenPath = constant.LorryServiceAccountTokenPath }()
+			lorryClient, err := NewHTTPClientWithPod(context.TODO(), secretReader, securedPod)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(lorryClient.token).Should(Equal("sa-token"))
+		})
+
+		It("fails without the credentials", func() {
+			_, err := NewHTTPClientWithPod(context.TODO(), nil, securedPod)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	Context("request with timeout", func() {
 		var httpServer *httptest.Server
 		var port int
@@ -110,7 +198,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 			body := []byte("{\"role\": \"leader\"}")
 			httpServer, port = newHTTPServer(body)
 			pod1.Spec.Containers[0].Ports[0].ContainerPort = int32(port)
-			lorryClient, _ = NewHTTPClientWithPod(pod1)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod1)
 			Expect(lorryClient).ShouldNot(BeNil())
 			cache = make(map[string]*OperationResult)
 		})
@@ -153,7 +241,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 
@@ -179,7 +267,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var systemAccounts []models.UserInfo
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			systemAccounts = []models.UserInfo{
 				{
@@ -209,7 +297,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 
@@ -232,7 +320,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 
@@ -249,12 +337,54 @@ var _ = Describe("Lorry HTTP Client", func() {
 		})
 	})
 
+	Context("update user password", func() {
+		var lorryClient *HTTPClient
+
+		BeforeEach(func() {
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
+			Expect(lorryClient).ShouldNot(BeNil())
+		})
+
+		It("success", func() {
+			mockDBManager.EXPECT().UpdateUserPassword(gomock.Any(), "user-test", "password-test", true).Return(nil)
+			Expect(lorryClient.UpdateUserPassword(context.TODO(), "user-test", "password-test", true)).Should(Succeed())
+		})
+
+		It("not implemented", func() {
+			mockDBManager.EXPECT().UpdateUserPassword(gomock.Any(), gomock.Any(), gomock.Any(), false).Return(fmt.Errorf(msg))
+			err := lorryClient.UpdateUserPassword(context.TODO(), "user-test", "password-test", false)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(msg))
+		})
+	})
+
+	Context("discard old user password", func() {
+		var lorryClient *HTTPClient
+
+		BeforeEach(func() {
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
+			Expect(lorryClient).ShouldNot(BeNil())
+		})
+
+		It("success", func() {
+			mockDBManager.EXPECT().DiscardOldUserPassword(gomock.Any(), "user-test").Return(nil)
+			Expect(lorryClient.DiscardOldUserPassword(context.TODO(), "user-test")).Should(Succeed())
+		})
+
+		It("not implemented", func() {
+			mockDBManager.EXPECT().DiscardOldUserPassword(gomock.Any(), gomock.Any()).Return(fmt.Errorf(msg))
+			err := lorryClient.DiscardOldUserPassword(context.TODO(), "user-test")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(msg))
+		})
+	})
+
 	Context("describe user", func() {
 		var lorryClient *HTTPClient
 		var userInfo *models.UserInfo
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			userInfo = &models.UserInfo{
 				UserName: "kb-admin1",
@@ -280,7 +410,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 
@@ -301,7 +431,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 
@@ -323,7 +453,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var users []models.UserInfo
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			users = []models.UserInfo{
 				{
@@ -355,7 +485,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var podName = "pod-test"
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			cluster = &dcs.Cluster{
 				Members: []dcs.Member{{Name: podName}},
@@ -430,7 +560,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var podName string
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			podName = "pod-test"
 
@@ -519,7 +649,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 		})
 
@@ -609,7 +739,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			os.Unsetenv(constant.KBEnvPodFQDN)
 			os.Unsetenv(constant.KBEnvServicePort)
@@ -667,7 +797,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			os.Unsetenv(constant.KBEnvPodFQDN)
 			os.Unsetenv(constant.KBEnvServicePort)
@@ -724,7 +854,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			os.Unsetenv(constant.KBEnvPodFQDN)
 			os.Unsetenv(constant.KBEnvServicePort)
@@ -775,7 +905,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		var lorryClient *HTTPClient
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			os.Unsetenv(constant.KBEnvPodFQDN)
 			os.Unsetenv(constant.KBEnvServicePort)
@@ -828,7 +958,7 @@ var _ = Describe("Lorry HTTP Client", func() {
 		podName := "pod-test"
 
 		BeforeEach(func() {
-			lorryClient, _ = NewHTTPClientWithPod(pod)
+			lorryClient, _ = NewHTTPClientWithPod(context.TODO(), nil, pod)
 			Expect(lorryClient).ShouldNot(BeNil())
 			cluster = &dcs.Cluster{
 				Members: []dcs.Member{{Name: podName}},
@@ -870,3 +1000,23 @@ func newHTTPServer(resp []byte) (*httptest.Server, int) {
 	port, _ := strconv.Atoi(portStr)
 	return s, port
 }
+
+func newSelfSignedCert() ([]byte, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ShouldNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		DNSNames:              []string{constant.LorrySecureAccessServerName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ShouldNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
@@ -25,6 +25,9 @@ type Client interface {
 	// GetRole return the replication role(like primary/secondary) of the target replica
 	GetRole(ctx context.Context) (string, error)
 
+	// GetLag return the replication lag in seconds of the target replica
+	GetLag(ctx context.Context) (int64, error)
+
 	// user management funcs
 	CreateUser(ctx context.Context, userName, password, roleName string) error
 	DeleteUser(ctx context.Context, userName string) error
@@ -33,6 +36,11 @@ type Client interface {
 	RevokeUserRole(ctx context.Context, userName, roleName string) error
 	ListUsers(ctx context.Context) ([]map[string]any, error)
 	ListSystemAccounts(ctx context.Context) ([]map[string]any, error)
+	// UpdateUserPassword updates the password of the user, and keeps the current password valid
+	// as a secondary one if retainCurrent is true and the engine supports dual passwords.
+	UpdateUserPassword(ctx context.Context, userName, password string, retainCurrent bool) error
+	// DiscardOldUserPassword discards the secondary password retained by UpdateUserPassword.
+	DiscardOldUserPassword(ctx context.Context, userName string) error
 
 	// JoinMember sends a join member operation request to Lorry, located on the target pod that is about to join.
 	JoinMember(ctx context.Context) error
@@ -45,6 +53,8 @@ type Client interface {
 	Unlock(ctx context.Context) error
 	PostProvision(ctx context.Context, componentNames, podNames, podIPs, podHostNames, podHostIPs string) error
 	PreTerminate(ctx context.Context) error
+	// Reconfigure asks the replica to reload its configuration, e.g. the renewed TLS certificates.
+	Reconfigure(ctx context.Context) error
 
 	// local rebuild slave
 	Rebuild(ctx context.Context) error
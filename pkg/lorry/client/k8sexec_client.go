@@ -40,6 +40,7 @@ import (
 	cmdexec "k8s.io/kubectl/pkg/cmd/exec"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/apecloud/kubeblocks/pkg/constant"
 	intctrlutil "github.com/apecloud/kubeblocks/pkg/controllerutil"
 )
 
@@ -51,6 +52,7 @@ type K8sExecClient struct {
 	restConfig     *rest.Config
 	restClient     *rest.RESTClient
 	lorryPort      int32
+	secureAccess   bool
 	RequestTimeout time.Duration
 	logger         logr.Logger
 }
@@ -100,6 +102,7 @@ func NewK8sExecClientWithPod(restConfig *rest.Config, pod *corev1.Pod) (*K8sExec
 	client := &K8sExecClient{
 		StreamOptions:  streamOptions,
 		lorryPort:      port,
+		secureAccess:   intctrlutil.IsLorrySecureAccessEnabled(intctrlutil.GetLorryContainer(pod.Spec.Containers)),
 		restConfig:     restConfig,
 		restClient:     restClient,
 		RequestTimeout: 10 * time.Second,
@@ -119,6 +122,14 @@ func (cli *K8sExecClient) Request(ctx context.Context, operation, method string,
 	)
 	curlCmd := fmt.Sprintf("curl --fail-with-body --silent -X %s -H 'Content-Type: application/json' http://localhost:%d/v1.0/%s",
 		strings.ToUpper(method), cli.lorryPort, strings.ToLower(operation))
+	if cli.secureAccess {
+		// authenticate with the credentials mounted in the lorry container
+		dir := constant.LorrySecureAccessMountPath
+		curlCmd = fmt.Sprintf("curl --fail-with-body --silent -X %s -H 'Content-Type: application/json' "+
+			"--cacert %s/%s --cert %s/%s --key %s/%s --resolve %s:%d:127.0.0.1 https://%s:%d/v1.0/%s",
+			strings.ToUpper(method), dir, constant.CAName, dir, constant.CertName, dir, constant.KeyName,
+			constant.LorrySecureAccessServerName, cli.lorryPort, constant.LorrySecureAccessServerName, cli.lorryPort, strings.ToLower(operation))
+	}
 
 	if len(req) != 0 {
 		jsonData, err := json.Marshal(req)
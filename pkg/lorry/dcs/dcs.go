@@ -20,10 +20,22 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package dcs
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
 
+const (
+	KubernetesDCS = "kubernetes"
+	EtcdDCS       = "etcd"
+)
+
 type DCS interface {
 	Initialize() error
 
@@ -74,11 +86,73 @@ func GetStore() DCS {
 	return dcs
 }
 
+// InitStore initializes the DCS store according to the configured DCS type, the Kubernetes store is used by default.
 func InitStore() error {
-	store, err := NewKubernetesStore()
+	var (
+		store DCS
+		err   error
+	)
+	switch dcsType := strings.ToLower(viper.GetString(constant.KBEnvDCSType)); dcsType {
+	case "", KubernetesDCS:
+		store, err = NewKubernetesStore()
+	case EtcdDCS:
+		store, err = NewEtcdStore()
+	default:
+		err = errors.Errorf("unknown DCS type: %s", dcsType)
+	}
 	if err != nil {
 		return err
 	}
 	dcs = store
 	return nil
 }
+
+// memberEnvs describes the current member, which are shared by all DCS stores.
+type memberEnvs struct {
+	clusterName         string
+	componentName       string
+	clusterCompName     string
+	currentMemberName   string
+	namespace           string
+	isLeaderClusterWide bool
+}
+
+func loadMemberEnvs() (*memberEnvs, error) {
+	clusterName := os.Getenv(constant.KBEnvClusterName)
+	if clusterName == "" {
+		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvClusterName))
+	}
+
+	componentName := os.Getenv(constant.KBEnvCompName)
+	if componentName == "" {
+		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvCompName))
+	}
+
+	clusterCompName := os.Getenv(constant.KBEnvClusterCompName)
+	if clusterCompName == "" {
+		clusterCompName = clusterName + "-" + componentName
+	}
+
+	currentMemberName := os.Getenv(constant.KBEnvPodName)
+	if currentMemberName == "" {
+		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvPodName))
+	}
+
+	namespace := os.Getenv(constant.KBEnvNamespace)
+	if namespace == "" {
+		return nil, errors.New("KB_NAMESPACE must be set")
+	}
+
+	characterType := viper.GetString(constant.KBEnvCharacterType)
+	if viper.IsSet(constant.KBEnvBuiltinHandler) {
+		characterType = viper.GetString(constant.KBEnvBuiltinHandler)
+	}
+	return &memberEnvs{
+		clusterName:         clusterName,
+		componentName:       componentName,
+		clusterCompName:     clusterCompName,
+		currentMemberName:   currentMemberName,
+		namespace:           namespace,
+		isLeaderClusterWide: characterType == string(models.Oceanbase),
+	}, nil
+}
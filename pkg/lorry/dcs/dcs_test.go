@@ -0,0 +1,170 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dcs
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+const (
+	testNamespace   = "default"
+	testClusterName = "test-cluster"
+	testCompName    = "mysql"
+)
+
+// newStoreFunc creates the store of the member, all the stores created by
+// the same function share the same backend.
+type newStoreFunc func(memberName string) DCS
+
+func setMemberEnvs(memberName string) {
+	Expect(os.Setenv(constant.KBEnvNamespace, testNamespace)).Should(Succeed())
+	Expect(os.Setenv(constant.KBEnvClusterName, testClusterName)).Should(Succeed())
+	Expect(os.Setenv(constant.KBEnvCompName, testCompName)).Should(Succeed())
+	Expect(os.Setenv(constant.KBEnvPodName, memberName)).Should(Succeed())
+	Expect(os.Setenv(constant.KBEnvPodUID, memberUID(memberName))).Should(Succeed())
+}
+
+func memberUID(memberName string) string {
+	return "uid-" + memberName
+}
+
+// describeStoreBehaviors describes the behaviors that all DCS stores should have.
+func describeStoreBehaviors(newBackend func() newStoreFunc) {
+	var newStore newStoreFunc
+
+	newInitializedStore := func(memberName string) DCS {
+		store := newStore(memberName)
+		Expect(store.Initialize()).Should(Succeed())
+		_, err := store.GetCluster()
+		Expect(err).Should(Succeed())
+		return store
+	}
+
+	BeforeEach(func() {
+		newStore = newBackend()
+	})
+
+	It("initializes the HA config and the leader lease", func() {
+		store := newInitializedStore("pod-0")
+		cluster, err := store.GetCluster()
+		Expect(err).Should(Succeed())
+		Expect(cluster.HaConfig.IsEnable()).Should(BeTrue())
+		Expect(cluster.HaConfig.GetTTL()).Should(Equal(15))
+		Expect(cluster.HasMember("pod-0")).Should(BeTrue())
+		Expect(cluster.GetMemberWithName("pod-0").UID).Should(Equal(memberUID("pod-0")))
+
+		exist, err := store.IsLeaseExist()
+		Expect(err).Should(Succeed())
+		Expect(exist).Should(BeTrue())
+		Expect(cluster.Leader.Name).Should(Equal("pod-0"))
+		Expect(store.HasLease()).Should(BeTrue())
+
+		By("initializing another member keeps the leader")
+		another := newInitializedStore("pod-1")
+		Expect(another.HasLease()).Should(BeFalse())
+		leader, err := another.GetLeader()
+		Expect(err).Should(Succeed())
+		Expect(leader.Name).Should(Equal("pod-0"))
+	})
+
+	It("renews, releases and acquires the lease", func() {
+		store0 := newInitializedStore("pod-0")
+		store1 := newInitializedStore("pod-1")
+
+		By("renewing the lease by the leader")
+		Expect(store0.UpdateLease()).Should(Succeed())
+		Expect(store0.HasLease()).Should(BeTrue())
+
+		By("releasing the lease by the leader")
+		Expect(store0.ReleaseLease()).Should(Succeed())
+		cluster, err := store1.GetCluster()
+		Expect(err).Should(Succeed())
+		Expect(cluster.IsLocked()).Should(BeFalse())
+
+		By("acquiring the lease by another member")
+		Expect(store1.AttemptAcquireLease()).Should(Succeed())
+		_, err = store1.GetCluster()
+		Expect(err).Should(Succeed())
+		Expect(store1.HasLease()).Should(BeTrue())
+		leader, err := store0.GetLeader()
+		Expect(err).Should(Succeed())
+		Expect(leader.Name).Should(Equal("pod-1"))
+
+		By("renewing the lease by the old leader fails")
+		_, err = store0.GetCluster()
+		Expect(err).Should(Succeed())
+		Expect(store0.HasLease()).Should(BeFalse())
+		Expect(store0.UpdateLease()).ShouldNot(Succeed())
+	})
+
+	It("expires the lease which is not renewed in time", func() {
+		store := newInitializedStore("pod-0")
+		cluster := store.GetClusterFromCache()
+		cluster.HaConfig.ttl = 1
+		Expect(store.UpdateLease()).Should(Succeed())
+
+		Eventually(func(g Gomega) {
+			leader, err := store.GetLeader()
+			g.Expect(err).Should(Succeed())
+			g.Expect(leader.Name).Should(BeEmpty())
+		}).WithTimeout(5 * time.Second).WithPolling(500 * time.Millisecond).Should(Succeed())
+	})
+
+	It("manages the switchover", func() {
+		store := newInitializedStore("pod-0")
+		switchover, err := store.GetSwitchover()
+		Expect(err).Should(Succeed())
+		Expect(switchover).Should(BeNil())
+
+		Expect(store.CreateSwitchover("pod-0", "pod-1")).Should(Succeed())
+		switchover, err = store.GetSwitchover()
+		Expect(err).Should(Succeed())
+		Expect(switchover.GetLeader()).Should(Equal("pod-0"))
+		Expect(switchover.GetCandidate()).Should(Equal("pod-1"))
+
+		By("creating another switchover fails")
+		Expect(store.CreateSwitchover("pod-0", "")).ShouldNot(Succeed())
+
+		Expect(store.DeleteSwitchover()).Should(Succeed())
+		switchover, err = store.GetSwitchover()
+		Expect(err).Should(Succeed())
+		Expect(switchover).Should(BeNil())
+	})
+
+	It("updates the HA config", func() {
+		store := newInitializedStore("pod-0")
+		cluster := store.GetClusterFromCache()
+		member := cluster.GetMemberWithName("pod-0")
+		Expect(member).ShouldNot(BeNil())
+		cluster.HaConfig.AddMemberToDelete(member)
+		Expect(store.UpdateHaConfig()).Should(Succeed())
+
+		haConfig, err := store.GetHaConfig()
+		Expect(err).Should(Succeed())
+		Expect(haConfig.IsDeleting(member)).Should(BeTrue())
+		Expect(haConfig.IsDeleted(member)).Should(BeFalse())
+	})
+}
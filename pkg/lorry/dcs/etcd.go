@@ -0,0 +1,632 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dcs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+const (
+	defaultEtcdPrefix     = "/kubeblocks"
+	etcdDialTimeout       = 5 * time.Second
+	etcdRequestTimeout    = 5 * time.Second
+	defaultMaxLagOnSwitch = 1048576
+
+	etcdLeaderKey     = "leader"
+	etcdHaConfigKey   = "haconfig"
+	etcdSwitchoverKey = "switchover"
+	etcdMembersKey    = "members"
+)
+
+func init() {
+	viper.SetDefault(constant.KBEnvDCSEtcdPrefix, defaultEtcdPrefix)
+}
+
+// EtcdStore is a DCS implementation on an external etcd, which keeps the failover
+// away from the Kubernetes API server. The records are stored as JSON values under
+// <prefix>/<namespace>/<cluster component name>, and the mod revisions of the keys
+// are used to guard the concurrent updates.
+type EtcdStore struct {
+	ctx                 context.Context
+	clusterName         string
+	componentName       string
+	clusterCompName     string
+	currentMemberName   string
+	namespace           string
+	keyPrefix           string
+	cluster             *Cluster
+	client              *clientv3.Client
+	memberLease         clientv3.LeaseID
+	logger              logr.Logger
+	IsLeaderClusterWide bool
+}
+
+type etcdLeaderRecord struct {
+	Leader      string   `json:"leader"`
+	AcquireTime int64    `json:"acquireTime"`
+	RenewTime   int64    `json:"renewTime"`
+	TTL         int      `json:"ttl"`
+	DBState     *DBState `json:"dbState,omitempty"`
+}
+
+type etcdHaConfigRecord struct {
+	TTL                int                       `json:"ttl"`
+	Enable             bool                      `json:"enable"`
+	MaxLagOnSwitchover int64                     `json:"maxLagOnSwitchover"`
+	DeleteMembers      map[string]MemberToDelete `json:"deleteMembers,omitempty"`
+}
+
+type etcdSwitchoverRecord struct {
+	Leader      string `json:"leader"`
+	Candidate   string `json:"candidate"`
+	ScheduledAt int64  `json:"scheduledAt"`
+}
+
+type etcdMemberRecord struct {
+	Name          string `json:"name"`
+	ComponentName string `json:"componentName"`
+	PodIP         string `json:"podIP"`
+	DBPort        string `json:"dbPort"`
+	LorryPort     string `json:"lorryPort"`
+	HAPort        string `json:"haPort,omitempty"`
+	UID           string `json:"uid"`
+	UseIP         bool   `json:"useIP,omitempty"`
+}
+
+func NewEtcdStore() (*EtcdStore, error) {
+	endpoints := strings.Split(viper.GetString(constant.KBEnvDCSEtcdEndpoints), ",")
+	if len(endpoints) == 0 || endpoints[0] == "" {
+		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvDCSEtcdEndpoints))
+	}
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: etcdDialTimeout,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "etcd client init failed")
+	}
+	return newEtcdStoreWithClient(client)
+}
+
+func newEtcdStoreWithClient(client *clientv3.Client) (*EtcdStore, error) {
+	envs, err := loadMemberEnvs()
+	if err != nil {
+		return nil, err
+	}
+	scopeName := envs.clusterCompName
+	if envs.isLeaderClusterWide {
+		scopeName = envs.clusterName
+	}
+	return &EtcdStore{
+		ctx:                 context.Background(),
+		clusterName:         envs.clusterName,
+		componentName:       envs.componentName,
+		clusterCompName:     envs.clusterCompName,
+		currentMemberName:   envs.currentMemberName,
+		namespace:           envs.namespace,
+		keyPrefix:           path.Join(viper.GetString(constant.KBEnvDCSEtcdPrefix), envs.namespace, scopeName),
+		client:              client,
+		logger:              ctrl.Log.WithName("DCS-ETCD"),
+		IsLeaderClusterWide: envs.isLeaderClusterWide,
+	}, nil
+}
+
+func (store *EtcdStore) Initialize() error {
+	store.logger.Info("etcd store initializing")
+	_, err := store.GetCluster()
+	if err != nil {
+		return err
+	}
+
+	err = store.CreateHaConfig()
+	if err != nil {
+		store.logger.Error(err, "Create Ha config failed")
+	}
+
+	err = store.CreateLease()
+	if err != nil {
+		store.logger.Error(err, "Create Leader lease failed")
+	}
+	return err
+}
+
+func (store *EtcdStore) GetClusterName() string {
+	return store.clusterName
+}
+
+func (store *EtcdStore) GetClusterFromCache() *Cluster {
+	if store.cluster != nil {
+		return store.cluster
+	}
+	cluster, _ := store.GetCluster()
+	return cluster
+}
+
+func (store *EtcdStore) GetCluster() (*Cluster, error) {
+	members, err := store.GetMembers()
+	if err != nil {
+		return nil, err
+	}
+	if !hasMember(members, store.currentMemberName) {
+		// the lease of the current member is lost, register it again
+		store.memberLease = clientv3.NoLease
+		if err = store.AddCurrentMember(); err != nil {
+			return nil, err
+		}
+		if members, err = store.GetMembers(); err != nil {
+			return nil, err
+		}
+	}
+
+	// the replicas is taken from the env to not depend on the API server,
+	// fall back to the registered members if it's not set.
+	replicas := viper.GetInt32(constant.KBEnvCompReplicas)
+	if replicas == 0 {
+		replicas = int32(len(members))
+	}
+
+	leader, err := store.GetLeader()
+	if err != nil {
+		store.logger.Info("get leader failed", "error", err.Error())
+	}
+
+	switchover, err := store.GetSwitchover()
+	if err != nil {
+		store.logger.Info("get switchover failed", "error", err.Error())
+	}
+
+	haConfig, err := store.GetHaConfig()
+	if err != nil {
+		store.logger.Info("get HaConfig failed", "error", err.Error())
+	}
+
+	cluster := &Cluster{
+		ClusterCompName: store.clusterCompName,
+		Namespace:       store.namespace,
+		Replicas:        replicas,
+		Members:         members,
+		Leader:          leader,
+		Switchover:      switchover,
+		HaConfig:        haConfig,
+	}
+
+	store.cluster = cluster
+	return cluster, nil
+}
+
+// GetMembers returns the members registered by AddCurrentMember, the member record
+// is bound to an etcd lease and disappears if the member stops renewing it.
+func (store *EtcdStore) GetMembers() ([]Member, error) {
+	resp, err := store.get(path.Join(store.keyPrefix, etcdMembersKey)+"/", clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+	members := make([]Member, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		record := &etcdMemberRecord{}
+		if err = json.Unmarshal(kv.Value, record); err != nil {
+			store.logger.Info("unmarshal member failed", "key", string(kv.Key), "error", err.Error())
+			continue
+		}
+		members = append(members, Member{
+			Index:         strconv.FormatInt(kv.ModRevision, 10),
+			Name:          record.Name,
+			ComponentName: record.ComponentName,
+			PodIP:         record.PodIP,
+			DBPort:        record.DBPort,
+			LorryPort:     record.LorryPort,
+			HAPort:        record.HAPort,
+			UID:           record.UID,
+			UseIP:         record.UseIP,
+			resource:      record,
+		})
+	}
+	return members, nil
+}
+
+// AddCurrentMember registers the current member with a lease, and keeps the lease alive in the background.
+func (store *EtcdStore) AddCurrentMember() error {
+	if store.memberLease != clientv3.NoLease {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(store.ctx, etcdRequestTimeout)
+	defer cancel()
+	lease, err := store.client.Grant(ctx, int64(viper.GetInt(constant.KBEnvTTL)))
+	if err != nil {
+		return err
+	}
+
+	record := &etcdMemberRecord{
+		Name:          store.currentMemberName,
+		ComponentName: store.componentName,
+		PodIP:         viper.GetString(constant.KBEnvPodIP),
+		DBPort:        viper.GetString(constant.KBEnvServicePort),
+		LorryPort:     viper.GetString(constant.KBEnvLorryHTTPPort),
+		UID:           viper.GetString(constant.KBEnvPodUID),
+	}
+	value, _ := json.Marshal(record)
+	if _, err = store.client.Put(ctx, store.memberKey(store.currentMemberName), string(value), clientv3.WithLease(lease.ID)); err != nil {
+		return err
+	}
+	keepAlive, err := store.client.KeepAlive(store.ctx, lease.ID)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for range keepAlive {
+			// drain the responses to keep the lease alive
+		}
+		store.logger.Info("the lease of the current member is lost")
+	}()
+	store.memberLease = lease.ID
+	return nil
+}
+
+func hasMember(members []Member, name string) bool {
+	for _, member := range members {
+		if member.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (store *EtcdStore) ResetCluster() {}
+
+func (store *EtcdStore) DeleteCluster() {
+	ctx, cancel := context.WithTimeout(store.ctx, etcdRequestTimeout)
+	defer cancel()
+	if _, err := store.client.Delete(ctx, store.keyPrefix+"/", clientv3.WithPrefix()); err != nil {
+		store.logger.Error(err, "Delete cluster failed")
+	}
+}
+
+func (store *EtcdStore) IsLeaseExist() (bool, error) {
+	resp, err := store.get(store.leaderKey(), clientv3.WithCountOnly())
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
+
+func (store *EtcdStore) CreateLease() error {
+	now := time.Now().Unix()
+	record := &etcdLeaderRecord{
+		Leader:      store.currentMemberName,
+		AcquireTime: now,
+		RenewTime:   now,
+		TTL:         viper.GetInt(constant.KBEnvTTL),
+	}
+	created, err := store.create(store.leaderKey(), record)
+	if err != nil {
+		store.logger.Error(err, "Create Leader lease failed")
+		return err
+	}
+	if created {
+		store.logger.Info(fmt.Sprintf("etcd store initializing, create leader lease: %s", store.leaderKey()))
+	}
+	return nil
+}
+
+func (store *EtcdStore) GetLeader() (*Leader, error) {
+	resp, err := store.get(store.leaderKey())
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+
+	kv := resp.Kvs[0]
+	record := &etcdLeaderRecord{}
+	if err = json.Unmarshal(kv.Value, record); err != nil {
+		return nil, err
+	}
+	ttl := record.TTL
+	if ttl == 0 {
+		ttl = viper.GetInt(constant.KBEnvTTL)
+	}
+	leader := record.Leader
+	if ttl > 0 && time.Now().Unix()-record.RenewTime > int64(ttl) {
+		store.logger.Info(fmt.Sprintf("lock expired: %v, now: %d", record, time.Now().Unix()))
+		leader = ""
+	}
+
+	return &Leader{
+		Index:       strconv.FormatInt(kv.ModRevision, 10),
+		Name:        leader,
+		AcquireTime: record.AcquireTime,
+		RenewTime:   record.RenewTime,
+		TTL:         ttl,
+		Resource:    record,
+		DBState:     record.DBState,
+	}, nil
+}
+
+func (store *EtcdStore) AttemptAcquireLease() error {
+	leader := store.cluster.Leader
+	if leader == nil {
+		return errors.New("no leader lease")
+	}
+	timestamp := time.Now().Unix()
+	record := &etcdLeaderRecord{
+		Leader:      store.currentMemberName,
+		AcquireTime: timestamp,
+		RenewTime:   timestamp,
+		TTL:         store.cluster.HaConfig.ttl,
+		DBState:     leader.DBState,
+	}
+	index, err := store.update(store.leaderKey(), leader.Index, record)
+	if err != nil {
+		store.logger.Error(err, "Acquire lease failed")
+		return err
+	}
+
+	leader.Index = index
+	leader.Resource = record
+	leader.AcquireTime = timestamp
+	leader.RenewTime = timestamp
+	return nil
+}
+
+func (store *EtcdStore) HasLease() bool {
+	return store.cluster != nil && store.cluster.Leader != nil && store.cluster.Leader.Name == store.currentMemberName
+}
+
+func (store *EtcdStore) UpdateLease() error {
+	leader := store.cluster.Leader
+	record, ok := leader.Resource.(*etcdLeaderRecord)
+	if !ok || record.Leader != store.currentMemberName {
+		return errors.Errorf("lost lease")
+	}
+	record.TTL = store.cluster.HaConfig.ttl
+	record.RenewTime = time.Now().Unix()
+	record.DBState = leader.DBState
+
+	index, err := store.update(store.leaderKey(), leader.Index, record)
+	if err != nil {
+		return err
+	}
+	leader.Index = index
+	leader.RenewTime = record.RenewTime
+	return nil
+}
+
+func (store *EtcdStore) ReleaseLease() error {
+	store.logger.Info("release lease")
+	leader := store.cluster.Leader
+	record, ok := leader.Resource.(*etcdLeaderRecord)
+	if !ok {
+		return errors.New("no leader lease")
+	}
+	record.Leader = ""
+	record.DBState = leader.DBState
+	leader.Name = ""
+
+	index, err := store.update(store.leaderKey(), leader.Index, record)
+	if err != nil {
+		store.logger.Error(err, "release lease failed")
+		return err
+	}
+	leader.Index = index
+	return nil
+}
+
+func (store *EtcdStore) CreateHaConfig() error {
+	enableHA := true
+	if enableStr := viper.GetString(constant.KBEnvEnableHA); enableStr != "" {
+		enableHA, _ = strconv.ParseBool(enableStr)
+	}
+	record := &etcdHaConfigRecord{
+		TTL:                viper.GetInt(constant.KBEnvTTL),
+		Enable:             enableHA,
+		MaxLagOnSwitchover: int64(viper.GetInt(constant.KBEnvMaxLag)),
+	}
+	created, err := store.create(store.haConfigKey(), record)
+	if err != nil {
+		store.logger.Error(err, "Create Ha config failed")
+		return err
+	}
+	if created {
+		store.logger.Info(fmt.Sprintf("Create Ha config: %s", store.haConfigKey()))
+	}
+	return nil
+}
+
+func (store *EtcdStore) GetHaConfig() (*HaConfig, error) {
+	deleteMembers := make(map[string]MemberToDelete)
+	resp, err := store.get(store.haConfigKey())
+	if err != nil || len(resp.Kvs) == 0 {
+		return &HaConfig{
+			index:              "",
+			ttl:                viper.GetInt(constant.KBEnvTTL),
+			maxLagOnSwitchover: defaultMaxLagOnSwitch,
+			DeleteMembers:      deleteMembers,
+		}, err
+	}
+
+	kv := resp.Kvs[0]
+	record := &etcdHaConfigRecord{}
+	if err = json.Unmarshal(kv.Value, record); err != nil {
+		store.logger.Error(err, fmt.Sprintf("Get ha config [%s] error", string(kv.Value)))
+	}
+	ttl := record.TTL
+	if ttl == 0 {
+		ttl = viper.GetInt(constant.KBEnvTTL)
+	}
+	maxLagOnSwitchover := record.MaxLagOnSwitchover
+	if maxLagOnSwitchover == 0 {
+		maxLagOnSwitchover = defaultMaxLagOnSwitch
+	}
+	for name, member := range record.DeleteMembers {
+		deleteMembers[name] = member
+	}
+
+	return &HaConfig{
+		index:              strconv.FormatInt(kv.ModRevision, 10),
+		ttl:                ttl,
+		enable:             record.Enable,
+		maxLagOnSwitchover: maxLagOnSwitchover,
+		DeleteMembers:      deleteMembers,
+		resource:           record,
+	}, err
+}
+
+func (store *EtcdStore) UpdateHaConfig() error {
+	haConfig := store.cluster.HaConfig
+	record, ok := haConfig.resource.(*etcdHaConfigRecord)
+	if !ok {
+		return errors.New("No HA config")
+	}
+
+	record.TTL = haConfig.ttl
+	record.Enable = haConfig.enable
+	record.MaxLagOnSwitchover = haConfig.maxLagOnSwitchover
+	record.DeleteMembers = haConfig.DeleteMembers
+	index, err := store.update(store.haConfigKey(), haConfig.index, record)
+	if err != nil {
+		return err
+	}
+	haConfig.index = index
+	return nil
+}
+
+func (store *EtcdStore) GetSwitchover() (*Switchover, error) {
+	resp, err := store.get(store.switchoverKey())
+	if err != nil {
+		store.logger.Error(err, "Get switchover failed")
+		return nil, nil
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	kv := resp.Kvs[0]
+	record := &etcdSwitchoverRecord{}
+	if err = json.Unmarshal(kv.Value, record); err != nil {
+		return nil, err
+	}
+	store.logger.Info("Found switchover Setting", "switchover", record)
+	return newSwitchover(strconv.FormatInt(kv.ModRevision, 10), record.Leader, record.Candidate, record.ScheduledAt), nil
+}
+
+func (store *EtcdStore) CreateSwitchover(leader, candidate string) error {
+	store.logger.Info(fmt.Sprintf("Create switchover %s", store.switchoverKey()))
+	created, err := store.create(store.switchoverKey(), &etcdSwitchoverRecord{
+		Leader:    leader,
+		Candidate: candidate,
+	})
+	if err != nil {
+		store.logger.Error(err, "Create switchover failed")
+		return err
+	}
+	if !created {
+		return fmt.Errorf("there is another switchover %s unfinished", store.switchoverKey())
+	}
+	return nil
+}
+
+func (store *EtcdStore) DeleteSwitchover() error {
+	ctx, cancel := context.WithTimeout(store.ctx, etcdRequestTimeout)
+	defer cancel()
+	_, err := store.client.Delete(ctx, store.switchoverKey())
+	if err != nil {
+		store.logger.Error(err, "Delete switchover failed")
+	}
+	return err
+}
+
+func (store *EtcdStore) leaderKey() string {
+	return path.Join(store.keyPrefix, etcdLeaderKey)
+}
+
+func (store *EtcdStore) haConfigKey() string {
+	return path.Join(store.keyPrefix, etcdHaConfigKey)
+}
+
+func (store *EtcdStore) switchoverKey() string {
+	return path.Join(store.keyPrefix, etcdSwitchoverKey)
+}
+
+func (store *EtcdStore) memberKey(name string) string {
+	return path.Join(store.keyPrefix, etcdMembersKey, name)
+}
+
+func (store *EtcdStore) get(key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	ctx, cancel := context.WithTimeout(store.ctx, etcdRequestTimeout)
+	defer cancel()
+	return store.client.Get(ctx, key, opts...)
+}
+
+// create puts the record if the key doesn't exist, it returns false if the key exists already.
+func (store *EtcdStore) create(key string, record any) (bool, error) {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(store.ctx, etcdRequestTimeout)
+	defer cancel()
+	resp, err := store.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// update puts the record if the key is not modified since the revision index, and returns the new revision.
+func (store *EtcdStore) update(key, index string, record any) (string, error) {
+	revision, err := strconv.ParseInt(index, 10, 64)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid revision %s of %s", index, key)
+	}
+	value, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(store.ctx, etcdRequestTimeout)
+	defer cancel()
+	resp, err := store.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", revision)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return "", err
+	}
+	if !resp.Succeeded {
+		return "", errors.Errorf("conflict on %s, the revision %s is out of date", key, index)
+	}
+	return strconv.FormatInt(resp.Header.Revision, 10), nil
+}
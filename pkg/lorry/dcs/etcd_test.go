@@ -0,0 +1,74 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dcs
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+var _ = Describe("Etcd DCS Store", func() {
+	var backendIndex int
+
+	// newEtcdBackend isolates the keys of each backend with a distinct prefix.
+	newEtcdBackend := func() newStoreFunc {
+		backendIndex++
+		prefix := fmt.Sprintf("/kubeblocks-test-%d", backendIndex)
+		return func(memberName string) DCS {
+			viper.Set(constant.KBEnvDCSEtcdPrefix, prefix)
+			setMemberEnvs(memberName)
+			store, err := newEtcdStoreWithClient(v3client.New(etcdServer.Server))
+			Expect(err).Should(Succeed())
+			return store
+		}
+	}
+
+	describeStoreBehaviors(newEtcdBackend)
+
+	It("rejects the lease acquisition with a stale revision", func() {
+		newStore := newEtcdBackend()
+		store0 := newStore("pod-0")
+		Expect(store0.Initialize()).Should(Succeed())
+		_, err := store0.GetCluster()
+		Expect(err).Should(Succeed())
+		Expect(store0.ReleaseLease()).Should(Succeed())
+
+		store1 := newStore("pod-1")
+		store2 := newStore("pod-2")
+		for _, store := range []DCS{store1, store2} {
+			cluster, err := store.GetCluster()
+			Expect(err).Should(Succeed())
+			Expect(cluster.IsLocked()).Should(BeFalse())
+		}
+
+		Expect(store1.AttemptAcquireLease()).Should(Succeed())
+		Expect(store2.AttemptAcquireLease()).ShouldNot(Succeed())
+		leader, err := store0.GetLeader()
+		Expect(err).Should(Succeed())
+		Expect(leader.Name).Should(Equal("pod-1"))
+	})
+})
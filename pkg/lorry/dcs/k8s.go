@@ -23,7 +23,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strconv"
 	"time"
 
@@ -42,7 +41,6 @@ import (
 
 	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
 	"github.com/apecloud/kubeblocks/pkg/constant"
-	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
 	k8s "github.com/apecloud/kubeblocks/pkg/lorry/util/kubernetes"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
@@ -56,7 +54,7 @@ type KubernetesStore struct {
 	namespace           string
 	cluster             *Cluster
 	client              *rest.RESTClient
-	clientset           *kubernetes.Clientset
+	clientset           kubernetes.Interface
 	LeaderObservedTime  int64
 	logger              logr.Logger
 	IsLeaderClusterWide bool
@@ -76,51 +74,22 @@ func NewKubernetesStore() (*KubernetesStore, error) {
 		return nil, err
 	}
 
-	clusterName := os.Getenv(constant.KBEnvClusterName)
-	if clusterName == "" {
-		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvClusterName))
-	}
-
-	componentName := os.Getenv(constant.KBEnvCompName)
-	if componentName == "" {
-		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvCompName))
-	}
-
-	clusterCompName := os.Getenv(constant.KBEnvClusterCompName)
-	if clusterCompName == "" {
-		clusterCompName = clusterName + "-" + componentName
-	}
-
-	currentMemberName := os.Getenv(constant.KBEnvPodName)
-	if currentMemberName == "" {
-		return nil, errors.New(fmt.Sprintf("%s must be set", constant.KBEnvPodName))
-	}
-
-	namespace := os.Getenv(constant.KBEnvNamespace)
-	if namespace == "" {
-		return nil, errors.New("KB_NAMESPACE must be set")
-	}
-
-	characterType := viper.GetString(constant.KBEnvCharacterType)
-	if viper.IsSet(constant.KBEnvBuiltinHandler) {
-		characterType = viper.GetString(constant.KBEnvBuiltinHandler)
-	}
-	isLeaderClusterWide := false
-	if characterType == string(models.Oceanbase) {
-		isLeaderClusterWide = true
+	envs, err := loadMemberEnvs()
+	if err != nil {
+		return nil, err
 	}
 
 	store := &KubernetesStore{
 		ctx:                 ctx,
-		clusterName:         clusterName,
-		componentName:       componentName,
-		clusterCompName:     clusterCompName,
-		currentMemberName:   currentMemberName,
-		namespace:           namespace,
+		clusterName:         envs.clusterName,
+		componentName:       envs.componentName,
+		clusterCompName:     envs.clusterCompName,
+		currentMemberName:   envs.currentMemberName,
+		namespace:           envs.namespace,
 		client:              client,
 		clientset:           clientset,
 		logger:              logger,
-		IsLeaderClusterWide: isLeaderClusterWide,
+		IsLeaderClusterWide: envs.isLeaderClusterWide,
 	}
 	return store, err
 }
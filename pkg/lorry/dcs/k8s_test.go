@@ -0,0 +1,132 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	appsv1alpha1 "github.com/apecloud/kubeblocks/apis/apps/v1alpha1"
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+// clusterRoundTripper serves the cluster object for the REST client of the store.
+type clusterRoundTripper struct {
+	cluster *appsv1alpha1.Cluster
+}
+
+func (rt *clusterRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := json.Marshal(rt.cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func newTestClusterRESTClient(cluster *appsv1alpha1.Cluster) *rest.RESTClient {
+	Expect(appsv1alpha1.AddToScheme(scheme.Scheme)).Should(Succeed())
+	client, err := rest.RESTClientFor(&rest.Config{
+		Host:      "http://127.0.0.1",
+		APIPath:   "/apis",
+		Transport: &clusterRoundTripper{cluster: cluster},
+		ContentConfig: rest.ContentConfig{
+			GroupVersion:         &appsv1alpha1.GroupVersion,
+			NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		},
+	})
+	Expect(err).Should(Succeed())
+	return client
+}
+
+func createMemberPod(clientset kubernetes.Interface, memberName string) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      memberName,
+			UID:       types.UID(memberUID(memberName)),
+			Labels: map[string]string{
+				constant.AppInstanceLabelKey:    testClusterName,
+				constant.AppManagedByLabelKey:   "kubeblocks",
+				constant.KBAppComponentLabelKey: testCompName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  testCompName,
+				Ports: []corev1.ContainerPort{{Name: "mysql", ContainerPort: 3306}},
+			}},
+		},
+		Status: corev1.PodStatus{PodIP: "127.0.0.1"},
+	}
+	_, err := clientset.CoreV1().Pods(testNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	Expect(err).Should(Succeed())
+}
+
+var _ = Describe("Kubernetes DCS Store", func() {
+	newKubernetesBackend := func() newStoreFunc {
+		clientset := fake.NewSimpleClientset()
+		cluster := &appsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testClusterName},
+			Spec: appsv1alpha1.ClusterSpec{
+				ComponentSpecs: []appsv1alpha1.ClusterComponentSpec{{Name: testCompName, Replicas: 3}},
+			},
+		}
+		restClient := newTestClusterRESTClient(cluster)
+		return func(memberName string) DCS {
+			setMemberEnvs(memberName)
+			createMemberPod(clientset, memberName)
+			envs, err := loadMemberEnvs()
+			Expect(err).Should(Succeed())
+			return &KubernetesStore{
+				ctx:               context.Background(),
+				clusterName:       envs.clusterName,
+				componentName:     envs.componentName,
+				clusterCompName:   envs.clusterCompName,
+				currentMemberName: envs.currentMemberName,
+				namespace:         envs.namespace,
+				client:            restClient,
+				clientset:         clientset,
+				logger:            ctrl.Log.WithName("DCS-K8S"),
+			}
+		}
+	}
+
+	describeStoreBehaviors(newKubernetesBackend)
+})
@@ -0,0 +1,77 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package dcs
+
+import (
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.etcd.io/etcd/server/v3/embed"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+const etcdStartTimeout = 30 * time.Second
+
+var (
+	etcdDir    string
+	etcdServer *embed.Etcd
+)
+
+func init() {
+	viper.AutomaticEnv()
+	ctrl.SetLogger(zap.New())
+}
+
+func TestDCS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DCS Suite")
+}
+
+var _ = BeforeSuite(func() {
+	By("starting the embedded etcd")
+	var err error
+	etcdDir, err = os.MkdirTemp("", "dcs-etcd")
+	Expect(err).Should(Succeed())
+
+	cfg := embed.NewConfig()
+	cfg.Dir = etcdDir
+	cfg.LogLevel = "error"
+	localURL, _ := url.Parse("http://127.0.0.1:0")
+	cfg.ListenPeerUrls = []url.URL{*localURL}
+	cfg.ListenClientUrls = []url.URL{*localURL}
+	etcdServer, err = embed.StartEtcd(cfg)
+	Expect(err).Should(Succeed())
+	Eventually(etcdServer.Server.ReadyNotify()).WithTimeout(etcdStartTimeout).Should(BeClosed())
+})
+
+var _ = AfterSuite(func() {
+	if etcdServer != nil {
+		etcdServer.Close()
+	}
+	_ = os.RemoveAll(etcdDir)
+})
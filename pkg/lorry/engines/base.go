@@ -230,6 +230,14 @@ func (mgr *DBManagerBase) RevokeUserRole(context.Context, string, string) error
 	return models.ErrNotImplemented
 }
 
+func (mgr *DBManagerBase) UpdateUserPassword(context.Context, string, string, bool) error {
+	return models.ErrNotImplemented
+}
+
+func (mgr *DBManagerBase) DiscardOldUserPassword(context.Context, string) error {
+	return models.ErrNotImplemented
+}
+
 func (mgr *DBManagerBase) IsRunning() bool {
 	return false
 }
@@ -144,6 +144,20 @@ func (mr *MockDBManagerMockRecorder) DescribeUser(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeUser", reflect.TypeOf((*MockDBManager)(nil).DescribeUser), arg0, arg1)
 }
 
+// DiscardOldUserPassword mocks base method.
+func (m *MockDBManager) DiscardOldUserPassword(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardOldUserPassword", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DiscardOldUserPassword indicates an expected call of DiscardOldUserPassword.
+func (mr *MockDBManagerMockRecorder) DiscardOldUserPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardOldUserPassword", reflect.TypeOf((*MockDBManager)(nil).DiscardOldUserPassword), arg0, arg1)
+}
+
 // Exec mocks base method.
 func (m *MockDBManager) Exec(arg0 context.Context, arg1 string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -741,3 +755,17 @@ func (mr *MockDBManagerMockRecorder) Unlock(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockDBManager)(nil).Unlock), arg0)
 }
+
+// UpdateUserPassword mocks base method.
+func (m *MockDBManager) UpdateUserPassword(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPassword", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPassword indicates an expected call of UpdateUserPassword.
+func (mr *MockDBManagerMockRecorder) UpdateUserPassword(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockDBManager)(nil).UpdateUserPassword), arg0, arg1, arg2, arg3)
+}
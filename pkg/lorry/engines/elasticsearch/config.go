@@ -0,0 +1,119 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+)
+
+const (
+	urlKey                = "url"
+	username              = "username"
+	password              = "password"
+	insecureSkipVerify    = "insecureSkipVerify"
+	operationTimeout      = "operationTimeout"
+	defaultURL            = "http://127.0.0.1:9200"
+	defaultTimeout        = 10 * time.Second
+	defaultPort           = 9200
+	defaultDialTimeout    = 5 * time.Second
+	defaultHandshakeLimit = 5 * time.Second
+)
+
+type Config struct {
+	URL                string
+	Username           string
+	Password           string
+	InsecureSkipVerify bool
+	OperationTimeout   time.Duration
+}
+
+func NewConfig(properties map[string]string) (*Config, error) {
+	config := &Config{
+		URL:              defaultURL,
+		OperationTimeout: defaultTimeout,
+	}
+
+	if val, ok := properties[urlKey]; ok && val != "" {
+		config.URL = val
+	}
+
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "incorrect url field from metadata")
+	}
+	if viper.IsSet(constant.KBEnvServicePort) {
+		u.Host = net.JoinHostPort(u.Hostname(), viper.GetString(constant.KBEnvServicePort))
+		config.URL = u.String()
+	}
+
+	if val, ok := properties[username]; ok && val != "" {
+		config.Username = val
+	}
+
+	if val, ok := properties[password]; ok && val != "" {
+		config.Password = val
+	}
+
+	if viper.IsSet(constant.KBEnvServiceUser) {
+		config.Username = viper.GetString(constant.KBEnvServiceUser)
+	}
+
+	if viper.IsSet(constant.KBEnvServicePassword) {
+		config.Password = viper.GetString(constant.KBEnvServicePassword)
+	}
+
+	if val, ok := properties[insecureSkipVerify]; ok && val != "" {
+		config.InsecureSkipVerify, err = strconv.ParseBool(val)
+		if err != nil {
+			return nil, errors.New("incorrect insecureSkipVerify field from metadata")
+		}
+	}
+
+	if val, ok := properties[operationTimeout]; ok && val != "" {
+		config.OperationTimeout, err = time.ParseDuration(val)
+		if err != nil {
+			return nil, errors.New("incorrect operationTimeout field from metadata")
+		}
+	}
+
+	return config, nil
+}
+
+func (config *Config) GetDBPort() int {
+	u, err := url.Parse(config.URL)
+	if err != nil {
+		return defaultPort
+	}
+
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		return defaultPort
+	}
+
+	return port
+}
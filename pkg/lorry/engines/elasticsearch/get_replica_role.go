@@ -0,0 +1,46 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+)
+
+// GetReplicaRole returns Leader for the elected master node, and Follower for the others.
+func (mgr *Manager) GetReplicaRole(ctx context.Context, cluster *dcs.Cluster) (string, error) {
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	node := findNode(nodes, mgr.CurrentMemberName)
+	if node == nil {
+		return "", errors.Errorf("node %s not found in cluster", mgr.CurrentMemberName)
+	}
+	if node.isElectedMaster() {
+		return models.LEADER, nil
+	}
+	return models.FOLLOWER, nil
+}
@@ -0,0 +1,238 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+const (
+	distributionOpenSearch = "opensearch"
+
+	healthRed = "red"
+)
+
+// Manager manages Elasticsearch and OpenSearch nodes through the REST API.
+// The node name is expected to be the pod name, which is how the addons configure node.name.
+type Manager struct {
+	engines.DBManagerBase
+	config *Config
+	client *http.Client
+
+	// distribution is detected from the root endpoint, it's "opensearch" for OpenSearch
+	// and empty for Elasticsearch.
+	distribution *string
+}
+
+var _ engines.DBManager = &Manager{}
+
+func NewManager(properties engines.Properties) (engines.DBManager, error) {
+	logger := ctrl.Log.WithName("Elasticsearch")
+
+	config, err := NewConfig(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	managerBase, err := engines.NewDBManagerBase(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout: defaultDialTimeout,
+	}
+	netTransport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: defaultHandshakeLimit,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec
+		},
+	}
+
+	mgr := &Manager{
+		DBManagerBase: *managerBase,
+		config:        config,
+		client: &http.Client{
+			Timeout:   config.OperationTimeout,
+			Transport: netTransport,
+		},
+	}
+	return mgr, nil
+}
+
+func (mgr *Manager) IsRunning() bool {
+	_, err := mgr.getDistribution(context.Background())
+	if err != nil {
+		mgr.Logger.Info("get node info failed", "error", err.Error())
+		return false
+	}
+	return true
+}
+
+func (mgr *Manager) IsDBStartupReady() bool {
+	if mgr.DBStartupReady {
+		return true
+	}
+
+	if !mgr.IsRunning() {
+		return false
+	}
+
+	mgr.DBStartupReady = true
+	mgr.Logger.Info("DB startup ready")
+	return true
+}
+
+func (mgr *Manager) GetPort() (int, error) {
+	return mgr.config.GetDBPort(), nil
+}
+
+// IsClusterInitialized checks if the cluster has elected a master, which means the cluster has been bootstrapped.
+func (mgr *Manager) IsClusterInitialized(ctx context.Context, cluster *dcs.Cluster) (bool, error) {
+	master, err := mgr.getMasterNode(ctx)
+	if err != nil {
+		return false, err
+	}
+	return master != "", nil
+}
+
+// IsClusterHealthy checks the cluster health, the cluster is unhealthy only if some primary shards are unassigned.
+func (mgr *Manager) IsClusterHealthy(ctx context.Context, cluster *dcs.Cluster) bool {
+	health, err := mgr.getClusterHealth(ctx)
+	if err != nil {
+		mgr.Logger.Info("get cluster health failed", "error", err.Error())
+		return false
+	}
+	if health.Status == healthRed {
+		mgr.Logger.Info("cluster is unhealthy", "status", health.Status, "unassignedShards", health.UnassignedShards)
+		return false
+	}
+	return true
+}
+
+type clusterHealth struct {
+	ClusterName      string `json:"cluster_name"`
+	Status           string `json:"status"`
+	NumberOfNodes    int    `json:"number_of_nodes"`
+	RelocatingShards int    `json:"relocating_shards"`
+	UnassignedShards int    `json:"unassigned_shards"`
+}
+
+func (mgr *Manager) getClusterHealth(ctx context.Context) (*clusterHealth, error) {
+	health := &clusterHealth{}
+	if err := mgr.request(ctx, http.MethodGet, "/_cluster/health", nil, health); err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
+func (mgr *Manager) isOpenSearch(ctx context.Context) (bool, error) {
+	distribution, err := mgr.getDistribution(ctx)
+	if err != nil {
+		return false, err
+	}
+	return distribution == distributionOpenSearch, nil
+}
+
+func (mgr *Manager) getDistribution(ctx context.Context) (string, error) {
+	info := struct {
+		Version struct {
+			Number       string `json:"number"`
+			Distribution string `json:"distribution"`
+		} `json:"version"`
+	}{}
+	if err := mgr.request(ctx, http.MethodGet, "/", nil, &info); err != nil {
+		return "", err
+	}
+	if mgr.distribution == nil {
+		mgr.distribution = &info.Version.Distribution
+	}
+	return *mgr.distribution, nil
+}
+
+// request sends a request with the body encoded in JSON, and decodes the response into out if it's not nil.
+func (mgr *Manager) request(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(mgr.config.URL, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if mgr.config.Username != "" {
+		req.SetBasicAuth(mgr.config.Username, mgr.config.Password)
+	}
+
+	resp, err := mgr.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return &responseError{StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+type responseError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("status code: %d, response: %s", e.StatusCode, e.Body)
+}
+
+func isNotFound(err error) bool {
+	var respErr *responseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+)
+
+var _ = Describe("Elasticsearch DBManager", func() {
+	var (
+		fake    *fakeCluster
+		server  *httptest.Server
+		manager *Manager
+		cluster *dcs.Cluster
+		ctx     = context.Background()
+	)
+
+	BeforeEach(func() {
+		fake = newFakeCluster("")
+		manager, server = newTestManager(fake)
+		cluster = &dcs.Cluster{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("new db manager", func() {
+		It("with default configurations", func() {
+			dbManager, err := NewManager(engines.Properties{})
+			Expect(err).Should(Succeed())
+			port, err := dbManager.GetPort()
+			Expect(err).Should(Succeed())
+			Expect(port).Should(Equal(defaultPort))
+		})
+
+		It("with wrong configurations", func() {
+			_, err := NewManager(engines.Properties{operationTimeout: "1"})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("health", func() {
+		It("checks the node and the cluster", func() {
+			Expect(manager.IsRunning()).Should(BeTrue())
+			Expect(manager.IsDBStartupReady()).Should(BeTrue())
+			Expect(manager.IsClusterHealthy(ctx, cluster)).Should(BeTrue())
+			Expect(manager.IsClusterInitialized(ctx, cluster)).Should(BeTrue())
+			Expect(manager.IsCurrentMemberHealthy(ctx, cluster)).Should(BeTrue())
+			Expect(manager.IsMemberHealthy(ctx, cluster, &dcs.Member{Name: "es-3"})).Should(BeFalse())
+
+			fake.health = healthRed
+			Expect(manager.IsClusterHealthy(ctx, cluster)).Should(BeFalse())
+		})
+
+		It("is not running", func() {
+			server.Close()
+			Expect(manager.IsRunning()).Should(BeFalse())
+			Expect(manager.IsDBStartupReady()).Should(BeFalse())
+		})
+	})
+
+	Context("roles", func() {
+		It("detects the elected master", func() {
+			role, err := manager.GetReplicaRole(ctx, cluster)
+			Expect(err).Should(Succeed())
+			Expect(role).Should(Equal(models.LEADER))
+			Expect(manager.IsLeader(ctx, cluster)).Should(BeTrue())
+			Expect(manager.IsLeaderMember(ctx, cluster, &dcs.Member{Name: "es-1"})).Should(BeFalse())
+
+			manager.CurrentMemberName = "es-1"
+			role, err = manager.GetReplicaRole(ctx, cluster)
+			Expect(err).Should(Succeed())
+			Expect(role).Should(Equal(models.FOLLOWER))
+		})
+	})
+
+	Context("membership", func() {
+		It("drains the node before it leaves", func() {
+			Expect(manager.GetMemberAddrs(ctx, cluster)).Should(Equal([]string{"10.0.0.1:9200", "10.0.0.2:9200", "10.0.0.3:9200"}))
+
+			err := manager.LeaveMemberFromCluster(ctx, cluster, "es-1")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("still has 2 shards"))
+			Expect(fake.exclude).Should(Equal("es-1"))
+			Expect(fake.votingExclusions).Should(Equal([]string{"es-1"}))
+
+			fake.shards["es-1"] = 0
+			Expect(manager.LeaveMemberFromCluster(ctx, cluster, "es-1")).Should(Succeed())
+			Expect(fake.exclude).Should(Equal("es-1"))
+
+			// the data node is not excluded from the voting configuration
+			fake.shards["es-2"] = 0
+			Expect(manager.LeaveMemberFromCluster(ctx, cluster, "es-2")).Should(Succeed())
+			Expect(fake.exclude).Should(Equal("es-1,es-2"))
+			Expect(fake.votingExclusions).Should(Equal([]string{"es-1"}))
+
+			// the node has left
+			Expect(manager.LeaveMemberFromCluster(ctx, cluster, "es-3")).Should(Succeed())
+		})
+
+		It("clears the voting config exclusions of the departed nodes", func() {
+			fake.votingExclusions = []string{"es-3", "es-1", "es-4"}
+			fake.nodes[0].Roles = "m"
+			fake.shards["es-0"] = 0
+			Expect(manager.LeaveMemberFromCluster(ctx, cluster, "es-0")).Should(Succeed())
+			Expect(fake.votingExclusions).Should(Equal([]string{"es-1", "es-0"}))
+		})
+
+		It("clears the exclusions when the node joins again", func() {
+			fake.exclude = "es-1,es-2"
+			fake.votingExclusions = []string{"es-1", "es-3"}
+			manager.CurrentMemberName = "es-1"
+			Expect(manager.IsCurrentMemberInCluster(ctx, cluster)).Should(BeTrue())
+			Expect(manager.JoinCurrentMemberToCluster(ctx, cluster)).Should(Succeed())
+			Expect(fake.exclude).Should(Equal("es-2"))
+			Expect(fake.votingExclusions).Should(Equal([]string{"es-3"}))
+
+			manager.CurrentMemberName = "es-3"
+			Expect(manager.IsCurrentMemberInCluster(ctx, cluster)).Should(BeFalse())
+			Expect(manager.JoinCurrentMemberToCluster(ctx, cluster)).Should(HaveOccurred())
+		})
+	})
+})
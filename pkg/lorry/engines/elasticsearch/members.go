@@ -0,0 +1,357 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+)
+
+const (
+	allocationExcludeSetting = "cluster.routing.allocation.exclude._name"
+
+	masterEligibleRole = "m"
+	electedMaster      = "*"
+)
+
+type catNode struct {
+	Name        string `json:"name"`
+	IP          string `json:"ip"`
+	HTTPAddress string `json:"http_address"`
+	Roles       string `json:"node.role"`
+	Master      string `json:"master"`
+}
+
+func (n *catNode) isMasterEligible() bool {
+	return strings.Contains(n.Roles, masterEligibleRole)
+}
+
+func (n *catNode) isElectedMaster() bool {
+	return n.Master == electedMaster
+}
+
+func (mgr *Manager) IsLeader(ctx context.Context, cluster *dcs.Cluster) (bool, error) {
+	master, err := mgr.getMasterNode(ctx)
+	if err != nil {
+		return false, err
+	}
+	return master == mgr.CurrentMemberName, nil
+}
+
+func (mgr *Manager) IsLeaderMember(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) (bool, error) {
+	if member == nil {
+		return false, nil
+	}
+	master, err := mgr.getMasterNode(ctx)
+	if err != nil {
+		return false, err
+	}
+	return master == member.Name, nil
+}
+
+func (mgr *Manager) GetMemberAddrs(ctx context.Context, cluster *dcs.Cluster) []string {
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		mgr.Logger.Info("list nodes failed", "error", err.Error())
+		return nil
+	}
+
+	addrs := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if node.HTTPAddress != "" {
+			addrs = append(addrs, node.HTTPAddress)
+		}
+	}
+	return addrs
+}
+
+func (mgr *Manager) IsCurrentMemberInCluster(ctx context.Context, cluster *dcs.Cluster) bool {
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		mgr.Logger.Info("list nodes failed", "error", err.Error())
+		return true
+	}
+	return findNode(nodes, mgr.CurrentMemberName) != nil
+}
+
+func (mgr *Manager) IsCurrentMemberHealthy(ctx context.Context, cluster *dcs.Cluster) bool {
+	return mgr.IsMemberHealthy(ctx, cluster, nil)
+}
+
+// IsMemberHealthy checks that the node has joined the cluster, which requires an elected master.
+func (mgr *Manager) IsMemberHealthy(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) bool {
+	memberName := mgr.CurrentMemberName
+	if member != nil {
+		memberName = member.Name
+	}
+
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		mgr.Logger.Info("list nodes failed", "error", err.Error())
+		return false
+	}
+	return findNode(nodes, memberName) != nil
+}
+
+// JoinCurrentMemberToCluster verifies that the current node has joined the cluster, nodes join by discovery themselves.
+// The exclusions left by a former leave of a node with the same name are removed first, otherwise no shard can be
+// allocated to the node.
+func (mgr *Manager) JoinCurrentMemberToCluster(ctx context.Context, cluster *dcs.Cluster) error {
+	if err := mgr.removeAllocationExclusion(ctx, mgr.CurrentMemberName); err != nil {
+		return errors.Wrap(err, "remove allocation exclusion failed")
+	}
+	if err := mgr.removeVotingConfigExclusions(ctx, mgr.CurrentMemberName); err != nil {
+		return errors.Wrap(err, "remove voting config exclusion failed")
+	}
+
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list nodes failed")
+	}
+	if findNode(nodes, mgr.CurrentMemberName) == nil {
+		return errors.Errorf("node %s has not joined the cluster yet", mgr.CurrentMemberName)
+	}
+	return nil
+}
+
+// LeaveMemberFromCluster drains the node before the pod is removed. The node is excluded from the shard allocation
+// so its shards are relocated to the other nodes, and from the voting configuration if it's master-eligible so
+// the master moves away. It fails until all the shards are relocated, the caller is expected to retry.
+// The voting config exclusions of the nodes already gone are cleared first, as the number of them is limited.
+func (mgr *Manager) LeaveMemberFromCluster(ctx context.Context, cluster *dcs.Cluster, memberName string) error {
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list nodes failed")
+	}
+	node := findNode(nodes, memberName)
+	if node == nil {
+		mgr.Logger.Info("node has already left", "node", memberName)
+		return nil
+	}
+
+	if err = mgr.addAllocationExclusion(ctx, memberName); err != nil {
+		return errors.Wrap(err, "add allocation exclusion failed")
+	}
+
+	if node.isMasterEligible() {
+		exclusions, err := mgr.getVotingConfigExclusions(ctx)
+		if err != nil {
+			return errors.Wrap(err, "get voting config exclusions failed")
+		}
+		var departed []string
+		for _, name := range exclusions {
+			if findNode(nodes, name) == nil {
+				departed = append(departed, name)
+			}
+		}
+		if err = mgr.removeVotingConfigExclusions(ctx, departed...); err != nil {
+			return errors.Wrap(err, "remove voting config exclusions failed")
+		}
+		if err = mgr.addVotingConfigExclusion(ctx, memberName); err != nil {
+			return errors.Wrap(err, "add voting config exclusion failed")
+		}
+	}
+
+	shards, err := mgr.getNodeShards(ctx, memberName)
+	if err != nil {
+		return errors.Wrap(err, "get node shards failed")
+	}
+	if shards > 0 {
+		return errors.Errorf("node %s still has %d shards, wait for the relocation", memberName, shards)
+	}
+	mgr.Logger.Info(fmt.Sprintf("node %s is drained", memberName))
+	return nil
+}
+
+func (mgr *Manager) getMasterNode(ctx context.Context) (string, error) {
+	nodes, err := mgr.catNodes(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, node := range nodes {
+		if node.isElectedMaster() {
+			return node.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (mgr *Manager) catNodes(ctx context.Context) ([]catNode, error) {
+	var nodes []catNode
+	err := mgr.request(ctx, http.MethodGet, "/_cat/nodes?format=json&h=name,ip,http_address,node.role,master", nil, &nodes)
+	return nodes, err
+}
+
+func findNode(nodes []catNode, name string) *catNode {
+	for i := range nodes {
+		if nodes[i].Name == name {
+			return &nodes[i]
+		}
+	}
+	return nil
+}
+
+func (mgr *Manager) getNodeShards(ctx context.Context, name string) (int, error) {
+	var allocations []struct {
+		Node   string `json:"node"`
+		Shards string `json:"shards"`
+	}
+	if err := mgr.request(ctx, http.MethodGet, "/_cat/allocation?format=json&h=node,shards", nil, &allocations); err != nil {
+		return 0, err
+	}
+	for _, allocation := range allocations {
+		if allocation.Node == name {
+			return strconv.Atoi(allocation.Shards)
+		}
+	}
+	return 0, nil
+}
+
+func (mgr *Manager) getAllocationExclusion(ctx context.Context) ([]string, error) {
+	settings := struct {
+		Persistent map[string]any `json:"persistent"`
+	}{}
+	if err := mgr.request(ctx, http.MethodGet, "/_cluster/settings?flat_settings=true", nil, &settings); err != nil {
+		return nil, err
+	}
+
+	value, _ := settings.Persistent[allocationExcludeSetting].(string)
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (mgr *Manager) setAllocationExclusion(ctx context.Context, names []string) error {
+	var value any
+	if len(names) > 0 {
+		value = strings.Join(names, ",")
+	}
+	settings := map[string]any{
+		"persistent": map[string]any{
+			allocationExcludeSetting: value,
+		},
+	}
+	return mgr.request(ctx, http.MethodPut, "/_cluster/settings", settings, nil)
+}
+
+func (mgr *Manager) addAllocationExclusion(ctx context.Context, name string) error {
+	names, err := mgr.getAllocationExclusion(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range names {
+		if n == name {
+			return nil
+		}
+	}
+	return mgr.setAllocationExclusion(ctx, append(names, name))
+}
+
+func (mgr *Manager) removeAllocationExclusion(ctx context.Context, name string) error {
+	names, err := mgr.getAllocationExclusion(ctx)
+	if err != nil {
+		return err
+	}
+	remains := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != name {
+			remains = append(remains, n)
+		}
+	}
+	if len(remains) == len(names) {
+		return nil
+	}
+	return mgr.setAllocationExclusion(ctx, remains)
+}
+
+func (mgr *Manager) getVotingConfigExclusions(ctx context.Context) ([]string, error) {
+	state := struct {
+		Metadata struct {
+			ClusterCoordination struct {
+				VotingConfigExclusions []struct {
+					NodeName string `json:"node_name"`
+				} `json:"voting_config_exclusions"`
+			} `json:"cluster_coordination"`
+		} `json:"metadata"`
+	}{}
+	path := "/_cluster/state/metadata?filter_path=metadata.cluster_coordination.voting_config_exclusions"
+	if err := mgr.request(ctx, http.MethodGet, path, nil, &state); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, exclusion := range state.Metadata.ClusterCoordination.VotingConfigExclusions {
+		names = append(names, exclusion.NodeName)
+	}
+	return names, nil
+}
+
+func (mgr *Manager) addVotingConfigExclusion(ctx context.Context, name string) error {
+	names, err := mgr.getVotingConfigExclusions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range names {
+		if n == name {
+			return nil
+		}
+	}
+	return mgr.request(ctx, http.MethodPost, "/_cluster/voting_config_exclusions?node_names="+url.QueryEscape(name), nil, nil)
+}
+
+// removeVotingConfigExclusions removes the voting config exclusions of the nodes.
+// The API clears all the exclusions, so the ones of the other nodes are added back right after.
+func (mgr *Manager) removeVotingConfigExclusions(ctx context.Context, names ...string) error {
+	exclusions, err := mgr.getVotingConfigExclusions(ctx)
+	if err != nil {
+		return err
+	}
+	remains := make([]string, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		if !slices.Contains(names, exclusion) {
+			remains = append(remains, exclusion)
+		}
+	}
+	if len(remains) == len(exclusions) {
+		return nil
+	}
+
+	if err = mgr.request(ctx, http.MethodDelete, "/_cluster/voting_config_exclusions?wait_for_removal=false", nil, nil); err != nil {
+		return err
+	}
+	if len(remains) == 0 {
+		return nil
+	}
+	path := "/_cluster/voting_config_exclusions?node_names=" + url.QueryEscape(strings.Join(remains, ","))
+	return mgr.request(ctx, http.MethodPost, path, nil, nil)
+}
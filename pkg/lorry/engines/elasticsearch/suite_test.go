@@ -0,0 +1,217 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/spf13/viper"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+func init() {
+	viper.AutomaticEnv()
+	viper.SetDefault(constant.KBEnvPodName, "es-0")
+	viper.SetDefault(constant.KBEnvClusterCompName, "cluster-es")
+	viper.SetDefault(constant.KBEnvNamespace, "namespace-test")
+	ctrl.SetLogger(zap.New())
+}
+
+func TestElasticsearchDBManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Elasticsearch DBManager. Suite")
+}
+
+// fakeCluster serves the subset of the Elasticsearch and OpenSearch REST API used by the manager.
+type fakeCluster struct {
+	sync.Mutex
+	distribution     string
+	health           string
+	nodes            []catNode
+	shards           map[string]int
+	exclude          string
+	votingExclusions []string
+	users            map[string]securityUser
+	passwords        map[string]string
+	roles            map[string]any
+}
+
+func newFakeCluster(distribution string) *fakeCluster {
+	return &fakeCluster{
+		distribution: distribution,
+		health:       "green",
+		nodes: []catNode{
+			{Name: "es-0", IP: "10.0.0.1", HTTPAddress: "10.0.0.1:9200", Roles: "dim", Master: "*"},
+			{Name: "es-1", IP: "10.0.0.2", HTTPAddress: "10.0.0.2:9200", Roles: "dim", Master: "-"},
+			{Name: "es-2", IP: "10.0.0.3", HTTPAddress: "10.0.0.3:9200", Roles: "d", Master: "-"},
+		},
+		shards:    map[string]int{"es-0": 2, "es-1": 2, "es-2": 2},
+		users:     map[string]securityUser{},
+		passwords: map[string]string{},
+		roles:     map[string]any{},
+	}
+}
+
+func newTestManager(fake *fakeCluster) (*Manager, *httptest.Server) {
+	server := httptest.NewServer(fake)
+	properties := engines.Properties{
+		urlKey:   server.URL,
+		username: "elastic",
+		password: "changeme",
+	}
+	manager, err := NewManager(properties)
+	Expect(err).Should(Succeed())
+	return manager.(*Manager), server
+}
+
+func (f *fakeCluster) securityPrefix() string {
+	if f.distribution == distributionOpenSearch {
+		return osSecurityPath + "/internalusers"
+	}
+	return esSecurityPath + "/user"
+}
+
+func (f *fakeCluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if user, password, _ := r.BasicAuth(); user != "elastic" || password != "changeme" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reply := func(v any) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	path := r.URL.Path
+	switch {
+	case path == "/":
+		reply(map[string]any{"version": map[string]any{"number": "8.8.2", "distribution": f.distribution}})
+	case path == "/_cluster/health":
+		reply(map[string]any{"status": f.health})
+	case path == "/_cat/nodes":
+		reply(f.nodes)
+	case path == "/_cat/allocation":
+		var allocations []map[string]any
+		for node, shards := range f.shards {
+			allocations = append(allocations, map[string]any{"node": node, "shards": strconv.Itoa(shards)})
+		}
+		reply(allocations)
+	case path == "/_cluster/settings" && r.Method == http.MethodGet:
+		persistent := map[string]any{}
+		if f.exclude != "" {
+			persistent[allocationExcludeSetting] = f.exclude
+		}
+		reply(map[string]any{"persistent": persistent, "transient": map[string]any{}})
+	case path == "/_cluster/settings" && r.Method == http.MethodPut:
+		settings := struct {
+			Persistent map[string]*string `json:"persistent"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&settings)
+		f.exclude = ""
+		if v := settings.Persistent[allocationExcludeSetting]; v != nil {
+			f.exclude = *v
+		}
+		reply(map[string]any{"acknowledged": true})
+	case path == "/_cluster/voting_config_exclusions" && r.Method == http.MethodPost:
+		for _, name := range strings.Split(r.URL.Query().Get("node_names"), ",") {
+			if !slices.Contains(f.votingExclusions, name) {
+				f.votingExclusions = append(f.votingExclusions, name)
+			}
+		}
+	case path == "/_cluster/voting_config_exclusions" && r.Method == http.MethodDelete:
+		f.votingExclusions = nil
+	case path == "/_cluster/state/metadata":
+		var exclusions []map[string]any
+		for _, name := range f.votingExclusions {
+			exclusions = append(exclusions, map[string]any{"node_id": name, "node_name": name})
+		}
+		reply(map[string]any{"metadata": map[string]any{"cluster_coordination": map[string]any{"voting_config_exclusions": exclusions}}})
+	case strings.HasPrefix(path, osSecurityPath+"/roles/"), strings.HasPrefix(path, esSecurityPath+"/role/"):
+		var role any
+		_ = json.NewDecoder(r.Body).Decode(&role)
+		f.roles[path[strings.LastIndex(path, "/")+1:]] = role
+	case strings.HasPrefix(path, f.securityPrefix()):
+		f.serveUser(w, r, strings.TrimPrefix(strings.TrimPrefix(path, f.securityPrefix()), "/"), reply)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeCluster) serveUser(w http.ResponseWriter, r *http.Request, name string, reply func(any)) {
+	name, passwordAPI := strings.CutSuffix(name, "/_password")
+	user, found := f.users[name]
+	switch {
+	case name == "" && r.Method == http.MethodGet:
+		reply(f.users)
+	case !found && r.Method != http.MethodPut:
+		w.WriteHeader(http.StatusNotFound)
+		reply(map[string]any{})
+	case r.Method == http.MethodGet:
+		reply(map[string]any{name: user})
+	case r.Method == http.MethodDelete:
+		delete(f.users, name)
+	case passwordAPI:
+		body := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.passwords[name] = body["password"]
+	case r.Method == http.MethodPatch:
+		var patches []struct {
+			Path  string          `json:"path"`
+			Value json.RawMessage `json:"value"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&patches)
+		for _, patch := range patches {
+			switch patch.Path {
+			case "/password":
+				var password string
+				_ = json.Unmarshal(patch.Value, &password)
+				f.passwords[name] = password
+			case "/opendistro_security_roles":
+				_ = json.Unmarshal(patch.Value, &user.SecurityRoles)
+			}
+		}
+		f.users[name] = user
+	case r.Method == http.MethodPut:
+		body := struct {
+			securityUser
+			Password string `json:"password"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Password != "" {
+			f.passwords[name] = body.Password
+		}
+		f.users[name] = body.securityUser
+	}
+}
@@ -0,0 +1,345 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+)
+
+const (
+	esSecurityPath = "/_security"
+	osSecurityPath = "/_plugins/_security/api"
+
+	esSuperUserRole = "superuser"
+	osSuperUserRole = "all_access"
+	readWriteRole   = "kubeblocks_readwrite"
+	readOnlyRole    = "kubeblocks_readonly"
+)
+
+// securityUser holds the fields of both the Elasticsearch user and the OpenSearch internal user.
+type securityUser struct {
+	Roles         []string `json:"roles,omitempty"`
+	SecurityRoles []string `json:"opendistro_security_roles,omitempty"`
+	Reserved      bool     `json:"reserved,omitempty"`
+	Metadata      struct {
+		Reserved bool `json:"_reserved,omitempty"`
+	} `json:"metadata,omitempty"`
+}
+
+func (u *securityUser) roles() []string {
+	if len(u.SecurityRoles) > 0 {
+		return u.SecurityRoles
+	}
+	return u.Roles
+}
+
+func (u *securityUser) reserved() bool {
+	return u.Reserved || u.Metadata.Reserved
+}
+
+func (mgr *Manager) ListUsers(ctx context.Context) ([]models.UserInfo, error) {
+	return mgr.listUsers(ctx, false)
+}
+
+// ListSystemAccounts lists the reserved users, which are built in and can't be changed.
+func (mgr *Manager) ListSystemAccounts(ctx context.Context) ([]models.UserInfo, error) {
+	return mgr.listUsers(ctx, true)
+}
+
+func (mgr *Manager) listUsers(ctx context.Context, reserved bool) ([]models.UserInfo, error) {
+	path, err := mgr.securityPath(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	users := map[string]securityUser{}
+	if err = mgr.request(ctx, http.MethodGet, path, nil, &users); err != nil {
+		mgr.Logger.Info("list users failed", "error", err.Error())
+		return nil, err
+	}
+
+	userInfos := make([]models.UserInfo, 0, len(users))
+	for name, user := range users {
+		if user.reserved() != reserved {
+			continue
+		}
+		userInfos = append(userInfos, models.UserInfo{
+			UserName: name,
+			RoleName: string(roles2RoleType(user.roles())),
+		})
+	}
+	sort.Slice(userInfos, func(i, j int) bool {
+		return userInfos[i].UserName < userInfos[j].UserName
+	})
+	return userInfos, nil
+}
+
+func (mgr *Manager) DescribeUser(ctx context.Context, userName string) (*models.UserInfo, error) {
+	user, err := mgr.getUser(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	return &models.UserInfo{
+		UserName: userName,
+		RoleName: string(roles2RoleType(user.roles())),
+	}, nil
+}
+
+func (mgr *Manager) CreateUser(ctx context.Context, userName, password string) error {
+	err := mgr.putUser(ctx, userName, map[string]any{
+		"password": password,
+	})
+	if err != nil {
+		mgr.Logger.Info("create user failed", "user", userName, "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (mgr *Manager) DeleteUser(ctx context.Context, userName string) error {
+	path, err := mgr.securityPath(ctx, userName)
+	if err != nil {
+		return err
+	}
+	err = mgr.request(ctx, http.MethodDelete, path, nil, nil)
+	if err != nil && !isNotFound(err) {
+		mgr.Logger.Info("delete user failed", "user", userName, "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (mgr *Manager) GrantUserRole(ctx context.Context, userName, roleName string) error {
+	role, err := mgr.ensureRole(ctx, models.String2RoleType(roleName))
+	if err != nil {
+		return err
+	}
+	user, err := mgr.getUser(ctx, userName)
+	if err != nil {
+		return err
+	}
+
+	roles := user.roles()
+	if slices.Contains(roles, role) {
+		return nil
+	}
+	return mgr.setUserRoles(ctx, userName, append(roles, role))
+}
+
+func (mgr *Manager) RevokeUserRole(ctx context.Context, userName, roleName string) error {
+	role, err := mgr.getRoleName(ctx, models.String2RoleType(roleName))
+	if err != nil {
+		return err
+	}
+	user, err := mgr.getUser(ctx, userName)
+	if err != nil {
+		return err
+	}
+
+	roles := user.roles()
+	index := slices.Index(roles, role)
+	if index < 0 {
+		return nil
+	}
+	return mgr.setUserRoles(ctx, userName, slices.Delete(roles, index, index+1))
+}
+
+// UpdateUserPassword updates the password of the user.
+// A user has only one password, so the request to retain the current password is rejected.
+func (mgr *Manager) UpdateUserPassword(ctx context.Context, userName, password string, retainCurrent bool) error {
+	if retainCurrent {
+		return models.ErrNoDualPassword
+	}
+
+	openSearch, err := mgr.isOpenSearch(ctx)
+	if err != nil {
+		return err
+	}
+	if openSearch {
+		err = mgr.patchUser(ctx, userName, "/password", password)
+	} else {
+		path := esSecurityPath + "/user/" + url.PathEscape(userName) + "/_password"
+		err = mgr.request(ctx, http.MethodPost, path, map[string]any{"password": password}, nil)
+	}
+	if err != nil {
+		mgr.Logger.Info("update user password failed", "user", userName, "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+// DiscardOldUserPassword does nothing, as the current password is never retained.
+func (mgr *Manager) DiscardOldUserPassword(ctx context.Context, userName string) error {
+	return nil
+}
+
+func (mgr *Manager) getUser(ctx context.Context, userName string) (*securityUser, error) {
+	path, err := mgr.securityPath(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	users := map[string]securityUser{}
+	if err = mgr.request(ctx, http.MethodGet, path, nil, &users); err != nil {
+		if isNotFound(err) {
+			return nil, models.ErrNoSuchUser
+		}
+		return nil, err
+	}
+	user, ok := users[userName]
+	if !ok {
+		return nil, models.ErrNoSuchUser
+	}
+	return &user, nil
+}
+
+func (mgr *Manager) putUser(ctx context.Context, userName string, user map[string]any) error {
+	path, err := mgr.securityPath(ctx, userName)
+	if err != nil {
+		return err
+	}
+	return mgr.request(ctx, http.MethodPut, path, user, nil)
+}
+
+func (mgr *Manager) setUserRoles(ctx context.Context, userName string, roles []string) error {
+	openSearch, err := mgr.isOpenSearch(ctx)
+	if err != nil {
+		return err
+	}
+	if openSearch {
+		// a PUT replaces the whole internal user including the password hash, so patch the roles only
+		return mgr.patchUser(ctx, userName, "/opendistro_security_roles", roles)
+	}
+	return mgr.putUser(ctx, userName, map[string]any{"roles": roles})
+}
+
+func (mgr *Manager) patchUser(ctx context.Context, userName, field string, value any) error {
+	patch := []map[string]any{
+		{"op": "add", "path": field, "value": value},
+	}
+	return mgr.request(ctx, http.MethodPatch, osSecurityPath+"/internalusers/"+url.PathEscape(userName), patch, nil)
+}
+
+// ensureRole creates the role for readwrite and readonly if it doesn't exist, the superuser role is built in.
+func (mgr *Manager) ensureRole(ctx context.Context, roleType models.RoleType) (string, error) {
+	role, err := mgr.getRoleName(ctx, roleType)
+	if err != nil || role == esSuperUserRole || role == osSuperUserRole {
+		return role, err
+	}
+
+	openSearch, err := mgr.isOpenSearch(ctx)
+	if err != nil {
+		return "", err
+	}
+	var path string
+	var body map[string]any
+	if openSearch {
+		actions := []string{"read"}
+		if roleType == models.ReadWriteRole {
+			actions = []string{"crud", "create_index"}
+		}
+		path = osSecurityPath + "/roles/" + role
+		body = map[string]any{
+			"cluster_permissions": []string{"cluster_monitor"},
+			"index_permissions": []map[string]any{
+				{"index_patterns": []string{"*"}, "allowed_actions": actions},
+			},
+		}
+	} else {
+		privileges := []string{"read", "view_index_metadata"}
+		if roleType == models.ReadWriteRole {
+			privileges = []string{"read", "write", "create_index", "view_index_metadata"}
+		}
+		path = esSecurityPath + "/role/" + role
+		body = map[string]any{
+			"cluster": []string{"monitor"},
+			"indices": []map[string]any{
+				{"names": []string{"*"}, "privileges": privileges},
+			},
+		}
+	}
+	if err = mgr.request(ctx, http.MethodPut, path, body, nil); err != nil {
+		return "", err
+	}
+	return role, nil
+}
+
+func (mgr *Manager) getRoleName(ctx context.Context, roleType models.RoleType) (string, error) {
+	switch roleType {
+	case models.SuperUserRole:
+		openSearch, err := mgr.isOpenSearch(ctx)
+		if err != nil {
+			return "", err
+		}
+		if openSearch {
+			return osSuperUserRole, nil
+		}
+		return esSuperUserRole, nil
+	case models.ReadWriteRole:
+		return readWriteRole, nil
+	case models.ReadOnlyRole:
+		return readOnlyRole, nil
+	default:
+		return "", models.ErrInvalidRoleName
+	}
+}
+
+// securityPath returns the path of the user API, or the one listing all users if userName is empty.
+func (mgr *Manager) securityPath(ctx context.Context, userName string) (string, error) {
+	openSearch, err := mgr.isOpenSearch(ctx)
+	if err != nil {
+		return "", err
+	}
+	path := esSecurityPath + "/user"
+	if openSearch {
+		path = osSecurityPath + "/internalusers"
+	}
+	if userName != "" {
+		path += "/" + url.PathEscape(userName)
+	}
+	return path, nil
+}
+
+// roles2RoleType returns the role type with the highest privileges among the roles.
+func roles2RoleType(roles []string) models.RoleType {
+	roleType := models.NoPrivileges
+	for _, role := range roles {
+		var t models.RoleType
+		switch role {
+		case esSuperUserRole, osSuperUserRole:
+			t = models.SuperUserRole
+		case readWriteRole:
+			t = models.ReadWriteRole
+		case readOnlyRole, "readall":
+			t = models.ReadOnlyRole
+		default:
+			t = models.CustomizedRole
+		}
+		if models.SortRoleByWeight(t, roleType) {
+			roleType = t
+		}
+	}
+	return roleType
+}
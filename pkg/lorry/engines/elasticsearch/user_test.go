@@ -0,0 +1,101 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package elasticsearch
+
+import (
+	"context"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+)
+
+var _ = Describe("Elasticsearch user management", func() {
+	ctx := context.Background()
+
+	for _, distribution := range []string{"", distributionOpenSearch} {
+		distribution := distribution
+
+		Context("distribution "+distribution, func() {
+			var (
+				fake    *fakeCluster
+				server  *httptest.Server
+				manager *Manager
+			)
+
+			BeforeEach(func() {
+				fake = newFakeCluster(distribution)
+				fake.users["elastic"] = securityUser{Roles: []string{esSuperUserRole}, Reserved: true}
+				manager, server = newTestManager(fake)
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			It("manages users and roles", func() {
+				Expect(manager.CreateUser(ctx, "user1", "pwd1")).Should(Succeed())
+				Expect(fake.passwords["user1"]).Should(Equal("pwd1"))
+
+				users, err := manager.ListUsers(ctx)
+				Expect(err).Should(Succeed())
+				Expect(users).Should(Equal([]models.UserInfo{{UserName: "user1", RoleName: string(models.NoPrivileges)}}))
+				accounts, err := manager.ListSystemAccounts(ctx)
+				Expect(err).Should(Succeed())
+				Expect(accounts).Should(HaveLen(1))
+				Expect(accounts[0].UserName).Should(Equal("elastic"))
+
+				Expect(manager.GrantUserRole(ctx, "user1", string(models.ReadWriteRole))).Should(Succeed())
+				Expect(fake.roles).Should(HaveKey(readWriteRole))
+				user, err := manager.DescribeUser(ctx, "user1")
+				Expect(err).Should(Succeed())
+				Expect(user.RoleName).Should(Equal(string(models.ReadWriteRole)))
+
+				Expect(manager.GrantUserRole(ctx, "user1", string(models.SuperUserRole))).Should(Succeed())
+				user, err = manager.DescribeUser(ctx, "user1")
+				Expect(err).Should(Succeed())
+				Expect(user.RoleName).Should(Equal(string(models.SuperUserRole)))
+
+				Expect(manager.RevokeUserRole(ctx, "user1", string(models.SuperUserRole))).Should(Succeed())
+				user, err = manager.DescribeUser(ctx, "user1")
+				Expect(err).Should(Succeed())
+				Expect(user.RoleName).Should(Equal(string(models.ReadWriteRole)))
+
+				Expect(manager.UpdateUserPassword(ctx, "user1", "pwd2", true)).Should(Equal(models.ErrNoDualPassword))
+				Expect(fake.passwords["user1"]).Should(Equal("pwd1"))
+				Expect(manager.UpdateUserPassword(ctx, "user1", "pwd2", false)).Should(Succeed())
+				Expect(fake.passwords["user1"]).Should(Equal("pwd2"))
+				Expect(manager.DiscardOldUserPassword(ctx, "user1")).Should(Succeed())
+
+				Expect(manager.DeleteUser(ctx, "user1")).Should(Succeed())
+				Expect(manager.DeleteUser(ctx, "user1")).Should(Succeed())
+				_, err = manager.DescribeUser(ctx, "user1")
+				Expect(err).Should(Equal(models.ErrNoSuchUser))
+			})
+
+			It("rejects an invalid role", func() {
+				Expect(manager.CreateUser(ctx, "user1", "pwd1")).Should(Succeed())
+				Expect(manager.GrantUserRole(ctx, "user1", "invalid")).Should(Equal(models.ErrInvalidRoleName))
+			})
+		})
+	}
+})
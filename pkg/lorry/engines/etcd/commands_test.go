@@ -0,0 +1,61 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package etcd
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+var _ = Describe("ETCD Engine", func() {
+	It("connection command", func() {
+		etcd := NewCommands()
+
+		Expect(etcd.ConnectCommand(nil)).Should(Equal([]string{"sh", "-c", "etcdctl --endpoints=http://127.0.0.1:2379"}))
+		authInfo := &engines.AuthInfo{
+			UserName:   "user-test",
+			UserPasswd: "pwd-test",
+		}
+		Expect(etcd.ConnectCommand(authInfo)[2]).Should(ContainSubstring("--user 'user-test:pwd-test'"))
+	})
+
+	It("connection example", func() {
+		etcd := NewCommands().(*Commands)
+
+		info := &engines.ConnectionInfo{
+			Host: "host",
+			Port: "2379",
+		}
+		for k := range etcd.examples {
+			Expect(etcd.ConnectExample(info, k.String())).Should(ContainSubstring("http://host:2379"))
+		}
+	})
+
+	It("execute command", func() {
+		etcd := NewCommands()
+
+		cmd, envs, err := etcd.ExecuteCommand([]string{"member list"})
+		Expect(err).Should(BeNil())
+		Expect(envs).Should(BeNil())
+		Expect(cmd).Should(Equal([]string{"/bin/sh", "-c", "etcdctl --endpoints=http://$KB_HOST:2379 member list"}))
+	})
+})
@@ -27,8 +27,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/viper"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	v3 "go.etcd.io/etcd/client/v3"
+	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
 )
 
@@ -102,4 +107,116 @@ var _ = Describe("ETCD DBManager", func() {
 			Expect(role).Should(Equal("Leader"))
 		})
 	})
+
+	Context("membership", func() {
+		var (
+			etcdServer *EmbeddedETCD
+			manager    *Manager
+			cluster    *dcs.Cluster
+		)
+
+		BeforeEach(func() {
+			var err error
+			etcdServer, err = StartEtcdServer()
+			Expect(err).Should(BeNil())
+			addr := etcdServer.ETCD.Clients[0].Addr().(*net.TCPAddr)
+			manager = &Manager{
+				DBManagerBase: engines.DBManagerBase{
+					CurrentMemberName: etcdServer.ETCD.Config().Name,
+					Logger:            ctrl.Log.WithName("ETCD"),
+				},
+				etcd:     etcdServer.client,
+				endpoint: fmt.Sprintf("http://%s", addr.String()),
+			}
+			cluster = &dcs.Cluster{
+				Members: []dcs.Member{
+					{
+						Name:   etcdServer.ETCD.Config().Name,
+						PodIP:  addr.IP.String(),
+						DBPort: fmt.Sprintf("%d", addr.Port),
+						UseIP:  true,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			etcdServer.Stop()
+		})
+
+		It("checks the current member", func() {
+			Expect(manager.IsRunning()).Should(BeTrue())
+			Expect(manager.IsCurrentMemberInCluster(context.Background(), cluster)).Should(BeTrue())
+			Expect(manager.IsCurrentMemberHealthy(context.Background(), cluster)).Should(BeTrue())
+			Expect(manager.GetMemberAddrs(context.Background(), cluster)).Should(HaveLen(1))
+			lag, err := manager.GetLag(context.Background(), cluster)
+			Expect(err).Should(BeNil())
+			Expect(lag).Should(BeZero())
+		})
+
+		It("joins as learner and leaves", func() {
+			cluster.Members = append(cluster.Members, dcs.Member{
+				Name:  "etcd-1",
+				PodIP: "127.0.0.2",
+				UseIP: true,
+			})
+			leader := manager.CurrentMemberName
+			manager.CurrentMemberName = "etcd-1"
+			Expect(manager.IsCurrentMemberInCluster(context.Background(), cluster)).Should(BeFalse())
+
+			// the learner is never started, so it can't be promoted
+			err := manager.JoinCurrentMemberToCluster(context.Background(), cluster)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("not in sync"))
+			Expect(manager.IsCurrentMemberInCluster(context.Background(), cluster)).Should(BeTrue())
+			resp, err := etcdServer.client.MemberList(context.Background())
+			Expect(err).Should(BeNil())
+			Expect(resp.Members).Should(HaveLen(2))
+			Expect(findMember(resp.Members, "", "http://127.0.0.2:2380").IsLearner).Should(BeTrue())
+
+			// join again doesn't add the learner twice
+			Expect(manager.JoinCurrentMemberToCluster(context.Background(), cluster)).Should(HaveOccurred())
+			resp, err = etcdServer.client.MemberList(context.Background())
+			Expect(err).Should(BeNil())
+			Expect(resp.Members).Should(HaveLen(2))
+
+			manager.CurrentMemberName = leader
+			Expect(manager.LeaveMemberFromCluster(context.Background(), cluster, "etcd-1")).Should(Succeed())
+			Expect(manager.LeaveMemberFromCluster(context.Background(), cluster, "etcd-1")).Should(Succeed())
+			resp, err = etcdServer.client.MemberList(context.Background())
+			Expect(err).Should(BeNil())
+			Expect(resp.Members).Should(HaveLen(1))
+		})
+
+		It("sends the leader transfer to the leader", func() {
+			status, err := etcdServer.client.Status(context.Background(), manager.endpoint)
+			Expect(err).Should(BeNil())
+			client, release, err := manager.getLeaderClient(nil, status)
+			Expect(err).Should(BeNil())
+			Expect(client).Should(BeIdenticalTo(manager.etcd))
+			release()
+
+			otherStatus := &v3.StatusResponse{Header: &pb.ResponseHeader{MemberId: 2}, Leader: 1}
+			members := []*pb.Member{{ID: 1, Name: "etcd-0"}, {ID: 2, Name: "etcd-1"}}
+			_, _, err = manager.getLeaderClient(members, otherStatus)
+			Expect(err).Should(HaveOccurred())
+			members[0].ClientURLs = []string{"http://127.0.0.2:2379"}
+			client, release, err = manager.getLeaderClient(members, otherStatus)
+			Expect(err).Should(BeNil())
+			Expect(client.Endpoints()).Should(Equal(members[0].ClientURLs))
+			release()
+		})
+
+		It("locks and unlocks", func() {
+			Expect(manager.Lock(context.Background(), "disk full")).Should(Succeed())
+			Expect(manager.IsLocked).Should(BeTrue())
+			_, err := etcdServer.client.Put(context.Background(), "key", "value")
+			Expect(err).Should(MatchError(rpctypes.ErrNoSpace))
+
+			Expect(manager.Unlock(context.Background())).Should(Succeed())
+			Expect(manager.IsLocked).Should(BeFalse())
+			_, err = etcdServer.client.Put(context.Background(), "key", "value")
+			Expect(err).Should(BeNil())
+		})
+	})
 })
@@ -0,0 +1,435 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	v3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+)
+
+const (
+	defaultPeerPort = 2380
+
+	// requestTimeout bounds the requests to other members, the etcd client waits for the connection to be ready.
+	requestTimeout = 3 * time.Second
+
+	// healthCheckKey is the key read by the health check, the same as etcdctl endpoint health.
+	healthCheckKey = "health"
+)
+
+func (mgr *Manager) IsRunning() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultDialTimeout)
+	defer cancel()
+
+	_, err := mgr.etcd.Status(ctx, mgr.endpoint)
+	if err != nil {
+		mgr.Logger.Info("get etcd status failed", "error", err.Error())
+		return false
+	}
+	return true
+}
+
+func (mgr *Manager) IsCurrentMemberInCluster(ctx context.Context, cluster *dcs.Cluster) bool {
+	client, release, err := mgr.getClusterClient(cluster)
+	if err != nil {
+		mgr.Logger.Info("get cluster client failed", "error", err.Error())
+		return true
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		mgr.Logger.Info("list members failed", "error", err.Error())
+		return true
+	}
+	return findMember(resp.Members, mgr.CurrentMemberName, mgr.getPeerURL(cluster, mgr.CurrentMemberName)) != nil
+}
+
+// JoinCurrentMemberToCluster adds the current member as a learner first, so the quorum is not affected
+// while it catches up with the leader, and then promotes it to a voting member.
+// The promotion fails until the learner is in sync, the caller is expected to retry.
+func (mgr *Manager) JoinCurrentMemberToCluster(ctx context.Context, cluster *dcs.Cluster) error {
+	peerURL := mgr.getPeerURL(cluster, mgr.CurrentMemberName)
+	if peerURL == "" {
+		return errors.Errorf("member %s not found in cluster", mgr.CurrentMemberName)
+	}
+
+	client, release, err := mgr.getClusterClient(cluster)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := client.MemberList(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list members failed")
+	}
+
+	member := findMember(resp.Members, mgr.CurrentMemberName, peerURL)
+	if member == nil {
+		mgr.Logger.Info("add member as learner", "member", mgr.CurrentMemberName, "peerURL", peerURL)
+		addResp, err := client.MemberAddAsLearner(ctx, []string{peerURL})
+		if err != nil {
+			return errors.Wrap(err, "add learner failed")
+		}
+		member = addResp.Member
+	}
+	if !member.IsLearner {
+		return nil
+	}
+
+	_, err = client.MemberPromote(ctx, member.ID)
+	if err != nil {
+		if errors.Is(err, rpctypes.ErrMemberLearnerNotReady) {
+			return errors.Errorf("learner %s is not in sync with the leader yet", mgr.CurrentMemberName)
+		}
+		return errors.Wrap(err, "promote learner failed")
+	}
+	mgr.Logger.Info("member joined", "member", mgr.CurrentMemberName)
+	return nil
+}
+
+func (mgr *Manager) LeaveMemberFromCluster(ctx context.Context, cluster *dcs.Cluster, memberName string) error {
+	resp, err := mgr.etcd.MemberList(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list members failed")
+	}
+
+	member := findMember(resp.Members, memberName, mgr.getPeerURL(cluster, memberName))
+	if member == nil {
+		mgr.Logger.Info("member is already deleted", "member", memberName)
+		return nil
+	}
+
+	if err = mgr.transferLeader(ctx, resp.Members, member.ID); err != nil {
+		return err
+	}
+
+	mgr.Logger.Info(fmt.Sprintf("Delete member: %s", memberName))
+	_, err = mgr.etcd.MemberRemove(ctx, member.ID)
+	if err != nil && !errors.Is(err, rpctypes.ErrMemberNotFound) {
+		return errors.Wrap(err, "remove member failed")
+	}
+	return nil
+}
+
+// transferLeader moves the leadership to another voting member if the member to remove is the leader,
+// to avoid the election timeout after it's gone. The request is sent to the leader, which may be another member.
+func (mgr *Manager) transferLeader(ctx context.Context, members []*pb.Member, memberID uint64) error {
+	status, err := mgr.etcd.Status(ctx, mgr.endpoint)
+	if err != nil {
+		return errors.Wrap(err, "get etcd status failed")
+	}
+	if status.Leader != memberID {
+		return nil
+	}
+
+	var transferee *pb.Member
+	for _, member := range members {
+		if member.ID != memberID && !member.IsLearner {
+			transferee = member
+			break
+		}
+	}
+	if transferee == nil {
+		return nil
+	}
+
+	client, release, err := mgr.getLeaderClient(members, status)
+	if err != nil {
+		return err
+	}
+	defer release()
+	mgr.Logger.Info("move leader", "transferee", transferee.Name)
+	if _, err = client.MoveLeader(ctx, transferee.ID); err != nil {
+		return errors.Wrap(err, "move leader failed")
+	}
+	return nil
+}
+
+// getLeaderClient returns a client connecting to the leader, the release func should be called when it's done.
+func (mgr *Manager) getLeaderClient(members []*pb.Member, status *v3.StatusResponse) (*v3.Client, func(), error) {
+	if status.Leader == status.Header.MemberId {
+		return mgr.etcd, func() {}, nil
+	}
+	for _, member := range members {
+		if member.ID != status.Leader {
+			continue
+		}
+		if len(member.ClientURLs) == 0 {
+			break
+		}
+		return newClient(member.ClientURLs)
+	}
+	return nil, nil, errors.Errorf("leader %x has no client url", status.Leader)
+}
+
+func (mgr *Manager) GetMemberAddrs(ctx context.Context, cluster *dcs.Cluster) []string {
+	resp, err := mgr.etcd.MemberList(ctx)
+	if err != nil {
+		mgr.Logger.Info("list members failed", "error", err.Error())
+		return nil
+	}
+
+	addrs := make([]string, 0, len(resp.Members))
+	for _, member := range resp.Members {
+		if len(member.PeerURLs) == 0 {
+			continue
+		}
+		peerURL, err := url.Parse(member.PeerURLs[0])
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, peerURL.Host)
+	}
+	return addrs
+}
+
+// IsCurrentMemberHealthy checks the current member with a linearizable read, which requires the quorum.
+func (mgr *Manager) IsCurrentMemberHealthy(ctx context.Context, cluster *dcs.Cluster) bool {
+	if !mgr.IsMemberHealthy(ctx, cluster, nil) {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	_, err := mgr.etcd.Get(ctx, healthCheckKey)
+	if err != nil && !errors.Is(err, rpctypes.ErrPermissionDenied) {
+		mgr.Logger.Info("health check read failed", "error", err.Error())
+		return false
+	}
+	return true
+}
+
+// IsMemberHealthy checks that the member has a leader and no alarm raised.
+func (mgr *Manager) IsMemberHealthy(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) bool {
+	endpoint := mgr.getMemberEndpoint(cluster, member)
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	status, err := mgr.etcd.Status(ctx, endpoint)
+	if err != nil {
+		mgr.Logger.Info("get etcd status failed", "endpoint", endpoint, "error", err.Error())
+		return false
+	}
+	if len(status.Errors) > 0 {
+		mgr.Logger.Info("member has errors", "endpoint", endpoint, "errors", status.Errors)
+		return false
+	}
+	return status.Leader != 0
+}
+
+// IsMemberLagging compares the raft index of the member with the one of the leader.
+func (mgr *Manager) IsMemberLagging(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) (bool, int64) {
+	lag, err := mgr.getLag(ctx, mgr.getMemberEndpoint(cluster, member))
+	if err != nil {
+		mgr.Logger.Info("get lag failed", "member", member.Name, "error", err.Error())
+		return true, 0
+	}
+	if lag <= cluster.HaConfig.GetMaxLagOnSwitchover() {
+		return false, lag
+	}
+	mgr.Logger.Info(fmt.Sprintf("The member %s has lag: %d", member.Name, lag))
+	return true, lag
+}
+
+// GetLag returns the raft entries that the current member lags behind the leader.
+func (mgr *Manager) GetLag(ctx context.Context, cluster *dcs.Cluster) (int64, error) {
+	return mgr.getLag(ctx, mgr.endpoint)
+}
+
+func (mgr *Manager) getLag(ctx context.Context, endpoint string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	status, err := mgr.etcd.Status(ctx, endpoint)
+	if err != nil {
+		return 0, err
+	}
+	if status.Leader == 0 {
+		return 0, errors.New("no leader")
+	}
+	if status.Leader == status.Header.MemberId {
+		return 0, nil
+	}
+
+	resp, err := mgr.etcd.MemberList(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var leaderEndpoint string
+	for _, member := range resp.Members {
+		if member.ID == status.Leader && len(member.ClientURLs) > 0 {
+			leaderEndpoint = member.ClientURLs[0]
+		}
+	}
+	if leaderEndpoint == "" {
+		return 0, errors.Errorf("leader %x has no client url", status.Leader)
+	}
+	leaderStatus, err := mgr.etcd.Status(ctx, leaderEndpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	lag := int64(leaderStatus.RaftIndex) - int64(status.RaftIndex)
+	if lag < 0 {
+		lag = 0
+	}
+	return lag, nil
+}
+
+// Lock raises the NOSPACE alarm on the current member, which is what etcd does by itself once the backend
+// exceeds its quota, the cluster rejects writes and only serves reads and deletes until the alarm is disarmed.
+func (mgr *Manager) Lock(ctx context.Context, reason string) error {
+	mgr.Logger.Info(fmt.Sprintf("Lock db: %s", reason))
+	status, err := mgr.etcd.Status(ctx, mgr.endpoint)
+	if err != nil {
+		return errors.Wrap(err, "get etcd status failed")
+	}
+
+	// the alarm can't be raised with the maintenance API of the client, the raw RPC is sent instead
+	client, release, err := newClient([]string{mgr.endpoint})
+	if err != nil {
+		return err
+	}
+	defer release()
+	_, err = pb.NewMaintenanceClient(client.ActiveConnection()).Alarm(ctx, &pb.AlarmRequest{
+		Action:   pb.AlarmRequest_ACTIVATE,
+		MemberID: status.Header.MemberId,
+		Alarm:    pb.AlarmType_NOSPACE,
+	})
+	if err != nil {
+		return errors.Wrap(err, "raise alarm failed")
+	}
+
+	mgr.IsLocked = true
+	mgr.Logger.Info("Lock db success")
+	return nil
+}
+
+// Unlock defragments the current member to give the freed space back, and then disarms the NOSPACE alarm
+// raised on it, otherwise etcd keeps rejecting writes even if the disk is no longer full.
+func (mgr *Manager) Unlock(ctx context.Context) error {
+	mgr.Logger.Info("Unlock db")
+	status, err := mgr.etcd.Status(ctx, mgr.endpoint)
+	if err != nil {
+		return errors.Wrap(err, "get etcd status failed")
+	}
+
+	if _, err = mgr.etcd.Defragment(ctx, mgr.endpoint); err != nil {
+		return errors.Wrap(err, "defragment failed")
+	}
+
+	alarms, err := mgr.etcd.AlarmList(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list alarms failed")
+	}
+	for _, alarm := range alarms.Alarms {
+		if alarm.MemberID != status.Header.MemberId || alarm.Alarm != pb.AlarmType_NOSPACE {
+			continue
+		}
+		if _, err = mgr.etcd.AlarmDisarm(ctx, (*v3.AlarmMember)(alarm)); err != nil {
+			return errors.Wrap(err, "disarm alarm failed")
+		}
+	}
+
+	mgr.IsLocked = false
+	mgr.Logger.Info("Unlock db success")
+	return nil
+}
+
+// getClusterClient returns a client connecting to the other members, since the current one may not be a member yet.
+func (mgr *Manager) getClusterClient(cluster *dcs.Cluster) (*v3.Client, func(), error) {
+	var endpoints []string
+	for _, member := range cluster.Members {
+		if member.Name == mgr.CurrentMemberName {
+			continue
+		}
+		endpoints = append(endpoints, mgr.getClientURL(cluster, member))
+	}
+	if len(endpoints) == 0 {
+		return mgr.etcd, func() {}, nil
+	}
+
+	return newClient(endpoints)
+}
+
+// newClient returns a client connecting to the endpoints, the release func should be called when it's done.
+func newClient(endpoints []string) (*v3.Client, func(), error) {
+	client, err := v3.New(v3.Config{
+		Endpoints:   endpoints,
+		DialTimeout: defaultDialTimeout,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, func() { _ = client.Close() }, nil
+}
+
+func (mgr *Manager) getMemberEndpoint(cluster *dcs.Cluster, member *dcs.Member) string {
+	if member == nil || member.Name == mgr.CurrentMemberName {
+		return mgr.endpoint
+	}
+	return mgr.getClientURL(cluster, *member)
+}
+
+func (mgr *Manager) getClientURL(cluster *dcs.Cluster, member dcs.Member) string {
+	port := member.DBPort
+	if port == "" {
+		port = strconv.Itoa(defaultPort)
+	}
+	return "http://" + net.JoinHostPort(cluster.GetMemberAddr(member), port)
+}
+
+func (mgr *Manager) getPeerURL(cluster *dcs.Cluster, memberName string) string {
+	member := cluster.GetMemberWithName(memberName)
+	if member == nil {
+		return ""
+	}
+	return "http://" + net.JoinHostPort(cluster.GetMemberAddr(*member), strconv.Itoa(defaultPeerPort))
+}
+
+// findMember matches the member by name, or by peer url for the learner which has not started yet.
+func findMember(members []*pb.Member, name, peerURL string) *pb.Member {
+	for _, member := range members {
+		if name != "" && member.Name == name {
+			return member
+		}
+		for _, u := range member.PeerURLs {
+			if peerURL != "" && u == peerURL {
+				return member
+			}
+		}
+	}
+	return nil
+}
@@ -117,6 +117,8 @@ type DBManager interface {
 	DescribeUser(context.Context, string) (*models.UserInfo, error)
 	GrantUserRole(context.Context, string, string) error
 	RevokeUserRole(context.Context, string, string) error
+	UpdateUserPassword(context.Context, string, string, bool) error
+	DiscardOldUserPassword(context.Context, string) error
 
 	GetPort() (int, error)
 
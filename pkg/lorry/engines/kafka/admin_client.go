@@ -0,0 +1,342 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/xdg-go/scram"
+)
+
+const (
+	defaultRequestTimeout = 30 * time.Second
+	maxResponseSize       = 100 << 20
+
+	clientID              = "kubeblocks-lorry"
+	clientSoftwareVersion = "1.0.0"
+
+	// the error codes of the Kafka protocol handled by lorry.
+	errCodeResourceNotFound int16 = 91
+)
+
+// saslConfig is the SASL settings to authenticate the admin client.
+type saslConfig struct {
+	mechanism string
+	user      string
+	password  string
+}
+
+// adminClient issues the Kafka admin API requests, the messages are encoded by kmsg.
+// A connection is dialed for each request, as lorry only sends the requests occasionally.
+type adminClient struct {
+	seeds     []string
+	sasl      *saslConfig
+	tlsConfig *tls.Config
+	formatter *kmsg.RequestFormatter
+}
+
+var _ kmsg.Requestor = &adminClient{}
+
+func newAdminClient(seeds string, sasl *saslConfig, tlsConfig *tls.Config) *adminClient {
+	client := &adminClient{
+		sasl:      sasl,
+		tlsConfig: tlsConfig,
+		formatter: kmsg.NewRequestFormatter(kmsg.FormatterClientID(clientID)),
+	}
+	for _, seed := range strings.Split(seeds, ",") {
+		if seed = strings.TrimSpace(seed); seed != "" {
+			client.seeds = append(client.seeds, seed)
+		}
+	}
+	return client
+}
+
+// Request sends the request to the first reachable seed and returns the response.
+func (c *adminClient) Request(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.request(req)
+}
+
+func (c *adminClient) connect(ctx context.Context) (*brokerConn, error) {
+	var lastErr error
+	for _, seed := range c.seeds {
+		conn, err := c.dial(ctx, seed)
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		return nil, errors.New("no kafka address is configured")
+	}
+	return nil, lastErr
+}
+
+func (c *adminClient) dial(ctx context.Context, address string) (*brokerConn, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s failed", address)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if c.tlsConfig != nil {
+		tlsConfig := c.tlsConfig.Clone()
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName, _, _ = net.SplitHostPort(address)
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return nil, errors.Wrapf(err, "tls handshake with %s failed", address)
+		}
+		conn = tlsConn
+	}
+
+	bc := &brokerConn{Conn: conn, address: address, formatter: c.formatter}
+	if err = bc.negotiateVersions(); err == nil && c.sasl != nil {
+		err = bc.authenticate(c.sasl)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return bc, nil
+}
+
+// brokerConn is a connection to a Kafka node, whose supported API versions are known.
+type brokerConn struct {
+	net.Conn
+	address       string
+	formatter     *kmsg.RequestFormatter
+	correlationID int32
+	maxVersions   map[int16]int16
+}
+
+func (c *brokerConn) negotiateVersions() error {
+	req := kmsg.NewPtrApiVersionsRequest()
+	req.ClientSoftwareName = clientID
+	req.ClientSoftwareVersion = clientSoftwareVersion
+	req.SetVersion(3)
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		return err
+	}
+	versions := resp.(*kmsg.ApiVersionsResponse)
+	if err = newKafkaError(versions.ErrorCode, nil); err != nil {
+		return errors.Wrapf(err, "get api versions from %s failed", c.address)
+	}
+	c.maxVersions = make(map[int16]int16, len(versions.ApiKeys))
+	for _, key := range versions.ApiKeys {
+		c.maxVersions[key.ApiKey] = key.MaxVersion
+	}
+	return nil
+}
+
+func (c *brokerConn) authenticate(sasl *saslConfig) error {
+	handshake := kmsg.NewPtrSASLHandshakeRequest()
+	handshake.Mechanism = sasl.mechanism
+	resp, err := c.request(handshake)
+	if err != nil {
+		return err
+	}
+	if err = newKafkaError(resp.(*kmsg.SASLHandshakeResponse).ErrorCode, nil); err != nil {
+		return errors.Wrapf(err, "sasl handshake with %s failed", c.address)
+	}
+
+	switch sasl.mechanism {
+	case "PLAIN":
+		_, err = c.saslAuthenticate([]byte("\x00" + sasl.user + "\x00" + sasl.password))
+		return err
+	case "SCRAM-SHA-256", "SCRAM-SHA-512":
+		return c.scramAuthenticate(sasl)
+	default:
+		return errors.Errorf("unsupported sasl mechanism: %s", sasl.mechanism)
+	}
+}
+
+func (c *brokerConn) scramAuthenticate(sasl *saslConfig) error {
+	hash := scram.SHA256
+	if sasl.mechanism == "SCRAM-SHA-512" {
+		hash = scram.SHA512
+	}
+	client, err := hash.NewClient(sasl.user, sasl.password, "")
+	if err != nil {
+		return err
+	}
+	conversation := client.NewConversation()
+	challenge := ""
+	for !conversation.Done() {
+		msg, err := conversation.Step(challenge)
+		if err != nil {
+			return errors.Wrapf(err, "scram authentication with %s failed", c.address)
+		}
+		if conversation.Done() {
+			break
+		}
+		reply, err := c.saslAuthenticate([]byte(msg))
+		if err != nil {
+			return err
+		}
+		challenge = string(reply)
+	}
+	if !conversation.Valid() {
+		return errors.Errorf("scram authentication with %s failed: invalid server signature", c.address)
+	}
+	return nil
+}
+
+func (c *brokerConn) saslAuthenticate(msg []byte) ([]byte, error) {
+	req := kmsg.NewPtrSASLAuthenticateRequest()
+	req.SASLAuthBytes = msg
+	resp, err := c.request(req)
+	if err != nil {
+		return nil, err
+	}
+	authResp := resp.(*kmsg.SASLAuthenticateResponse)
+	if err = newKafkaError(authResp.ErrorCode, authResp.ErrorMessage); err != nil {
+		return nil, errors.Wrapf(err, "sasl authentication with %s failed", c.address)
+	}
+	return authResp.SASLAuthBytes, nil
+}
+
+// request sends the request with the highest version supported by both sides.
+func (c *brokerConn) request(req kmsg.Request) (kmsg.Response, error) {
+	maxVersion, ok := c.maxVersions[req.Key()]
+	if !ok {
+		return nil, errors.Errorf("api key %d is not supported by %s", req.Key(), c.address)
+	}
+	req.SetVersion(min(req.MaxVersion(), maxVersion))
+	return c.roundTrip(req)
+}
+
+func (c *brokerConn) roundTrip(req kmsg.Request) (kmsg.Response, error) {
+	c.correlationID++
+	if _, err := c.Write(c.formatter.AppendRequest(nil, req, c.correlationID)); err != nil {
+		return nil, errors.Wrapf(err, "send request to %s failed", c.address)
+	}
+
+	sizeBuf := make([]byte, 4)
+	if _, err := io.ReadFull(c, sizeBuf); err != nil {
+		return nil, errors.Wrapf(err, "read response from %s failed", c.address)
+	}
+	size := int32(binary.BigEndian.Uint32(sizeBuf))
+	if size < 4 || size > maxResponseSize {
+		return nil, errors.Errorf("invalid response size %d from %s", size, c.address)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(c, body); err != nil {
+		return nil, errors.Wrapf(err, "read response from %s failed", c.address)
+	}
+	if correlationID := int32(binary.BigEndian.Uint32(body)); correlationID != c.correlationID {
+		return nil, errors.Errorf("unexpected correlation id %d from %s, expected %d", correlationID, c.address, c.correlationID)
+	}
+	body = body[4:]
+
+	resp := req.ResponseKind()
+	resp.SetVersion(req.GetVersion())
+	// the response header of ApiVersions is never flexible, for the clients to parse it without knowing the versions
+	if resp.IsFlexible() && req.Key() != kmsg.ApiVersions.Int16() {
+		var err error
+		if body, err = skipTaggedFields(body); err != nil {
+			return nil, err
+		}
+	}
+	if err := resp.ReadFrom(body); err != nil {
+		return nil, errors.Wrapf(err, "parse response from %s failed", c.address)
+	}
+	return resp, nil
+}
+
+// skipTaggedFields skips the tagged fields of the flexible response header.
+func skipTaggedFields(body []byte) ([]byte, error) {
+	readUvarint := func() (uint64, error) {
+		value, n := binary.Uvarint(body)
+		if n <= 0 {
+			return 0, errors.New("invalid tagged fields in response header")
+		}
+		body = body[n:]
+		return value, nil
+	}
+	num, err := readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < num; i++ {
+		if _, err = readUvarint(); err != nil {
+			return nil, err
+		}
+		size, err := readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if size > uint64(len(body)) {
+			return nil, errors.New("invalid tagged fields in response header")
+		}
+		body = body[size:]
+	}
+	return body, nil
+}
+
+// kafkaError is the error code returned in the Kafka responses.
+type kafkaError struct {
+	code    int16
+	message string
+}
+
+func (e *kafkaError) Error() string {
+	if e.message == "" {
+		return fmt.Sprintf("kafka error code %d", e.code)
+	}
+	return fmt.Sprintf("kafka error code %d: %s", e.code, e.message)
+}
+
+func newKafkaError(code int16, message *string) error {
+	if code == 0 {
+		return nil
+	}
+	err := &kafkaError{code: code}
+	if message != nil {
+		err.message = *message
+	}
+	return err
+}
+
+func isKafkaError(err error, code int16) bool {
+	var kerr *kafkaError
+	return errors.As(err, &kerr) && kerr.code == code
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// fakeBroker serves the Kafka wire protocol with the handlers.
+type fakeBroker struct {
+	listener net.Listener
+	versions map[kmsg.Key]int16
+	handlers map[kmsg.Key]func(kmsg.Request) kmsg.Response
+	received []kmsg.Request
+}
+
+func newFakeBroker() *fakeBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).Should(Succeed())
+	broker := &fakeBroker{
+		listener: listener,
+		versions: map[kmsg.Key]int16{},
+		handlers: map[kmsg.Key]func(kmsg.Request) kmsg.Response{},
+	}
+	broker.handlers[kmsg.ApiVersions] = func(kmsg.Request) kmsg.Response {
+		resp := kmsg.NewPtrApiVersionsResponse()
+		for key, version := range broker.versions {
+			apiKey := kmsg.NewApiVersionsResponseApiKey()
+			apiKey.ApiKey = key.Int16()
+			apiKey.MaxVersion = version
+			resp.ApiKeys = append(resp.ApiKeys, apiKey)
+		}
+		return resp
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go broker.serve(conn)
+		}
+	}()
+	DeferCleanup(listener.Close)
+	return broker
+}
+
+func (b *fakeBroker) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		sizeBuf := make([]byte, 4)
+		if _, err := io.ReadFull(conn, sizeBuf); err != nil {
+			return
+		}
+		body := make([]byte, binary.BigEndian.Uint32(sizeBuf))
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return
+		}
+		key := int16(binary.BigEndian.Uint16(body))
+		req := kmsg.RequestForKey(key)
+		req.SetVersion(int16(binary.BigEndian.Uint16(body[2:])))
+		correlationID := body[4:8]
+		clientIDLen := int16(binary.BigEndian.Uint16(body[8:]))
+		body = body[10+clientIDLen:]
+		if req.IsFlexible() {
+			body = body[1:]
+		}
+		if err := req.ReadFrom(body); err != nil {
+			return
+		}
+		b.received = append(b.received, req)
+
+		resp := b.handlers[kmsg.Key(key)](req)
+		resp.SetVersion(req.GetVersion())
+		out := append([]byte{0, 0, 0, 0}, correlationID...)
+		if resp.IsFlexible() && kmsg.Key(key) != kmsg.ApiVersions {
+			out = append(out, 0)
+		}
+		out = resp.AppendTo(out)
+		binary.BigEndian.PutUint32(out, uint32(len(out)-4))
+		if _, err := conn.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+var _ = Describe("Kafka admin client", func() {
+	var broker *fakeBroker
+
+	BeforeEach(func() {
+		broker = newFakeBroker()
+		broker.versions[kmsg.ApiVersions] = 3
+		broker.versions[kmsg.Metadata] = 5
+		broker.handlers[kmsg.Metadata] = func(kmsg.Request) kmsg.Response {
+			return newMetadataResponse([]int32{0, 1}, nil)
+		}
+	})
+
+	It("negotiates the api versions", func() {
+		client := newAdminClient("127.0.0.1:1, "+broker.listener.Addr().String(), nil, nil)
+		resp, err := client.Request(context.Background(), kmsg.NewPtrMetadataRequest())
+		Expect(err).Should(Succeed())
+		Expect(resp.(*kmsg.MetadataResponse).Brokers).Should(HaveLen(2))
+		Expect(broker.received).Should(HaveLen(2))
+		Expect(broker.received[1].GetVersion()).Should(Equal(int16(5)))
+
+		By("the request not supported by the broker fails")
+		_, err = client.Request(context.Background(), kmsg.NewPtrDescribeQuorumRequest())
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("authenticates with the sasl mechanism", func() {
+		broker.versions[kmsg.SASLHandshake] = 1
+		broker.versions[kmsg.SASLAuthenticate] = 2
+		broker.handlers[kmsg.SASLHandshake] = func(kmsg.Request) kmsg.Response {
+			return kmsg.NewPtrSASLHandshakeResponse()
+		}
+		broker.handlers[kmsg.SASLAuthenticate] = func(req kmsg.Request) kmsg.Response {
+			resp := kmsg.NewPtrSASLAuthenticateResponse()
+			if string(req.(*kmsg.SASLAuthenticateRequest).SASLAuthBytes) != "\x00admin\x00password" {
+				resp.ErrorCode = 58
+			}
+			return resp
+		}
+
+		sasl := &saslConfig{mechanism: "PLAIN", user: "admin", password: "password"}
+		client := newAdminClient(broker.listener.Addr().String(), sasl, nil)
+		_, err := client.Request(context.Background(), kmsg.NewPtrMetadataRequest())
+		Expect(err).Should(Succeed())
+		Expect(broker.received[1].(*kmsg.SASLHandshakeRequest).Mechanism).Should(Equal("PLAIN"))
+
+		sasl.password = "wrong"
+		_, err = client.Request(context.Background(), kmsg.NewPtrMetadataRequest())
+		Expect(isKafkaError(err, 58)).Should(BeTrue())
+	})
+})
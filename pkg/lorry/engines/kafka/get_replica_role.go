@@ -0,0 +1,115 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+)
+
+const (
+	// ControllerRole is the role of the active controller of the KRaft quorum.
+	ControllerRole = "controller"
+	// StandbyControllerRole is the role of the voters other than the active controller.
+	StandbyControllerRole = "standby-controller"
+	// BrokerRole is the role of the broker only node.
+	BrokerRole = "broker"
+
+	// metadataTopic is the topic of the KRaft metadata log.
+	metadataTopic = "__cluster_metadata"
+)
+
+// quorumStatus is the status of the KRaft quorum.
+type quorumStatus struct {
+	LeaderID  int
+	Voters    []int
+	Observers []int
+}
+
+func (mgr *Manager) GetReplicaRole(ctx context.Context, _ *dcs.Cluster) (string, error) {
+	if !mgr.isController() {
+		return BrokerRole, nil
+	}
+
+	status, err := mgr.describeQuorum(ctx)
+	if err != nil {
+		return "", err
+	}
+	if status.LeaderID == mgr.nodeID {
+		return ControllerRole, nil
+	}
+	return StandbyControllerRole, nil
+}
+
+func (mgr *Manager) describeQuorum(ctx context.Context) (*quorumStatus, error) {
+	// the controller only node has no broker listener, the quorum is described by the controllers
+	admin := mgr.brokerAdmin
+	if !mgr.isBroker() {
+		admin = mgr.controllerAdmin
+	}
+
+	req := kmsg.NewPtrDescribeQuorumRequest()
+	topic := kmsg.NewDescribeQuorumRequestTopic()
+	topic.Topic = metadataTopic
+	partition := kmsg.NewDescribeQuorumRequestTopicPartition()
+	topic.Partitions = append(topic.Partitions, partition)
+	req.Topics = append(req.Topics, topic)
+	resp, err := admin.Request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	quorumResp := resp.(*kmsg.DescribeQuorumResponse)
+	if err = newKafkaError(quorumResp.ErrorCode, nil); err != nil {
+		return nil, errors.Wrap(err, "describe quorum failed")
+	}
+	for _, t := range quorumResp.Topics {
+		for _, p := range t.Partitions {
+			if t.Topic == metadataTopic && p.Partition == partition.Partition {
+				return newQuorumStatus(p)
+			}
+		}
+	}
+	return nil, errors.Errorf("no %s partition found in quorum description", metadataTopic)
+}
+
+func newQuorumStatus(partition kmsg.DescribeQuorumResponseTopicPartition) (*quorumStatus, error) {
+	if err := newKafkaError(partition.ErrorCode, nil); err != nil {
+		return nil, errors.Wrap(err, "describe quorum failed")
+	}
+	if partition.LeaderID < 0 {
+		return nil, errors.New("no leader found in the quorum")
+	}
+	replicaIDs := func(replicas []kmsg.DescribeQuorumResponseTopicPartitionReplicaState) []int {
+		ids := make([]int, 0, len(replicas))
+		for _, replica := range replicas {
+			ids = append(ids, int(replica.ReplicaID))
+		}
+		return ids
+	}
+	return &quorumStatus{
+		LeaderID:  int(partition.LeaderID),
+		Voters:    replicaIDs(partition.CurrentVoters),
+		Observers: replicaIDs(partition.Observers),
+	}, nil
+}
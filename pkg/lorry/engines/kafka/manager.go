@@ -0,0 +1,250 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+const (
+	brokers       = "brokers"
+	controllers   = "controllers"
+	saslMechanism = "saslMechanism"
+	caFile        = "caFile"
+
+	defaultBrokers     = "localhost:9092"
+	defaultControllers = "localhost:9093"
+	defaultPort        = 9092
+
+	// the envs are the same as the ones used by the Kafka image to generate server.properties.
+	envNodeID       = "KAFKA_CFG_NODE_ID"
+	envProcessRoles = "KAFKA_CFG_PROCESS_ROLES"
+)
+
+// Manager manages Kafka in KRaft mode through the admin API.
+type Manager struct {
+	engines.DBManagerBase
+	brokers      string
+	nodeID       int
+	processRoles []string
+	// brokerAdmin sends the requests to the brokers, and controllerAdmin sends
+	// the requests to the controllers, which is used by the controller only node.
+	brokerAdmin     kmsg.Requestor
+	controllerAdmin kmsg.Requestor
+}
+
+var _ engines.DBManager = &Manager{}
+
+func NewManager(properties engines.Properties) (engines.DBManager, error) {
+	logger := ctrl.Log.WithName("Kafka")
+
+	managerBase, err := engines.NewDBManagerBase(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	sasl, tlsConfig, err := newClientSecurity(properties)
+	if err != nil {
+		return nil, err
+	}
+	mgr := &Manager{
+		DBManagerBase:   *managerBase,
+		brokers:         getProperty(properties, brokers, defaultBrokers),
+		processRoles:    []string{"broker", "controller"},
+		controllerAdmin: newAdminClient(getProperty(properties, controllers, defaultControllers), sasl, tlsConfig),
+	}
+	mgr.brokerAdmin = newAdminClient(mgr.brokers, sasl, tlsConfig)
+
+	if roles := viper.GetString(envProcessRoles); roles != "" {
+		mgr.processRoles = strings.Split(roles, ",")
+		for i := range mgr.processRoles {
+			mgr.processRoles[i] = strings.TrimSpace(mgr.processRoles[i])
+		}
+	}
+
+	nodeID := viper.GetString(envNodeID)
+	if nodeID == "" {
+		// the node id is the ordinal of the pod by default
+		mgr.nodeID, err = getOrdinal(mgr.CurrentMemberName)
+	} else {
+		mgr.nodeID, err = strconv.Atoi(nodeID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "get kafka node id failed")
+	}
+	return mgr, nil
+}
+
+func getProperty(properties engines.Properties, key, defaultValue string) string {
+	if value, ok := properties[key]; ok && value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// newClientSecurity returns the SASL and TLS settings of the admin clients, the SASL
+// credential is the service account of the component, which is passed by the envs.
+func newClientSecurity(properties engines.Properties) (*saslConfig, *tls.Config, error) {
+	var sasl *saslConfig
+	if mechanism := strings.ToUpper(properties[saslMechanism]); mechanism != "" {
+		sasl = &saslConfig{
+			mechanism: mechanism,
+			user:      viper.GetString(constant.KBEnvServiceUser),
+			password:  viper.GetString(constant.KBEnvServicePassword),
+		}
+	}
+
+	var tlsConfig *tls.Config
+	if file := properties[caFile]; file != "" {
+		ca, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "read kafka ca file failed")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, nil, errors.Errorf("no certificate found in %s", file)
+		}
+		tlsConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return sasl, tlsConfig, nil
+}
+
+func getOrdinal(memberName string) (int, error) {
+	index := strings.LastIndex(memberName, "-")
+	if index < 0 {
+		return 0, errors.Errorf("invalid member name: %s", memberName)
+	}
+	return strconv.Atoi(memberName[index+1:])
+}
+
+// getNodeID returns the node id of the member, the node ids of all members
+// share the same offset to the pod ordinals.
+func (mgr *Manager) getNodeID(memberName string) (int, error) {
+	if memberName == mgr.CurrentMemberName {
+		return mgr.nodeID, nil
+	}
+	currentOrdinal, err := getOrdinal(mgr.CurrentMemberName)
+	if err != nil {
+		return 0, err
+	}
+	ordinal, err := getOrdinal(memberName)
+	if err != nil {
+		return 0, err
+	}
+	return ordinal + mgr.nodeID - currentOrdinal, nil
+}
+
+func containsID(ids []int, id int) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (mgr *Manager) hasProcessRole(role string) bool {
+	for _, r := range mgr.processRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (mgr *Manager) isBroker() bool {
+	return mgr.hasProcessRole("broker")
+}
+
+func (mgr *Manager) isController() bool {
+	return mgr.hasProcessRole("controller")
+}
+
+func (mgr *Manager) IsDBStartupReady() bool {
+	if mgr.DBStartupReady {
+		return true
+	}
+
+	if err := mgr.checkHealth(context.Background()); err != nil {
+		mgr.Logger.Info("check kafka health failed", "error", err.Error())
+		return false
+	}
+
+	mgr.DBStartupReady = true
+	mgr.Logger.Info("DB startup ready")
+	return true
+}
+
+func (mgr *Manager) IsCurrentMemberHealthy(ctx context.Context, _ *dcs.Cluster) bool {
+	if err := mgr.checkHealth(ctx); err != nil {
+		mgr.Logger.Info("check kafka health failed", "error", err.Error())
+		return false
+	}
+	return true
+}
+
+// checkHealth checks whether the broker serves the API requests, or the
+// controller only node answers the quorum requests.
+func (mgr *Manager) checkHealth(ctx context.Context) error {
+	if !mgr.isBroker() {
+		_, err := mgr.describeQuorum(ctx)
+		return err
+	}
+	brokerIDs, err := mgr.listBrokers(ctx)
+	if err != nil {
+		return err
+	}
+	if !containsID(brokerIDs, mgr.nodeID) {
+		return errors.Errorf("broker %d is not registered", mgr.nodeID)
+	}
+	return nil
+}
+
+func (mgr *Manager) IsCurrentMemberInCluster(ctx context.Context, _ *dcs.Cluster) bool {
+	if !mgr.isBroker() {
+		status, err := mgr.describeQuorum(ctx)
+		return err == nil && containsID(status.Voters, mgr.nodeID)
+	}
+	brokerIDs, err := mgr.listBrokers(ctx)
+	return err == nil && containsID(brokerIDs, mgr.nodeID)
+}
+
+func (mgr *Manager) GetPort() (int, error) {
+	address := strings.Split(mgr.brokers, ",")[0]
+	index := strings.LastIndex(address, ":")
+	if index < 0 {
+		return defaultPort, nil
+	}
+	return strconv.Atoi(address[index+1:])
+}
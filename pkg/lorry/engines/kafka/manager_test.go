@@ -0,0 +1,400 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/exp/slices"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+func newMetadataResponse(brokerIDs []int32, partitionReplicas map[string][][]int32) *kmsg.MetadataResponse {
+	resp := kmsg.NewPtrMetadataResponse()
+	for _, id := range brokerIDs {
+		broker := kmsg.NewMetadataResponseBroker()
+		broker.NodeID = id
+		resp.Brokers = append(resp.Brokers, broker)
+	}
+	for name, replicas := range partitionReplicas {
+		topic := kmsg.NewMetadataResponseTopic()
+		topic.Topic = kmsg.StringPtr(name)
+		for i := range replicas {
+			partition := kmsg.NewMetadataResponseTopicPartition()
+			partition.Partition = int32(i)
+			partition.Replicas = replicas[i]
+			topic.Partitions = append(topic.Partitions, partition)
+		}
+		resp.Topics = append(resp.Topics, topic)
+	}
+	internal := kmsg.NewMetadataResponseTopic()
+	internal.Topic = kmsg.StringPtr("__consumer_offsets")
+	internal.IsInternal = true
+	resp.Topics = append(resp.Topics, internal)
+	return resp
+}
+
+func newQuorumResponse(leaderID int32, voterIDs, observerIDs []int32) *kmsg.DescribeQuorumResponse {
+	replicas := func(ids []int32) []kmsg.DescribeQuorumResponseTopicPartitionReplicaState {
+		var states []kmsg.DescribeQuorumResponseTopicPartitionReplicaState
+		for _, id := range ids {
+			state := kmsg.NewDescribeQuorumResponseTopicPartitionReplicaState()
+			state.ReplicaID = id
+			states = append(states, state)
+		}
+		return states
+	}
+	partition := kmsg.NewDescribeQuorumResponseTopicPartition()
+	partition.LeaderID = leaderID
+	partition.CurrentVoters = replicas(voterIDs)
+	partition.Observers = replicas(observerIDs)
+	topic := kmsg.NewDescribeQuorumResponseTopic()
+	topic.Topic = metadataTopic
+	topic.Partitions = append(topic.Partitions, partition)
+	resp := kmsg.NewPtrDescribeQuorumResponse()
+	resp.Topics = append(resp.Topics, topic)
+	return resp
+}
+
+var _ = Describe("Kafka DBManager", func() {
+	var (
+		ctx             = context.Background()
+		brokerAdmin     *fakeAdmin
+		controllerAdmin *fakeAdmin
+	)
+
+	newManager := func() *Manager {
+		dbManager, err := NewManager(engines.Properties{})
+		Expect(err).Should(Succeed())
+		mgr := dbManager.(*Manager)
+		mgr.brokerAdmin = brokerAdmin
+		mgr.controllerAdmin = controllerAdmin
+		return mgr
+	}
+
+	BeforeEach(func() {
+		brokerAdmin = newFakeAdmin()
+		controllerAdmin = newFakeAdmin()
+	})
+
+	AfterEach(func() {
+		viper.Set(envNodeID, "")
+		viper.Set(envProcessRoles, "")
+	})
+
+	Context("new db manager", func() {
+		It("takes the node id from the pod ordinal by default", func() {
+			mgr := newManager()
+			Expect(mgr.nodeID).Should(Equal(1))
+			Expect(mgr.isBroker()).Should(BeTrue())
+			Expect(mgr.isController()).Should(BeTrue())
+			port, err := mgr.GetPort()
+			Expect(err).Should(Succeed())
+			Expect(port).Should(Equal(9092))
+		})
+
+		It("takes the node id and roles from the envs", func() {
+			viper.Set(envNodeID, "101")
+			viper.Set(envProcessRoles, "broker")
+			mgr := newManager()
+			Expect(mgr.nodeID).Should(Equal(101))
+			Expect(mgr.isController()).Should(BeFalse())
+
+			nodeID, err := mgr.getNodeID("kafka-3")
+			Expect(err).Should(Succeed())
+			Expect(nodeID).Should(Equal(103))
+		})
+
+		It("takes the sasl mechanism from the properties", func() {
+			sasl, tlsConfig, err := newClientSecurity(engines.Properties{saslMechanism: "scram-sha-512"})
+			Expect(err).Should(Succeed())
+			Expect(sasl.mechanism).Should(Equal("SCRAM-SHA-512"))
+			Expect(tlsConfig).Should(BeNil())
+
+			_, _, err = newClientSecurity(engines.Properties{caFile: "/not/exist/ca.crt"})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("health check", func() {
+		It("checks whether the broker is registered", func() {
+			brokerAdmin.respond(kmsg.Metadata, newMetadataResponse([]int32{0, 1}, nil))
+			mgr := newManager()
+			Expect(mgr.IsDBStartupReady()).Should(BeTrue())
+			Expect(mgr.IsCurrentMemberHealthy(ctx, nil)).Should(BeTrue())
+			Expect(brokerAdmin.requested(kmsg.Metadata)[0].(*kmsg.MetadataRequest).Topics).ShouldNot(BeNil())
+
+			viper.Set(envNodeID, "2")
+			mgr = newManager()
+			Expect(mgr.IsCurrentMemberHealthy(ctx, nil)).Should(BeFalse())
+			Expect(mgr.IsCurrentMemberInCluster(ctx, nil)).Should(BeFalse())
+		})
+
+		It("checks the quorum for the controller only node", func() {
+			viper.Set(envProcessRoles, "controller")
+			controllerAdmin.respond(kmsg.DescribeQuorum, newQuorumResponse(0, []int32{0, 1, 2}, []int32{3}))
+			mgr := newManager()
+			Expect(mgr.IsCurrentMemberHealthy(ctx, nil)).Should(BeTrue())
+			Expect(mgr.IsCurrentMemberInCluster(ctx, nil)).Should(BeTrue())
+			Expect(brokerAdmin.requests).Should(BeEmpty())
+		})
+	})
+
+	Context("role detection", func() {
+		It("detects the controller roles in KRaft mode", func() {
+			brokerAdmin.respond(kmsg.DescribeQuorum, newQuorumResponse(0, []int32{0, 1, 2}, []int32{3}))
+
+			role, err := newManager().GetReplicaRole(ctx, nil)
+			Expect(err).Should(Succeed())
+			Expect(role).Should(Equal(StandbyControllerRole))
+
+			viper.Set(envNodeID, "0")
+			role, err = newManager().GetReplicaRole(ctx, nil)
+			Expect(err).Should(Succeed())
+			Expect(role).Should(Equal(ControllerRole))
+
+			viper.Set(envProcessRoles, "broker")
+			role, err = newManager().GetReplicaRole(ctx, nil)
+			Expect(err).Should(Succeed())
+			Expect(role).Should(Equal(BrokerRole))
+		})
+
+		It("describes the quorum", func() {
+			brokerAdmin.respond(kmsg.DescribeQuorum, newQuorumResponse(1, []int32{1, 2}, nil))
+			status, err := newManager().describeQuorum(ctx)
+			Expect(err).Should(Succeed())
+			Expect(status.LeaderID).Should(Equal(1))
+			Expect(status.Voters).Should(Equal([]int{1, 2}))
+			Expect(status.Observers).Should(BeEmpty())
+			req := brokerAdmin.requests[0].(*kmsg.DescribeQuorumRequest)
+			Expect(req.Topics[0].Topic).Should(Equal(metadataTopic))
+
+			By("no leader is elected")
+			brokerAdmin.respond(kmsg.DescribeQuorum, newQuorumResponse(-1, []int32{1, 2}, nil))
+			_, err = newManager().describeQuorum(ctx)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("member join and leave", func() {
+		alterRequests := func() []*kmsg.AlterPartitionAssignmentsRequest {
+			var requests []*kmsg.AlterPartitionAssignmentsRequest
+			for _, req := range brokerAdmin.requested(kmsg.AlterPartitionAssignments) {
+				requests = append(requests, req.(*kmsg.AlterPartitionAssignmentsRequest))
+			}
+			return requests
+		}
+
+		BeforeEach(func() {
+			brokerAdmin.handlers[kmsg.Metadata] = func(req kmsg.Request) (kmsg.Response, error) {
+				if topics := req.(*kmsg.MetadataRequest).Topics; topics != nil {
+					return newMetadataResponse([]int32{0, 1}, nil), nil
+				}
+				return newMetadataResponse([]int32{0, 1}, map[string][][]int32{"foo": {{0}, {0}}}), nil
+			}
+			brokerAdmin.respond(kmsg.AlterPartitionAssignments, kmsg.NewPtrAlterPartitionAssignmentsResponse())
+			brokerAdmin.respond(kmsg.ListPartitionReassignments, kmsg.NewPtrListPartitionReassignmentsResponse())
+		})
+
+		reassigning := func() *kmsg.ListPartitionReassignmentsResponse {
+			partition := kmsg.NewListPartitionReassignmentsResponseTopicPartition()
+			partition.Replicas = []int32{0, 1}
+			partition.AddingReplicas = []int32{1}
+			partition.RemovingReplicas = []int32{0}
+			topic := kmsg.NewListPartitionReassignmentsResponseTopic()
+			topic.Topic = "foo"
+			topic.Partitions = append(topic.Partitions, partition)
+			resp := kmsg.NewPtrListPartitionReassignmentsResponse()
+			resp.Topics = append(resp.Topics, topic)
+			return resp
+		}
+
+		It("reassigns the partitions to the joined broker", func() {
+			Expect(newManager().JoinCurrentMemberToCluster(ctx, nil)).Should(Succeed())
+			requests := alterRequests()
+			Expect(requests).Should(HaveLen(1))
+			Expect(requests[0].Topics).Should(HaveLen(1))
+			Expect(requests[0].Topics[0].Topic).Should(Equal("foo"))
+			// one of the partitions is moved to the joined broker
+			Expect(requests[0].Topics[0].Partitions).Should(HaveLen(1))
+			Expect(requests[0].Topics[0].Partitions[0].Replicas).Should(Equal([]int32{1}))
+		})
+
+		It("skips the reassignment if the broker hosts replicas already", func() {
+			viper.Set(envNodeID, "0")
+			Expect(newManager().JoinCurrentMemberToCluster(ctx, nil)).Should(Succeed())
+			Expect(alterRequests()).Should(BeEmpty())
+		})
+
+		It("waits for the ongoing reassignments", func() {
+			brokerAdmin.respond(kmsg.ListPartitionReassignments, reassigning())
+			Expect(newManager().JoinCurrentMemberToCluster(ctx, nil)).ShouldNot(Succeed())
+			Expect(newManager().LeaveMemberFromCluster(ctx, nil, "kafka-0")).ShouldNot(Succeed())
+			Expect(alterRequests()).Should(BeEmpty())
+		})
+
+		It("moves the partitions out of the leaving broker", func() {
+			// the leave is retried until the leaving broker hosts no replica
+			Expect(newManager().LeaveMemberFromCluster(ctx, nil, "kafka-0")).ShouldNot(Succeed())
+			requests := alterRequests()
+			Expect(requests).Should(HaveLen(1))
+			Expect(requests[0].Topics[0].Partitions).Should(HaveLen(2))
+			for _, partition := range requests[0].Topics[0].Partitions {
+				Expect(partition.Replicas).Should(Equal([]int32{1}))
+			}
+
+			By("nothing to do if the broker hosts no replica")
+			brokerAdmin.requests = nil
+			Expect(newManager().LeaveMemberFromCluster(ctx, nil, "kafka-2")).Should(Succeed())
+			Expect(alterRequests()).Should(BeEmpty())
+		})
+
+		It("moves only the replicas the join needs", func() {
+			topics := newMetadataResponse(nil, map[string][][]int32{"foo": {{0, 1}, {1, 2}, {2, 0}, {0, 1}}}).Topics[:1]
+			// 8 replicas on 4 brokers, 2 of them are moved to the joining broker
+			reqTopics := proposeJoinAssignment(topics, []int{0, 1, 2, 3}, 3)
+			Expect(reqTopics).Should(HaveLen(1))
+			Expect(reqTopics[0].Partitions).Should(HaveLen(2))
+			loads := countReplicas(topics, []int{0, 1, 2, 3})
+			for _, partition := range reqTopics[0].Partitions {
+				Expect(partition.Replicas).Should(HaveLen(2))
+				Expect(partition.Replicas).Should(ContainElement(int32(3)))
+				for _, id := range topics[0].Partitions[partition.Partition].Replicas {
+					if !slices.Contains(partition.Replicas, id) {
+						loads[int(id)]--
+					}
+				}
+			}
+			Expect(loads).Should(Equal(map[int]int{0: 2, 1: 2, 2: 2, 3: 0}))
+		})
+
+		It("keeps the replication factor of the topics", func() {
+			topics := newMetadataResponse(nil, map[string][][]int32{"foo": {{0, 1}}}).Topics[:1]
+			_, err := proposeLeaveAssignment(topics, []int{1}, 0)
+			Expect(err).Should(HaveOccurred())
+
+			reqTopics, err := proposeLeaveAssignment(topics, []int{1, 2}, 0)
+			Expect(err).Should(Succeed())
+			Expect(reqTopics[0].Partitions[0].Replicas).Should(Equal([]int32{2, 1}))
+		})
+	})
+
+	Context("user management", func() {
+		newSCRAMResult := func(user string, errorCode int16) kmsg.DescribeUserSCRAMCredentialsResponseResult {
+			result := kmsg.NewDescribeUserSCRAMCredentialsResponseResult()
+			result.User = user
+			result.ErrorCode = errorCode
+			return result
+		}
+
+		It("manages the SCRAM credentials", func() {
+			mgr := newManager()
+			users := kmsg.NewPtrDescribeUserSCRAMCredentialsResponse()
+			users.Results = append(users.Results, newSCRAMResult("admin", 0), newSCRAMResult("alice", 0))
+			brokerAdmin.respond(kmsg.DescribeUserSCRAMCredentials, users)
+			userList, err := mgr.ListUsers(ctx)
+			Expect(err).Should(Succeed())
+			Expect(userList).Should(Equal([]models.UserInfo{{UserName: "alice"}}))
+			accounts, err := mgr.ListSystemAccounts(ctx)
+			Expect(err).Should(Succeed())
+			Expect(accounts).Should(Equal([]models.UserInfo{{UserName: "admin"}}))
+
+			brokerAdmin.respond(kmsg.AlterUserSCRAMCredentials, kmsg.NewPtrAlterUserSCRAMCredentialsResponse())
+			Expect(mgr.CreateUser(ctx, "bob", "a,b[secret]")).Should(Succeed())
+			req := brokerAdmin.requested(kmsg.AlterUserSCRAMCredentials)[0].(*kmsg.AlterUserSCRAMCredentialsRequest)
+			Expect(req.Upsertions).Should(HaveLen(2))
+			upsertion := req.Upsertions[0]
+			Expect(upsertion.Name).Should(Equal("bob"))
+			Expect(upsertion.Mechanism).Should(Equal(int8(1)))
+			Expect(upsertion.SaltedPassword).Should(Equal(pbkdf2.Key([]byte("a,b[secret]"), upsertion.Salt, 8192, sha256.Size, sha256.New)))
+			// the password is salted by lorry, and never sent to Kafka
+			Expect(bytes.Contains(req.AppendTo(nil), []byte("secret"))).Should(BeFalse())
+		})
+
+		It("maps the roles to ACLs", func() {
+			mgr := newManager()
+			brokerAdmin.respond(kmsg.CreateACLs, kmsg.NewPtrCreateACLsResponse())
+			Expect(mgr.GrantUserRole(ctx, "alice", "readonly")).Should(Succeed())
+			creations := brokerAdmin.requested(kmsg.CreateACLs)[0].(*kmsg.CreateACLsRequest).Creations
+			Expect(creations).Should(HaveLen(3))
+			for _, creation := range creations {
+				Expect(creation.Principal).Should(Equal("User:alice"))
+				Expect(creation.ResourcePatternType).Should(Equal(kmsg.ACLResourcePatternTypeLiteral))
+				Expect(creation.PermissionType).Should(Equal(kmsg.ACLPermissionTypeAllow))
+			}
+			Expect(creations[2].ResourceType).Should(Equal(kmsg.ACLResourceTypeGroup))
+			Expect(mgr.GrantUserRole(ctx, "alice", "unknown")).Should(Equal(models.ErrInvalidRoleName))
+
+			users := kmsg.NewPtrDescribeUserSCRAMCredentialsResponse()
+			users.Results = append(users.Results, newSCRAMResult("alice", 0))
+			brokerAdmin.respond(kmsg.DescribeUserSCRAMCredentials, users)
+			acls := kmsg.NewPtrDescribeACLsResponse()
+			for _, a := range roleACLs("readonly") {
+				resource := kmsg.NewDescribeACLsResponseResource()
+				resource.ResourceType = a.resourceType
+				resource.ResourceName = a.name
+				entry := kmsg.NewDescribeACLsResponseResourceACL()
+				entry.Operation = a.operation
+				resource.ACLs = append(resource.ACLs, entry)
+				acls.Resources = append(acls.Resources, resource)
+			}
+			brokerAdmin.respond(kmsg.DescribeACLs, acls)
+			user, err := mgr.DescribeUser(ctx, "alice")
+			Expect(err).Should(Succeed())
+			Expect(user.RoleName).Should(Equal(string(models.ReadOnlyRole)))
+
+			users = kmsg.NewPtrDescribeUserSCRAMCredentialsResponse()
+			users.Results = append(users.Results, newSCRAMResult("bob", errCodeResourceNotFound))
+			brokerAdmin.respond(kmsg.DescribeUserSCRAMCredentials, users)
+			user, err = mgr.DescribeUser(ctx, "bob")
+			Expect(err).Should(Succeed())
+			Expect(user).Should(BeNil())
+
+			By("deleting the user removes the ACLs and the credentials")
+			brokerAdmin.respond(kmsg.DeleteACLs, kmsg.NewPtrDeleteACLsResponse())
+			deleted := kmsg.NewPtrAlterUserSCRAMCredentialsResponse()
+			result := kmsg.NewAlterUserSCRAMCredentialsResponseResult()
+			result.User = "alice"
+			result.ErrorCode = errCodeResourceNotFound
+			deleted.Results = append(deleted.Results, result)
+			brokerAdmin.respond(kmsg.AlterUserSCRAMCredentials, deleted)
+			Expect(mgr.DeleteUser(ctx, "alice")).Should(Succeed())
+			Expect(brokerAdmin.requested(kmsg.DeleteACLs)[0].(*kmsg.DeleteACLsRequest).Filters).Should(HaveLen(3))
+			Expect(brokerAdmin.requested(kmsg.AlterUserSCRAMCredentials)[0].(*kmsg.AlterUserSCRAMCredentialsRequest).Deletions).Should(HaveLen(2))
+		})
+
+		It("detects the role from the ACLs", func() {
+			Expect(acls2Role(nil)).Should(Equal(models.NoPrivileges))
+			Expect(acls2Role(roleACLs("superuser"))).Should(Equal(models.SuperUserRole))
+			Expect(acls2Role(roleACLs("readwrite"))).Should(Equal(models.ReadWriteRole))
+			Expect(acls2Role([]acl{{resourceType: kmsg.ACLResourceTypeTopic, name: "foo", operation: kmsg.ACLOperationRead}})).Should(Equal(models.CustomizedRole))
+		})
+	})
+})
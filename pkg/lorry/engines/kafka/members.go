@@ -0,0 +1,330 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"golang.org/x/exp/slices"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+)
+
+const reassignmentTimeoutMillis = 30000
+
+func (mgr *Manager) getMetadata(ctx context.Context, topics []kmsg.MetadataRequestTopic) (*kmsg.MetadataResponse, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	req.Topics = topics
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*kmsg.MetadataResponse), nil
+}
+
+// listBrokers returns the ids of the brokers registered in the cluster.
+func (mgr *Manager) listBrokers(ctx context.Context) ([]int, error) {
+	// an empty topic list requests no topic
+	metadata, err := mgr.getMetadata(ctx, []kmsg.MetadataRequestTopic{})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(metadata.Brokers))
+	for _, broker := range metadata.Brokers {
+		ids = append(ids, int(broker.NodeID))
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// listTopics returns the topics with the partition assignments, the internal topics are excluded.
+func (mgr *Manager) listTopics(ctx context.Context) ([]kmsg.MetadataResponseTopic, error) {
+	// a nil topic list requests all topics
+	metadata, err := mgr.getMetadata(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var topics []kmsg.MetadataResponseTopic
+	for _, topic := range metadata.Topics {
+		if topic.IsInternal || topic.Topic == nil {
+			continue
+		}
+		if err = newKafkaError(topic.ErrorCode, nil); err != nil {
+			return nil, errors.Wrapf(err, "get metadata of topic %s failed", *topic.Topic)
+		}
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+// JoinCurrentMemberToCluster moves a fair share of the partition replicas to the current broker,
+// the broker registers itself to the controllers once it starts.
+func (mgr *Manager) JoinCurrentMemberToCluster(ctx context.Context, _ *dcs.Cluster) error {
+	if !mgr.isBroker() {
+		return nil
+	}
+	brokerIDs, err := mgr.listBrokers(ctx)
+	if err != nil {
+		return err
+	}
+	if !containsID(brokerIDs, mgr.nodeID) {
+		return errors.Errorf("broker %d is not registered", mgr.nodeID)
+	}
+	if err = mgr.checkNoReassignment(ctx); err != nil {
+		return err
+	}
+	topics, err := mgr.listTopics(ctx)
+	if err != nil || len(topics) == 0 {
+		return err
+	}
+	// the partitions are reassigned already if the broker hosts any replica
+	if hostsReplicas(topics, mgr.nodeID) {
+		mgr.Logger.Info("partitions are reassigned already", "broker", mgr.nodeID)
+		return nil
+	}
+	return mgr.alterPartitionAssignments(ctx, proposeJoinAssignment(topics, brokerIDs, mgr.nodeID))
+}
+
+// LeaveMemberFromCluster moves the partitions out of the leaving broker, it fails until no partition
+// is being reassigned and the leaving broker hosts no replica, so the caller retries until then.
+func (mgr *Manager) LeaveMemberFromCluster(ctx context.Context, _ *dcs.Cluster, memberName string) error {
+	if !mgr.isBroker() {
+		return nil
+	}
+	leavingID, err := mgr.getNodeID(memberName)
+	if err != nil {
+		return err
+	}
+	brokerIDs, err := mgr.listBrokers(ctx)
+	if err != nil {
+		return err
+	}
+	var remainingIDs []int
+	for _, id := range brokerIDs {
+		if id != leavingID {
+			remainingIDs = append(remainingIDs, id)
+		}
+	}
+	if err = mgr.checkNoReassignment(ctx); err != nil {
+		return err
+	}
+	topics, err := mgr.listTopics(ctx)
+	if err != nil {
+		return err
+	}
+	if !hostsReplicas(topics, leavingID) {
+		mgr.Logger.Info("no replica is hosted by the leaving broker", "broker", leavingID)
+		return nil
+	}
+	if len(remainingIDs) == 0 {
+		return errors.Errorf("no broker remains after %s leaves", memberName)
+	}
+	reqTopics, err := proposeLeaveAssignment(topics, remainingIDs, leavingID)
+	if err != nil {
+		return err
+	}
+	if err = mgr.alterPartitionAssignments(ctx, reqTopics); err != nil {
+		return err
+	}
+	return errors.Errorf("the partitions are being moved out of broker %d", leavingID)
+}
+
+// checkNoReassignment fails if any partition is being reassigned.
+func (mgr *Manager) checkNoReassignment(ctx context.Context) error {
+	req := kmsg.NewPtrListPartitionReassignmentsRequest()
+	req.TimeoutMillis = reassignmentTimeoutMillis
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return err
+	}
+	listResp := resp.(*kmsg.ListPartitionReassignmentsResponse)
+	if err = newKafkaError(listResp.ErrorCode, listResp.ErrorMessage); err != nil {
+		return errors.Wrap(err, "list partition reassignments failed")
+	}
+	reassigning := 0
+	for _, topic := range listResp.Topics {
+		reassigning += len(topic.Partitions)
+	}
+	if reassigning > 0 {
+		return errors.Errorf("%d partitions are being reassigned", reassigning)
+	}
+	return nil
+}
+
+func (mgr *Manager) alterPartitionAssignments(ctx context.Context, topics []kmsg.AlterPartitionAssignmentsRequestTopic) error {
+	if len(topics) == 0 {
+		return nil
+	}
+	req := kmsg.NewPtrAlterPartitionAssignmentsRequest()
+	req.TimeoutMillis = reassignmentTimeoutMillis
+	req.Topics = topics
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return err
+	}
+	alterResp := resp.(*kmsg.AlterPartitionAssignmentsResponse)
+	if err = newKafkaError(alterResp.ErrorCode, alterResp.ErrorMessage); err != nil {
+		return errors.Wrap(err, "reassign partitions failed")
+	}
+	for _, topic := range alterResp.Topics {
+		for _, partition := range topic.Partitions {
+			if err = newKafkaError(partition.ErrorCode, partition.ErrorMessage); err != nil {
+				return errors.Wrapf(err, "reassign partition %s-%d failed", topic.Topic, partition.Partition)
+			}
+		}
+	}
+	mgr.Logger.Info("partitions reassignment started", "topics", len(topics))
+	return nil
+}
+
+// proposeJoinAssignment moves the replicas from the most loaded brokers to the joining broker,
+// until the joining broker hosts its fair share of the replicas. The other replicas stay where they are.
+func proposeJoinAssignment(topics []kmsg.MetadataResponseTopic, brokerIDs []int, joiningID int) []kmsg.AlterPartitionAssignmentsRequestTopic {
+	loads := countReplicas(topics, brokerIDs)
+	total := 0
+	for _, load := range loads {
+		total += load
+	}
+	fairShare := total / len(brokerIDs)
+
+	var reqTopics []kmsg.AlterPartitionAssignmentsRequestTopic
+	for _, topic := range sortedTopics(topics) {
+		reqTopic := kmsg.NewAlterPartitionAssignmentsRequestTopic()
+		reqTopic.Topic = *topic.Topic
+		for _, partition := range topic.Partitions {
+			if loads[joiningID] >= fairShare {
+				break
+			}
+			// move the replica of the most loaded broker, if it's still loaded more than the joining broker after the move
+			from := -1
+			for i, id := range partition.Replicas {
+				if from < 0 || loads[int(id)] > loads[int(partition.Replicas[from])] {
+					from = i
+				}
+			}
+			if from < 0 || loads[int(partition.Replicas[from])] <= loads[joiningID]+1 {
+				continue
+			}
+			replicas := slices.Clone(partition.Replicas)
+			loads[int(replicas[from])]--
+			loads[joiningID]++
+			replicas[from] = int32(joiningID)
+			reqTopic.Partitions = append(reqTopic.Partitions, newReqPartition(partition.Partition, replicas))
+		}
+		if len(reqTopic.Partitions) > 0 {
+			reqTopics = append(reqTopics, reqTopic)
+		}
+	}
+	return reqTopics
+}
+
+// proposeLeaveAssignment replaces the replicas of the leaving broker with the least loaded remaining brokers,
+// the replication factor of the partitions is kept.
+func proposeLeaveAssignment(topics []kmsg.MetadataResponseTopic, remainingIDs []int, leavingID int) ([]kmsg.AlterPartitionAssignmentsRequestTopic, error) {
+	loads := countReplicas(topics, remainingIDs)
+
+	var reqTopics []kmsg.AlterPartitionAssignmentsRequestTopic
+	for _, topic := range sortedTopics(topics) {
+		reqTopic := kmsg.NewAlterPartitionAssignmentsRequestTopic()
+		reqTopic.Topic = *topic.Topic
+		for _, partition := range topic.Partitions {
+			from := slices.Index(partition.Replicas, int32(leavingID))
+			if from < 0 {
+				continue
+			}
+			to := -1
+			for _, id := range remainingIDs {
+				if slices.Contains(partition.Replicas, int32(id)) {
+					continue
+				}
+				if to < 0 || loads[id] < loads[to] {
+					to = id
+				}
+			}
+			if to < 0 {
+				return nil, errors.Errorf("the replication factor %d of topic %s is larger than the number of the remaining brokers %d",
+					len(partition.Replicas), reqTopic.Topic, len(remainingIDs))
+			}
+			replicas := slices.Clone(partition.Replicas)
+			replicas[from] = int32(to)
+			loads[to]++
+			reqTopic.Partitions = append(reqTopic.Partitions, newReqPartition(partition.Partition, replicas))
+		}
+		if len(reqTopic.Partitions) > 0 {
+			reqTopics = append(reqTopics, reqTopic)
+		}
+	}
+	return reqTopics, nil
+}
+
+func newReqPartition(partition int32, replicas []int32) kmsg.AlterPartitionAssignmentsRequestTopicPartition {
+	reqPartition := kmsg.NewAlterPartitionAssignmentsRequestTopicPartition()
+	reqPartition.Partition = partition
+	reqPartition.Replicas = replicas
+	return reqPartition
+}
+
+// countReplicas returns the number of the replicas hosted by each of the brokers.
+func countReplicas(topics []kmsg.MetadataResponseTopic, brokerIDs []int) map[int]int {
+	loads := make(map[int]int, len(brokerIDs))
+	for _, id := range brokerIDs {
+		loads[id] = 0
+	}
+	for _, topic := range topics {
+		for _, partition := range topic.Partitions {
+			for _, id := range partition.Replicas {
+				if _, ok := loads[int(id)]; ok {
+					loads[int(id)]++
+				}
+			}
+		}
+	}
+	return loads
+}
+
+// sortedTopics sorts the topics and their partitions to make the proposals stable.
+func sortedTopics(topics []kmsg.MetadataResponseTopic) []kmsg.MetadataResponseTopic {
+	sorted := slices.Clone(topics)
+	sort.Slice(sorted, func(i, j int) bool {
+		return *sorted[i].Topic < *sorted[j].Topic
+	})
+	for i := range sorted {
+		partitions := slices.Clone(sorted[i].Partitions)
+		sort.Slice(partitions, func(m, n int) bool {
+			return partitions[m].Partition < partitions[n].Partition
+		})
+		sorted[i].Partitions = partitions
+	}
+	return sorted
+}
+
+func hostsReplicas(topics []kmsg.MetadataResponseTopic, brokerID int) bool {
+	for _, topic := range topics {
+		for _, partition := range topic.Partitions {
+			if slices.Contains(partition.Replicas, int32(brokerID)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/pkg/errors"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+func init() {
+	viper.AutomaticEnv()
+	viper.SetDefault(constant.KBEnvPodName, "kafka-1")
+	viper.SetDefault(constant.KBEnvClusterCompName, "test-kafka")
+	viper.SetDefault(constant.KBEnvNamespace, "default")
+	ctrl.SetLogger(zap.New())
+}
+
+func TestKafkaDBManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kafka DBManager Suite")
+}
+
+// fakeAdmin fakes the responses of the Kafka admin API and records the requests.
+type fakeAdmin struct {
+	handlers map[kmsg.Key]func(kmsg.Request) (kmsg.Response, error)
+	requests []kmsg.Request
+}
+
+var _ kmsg.Requestor = &fakeAdmin{}
+
+func newFakeAdmin() *fakeAdmin {
+	return &fakeAdmin{handlers: map[kmsg.Key]func(kmsg.Request) (kmsg.Response, error){}}
+}
+
+// respond registers the response of the requests with the key.
+func (a *fakeAdmin) respond(key kmsg.Key, resp kmsg.Response) {
+	a.handlers[key] = func(kmsg.Request) (kmsg.Response, error) {
+		return resp, nil
+	}
+}
+
+func (a *fakeAdmin) Request(_ context.Context, req kmsg.Request) (kmsg.Response, error) {
+	a.requests = append(a.requests, req)
+	handler, ok := a.handlers[kmsg.Key(req.Key())]
+	if !ok {
+		return nil, errors.Errorf("unexpected request: %s", kmsg.NameForKey(req.Key()))
+	}
+	return handler(req)
+}
+
+// requested returns the requests with the key.
+func (a *fakeAdmin) requested(key kmsg.Key) []kmsg.Request {
+	var requests []kmsg.Request
+	for _, req := range a.requests {
+		if req.Key() == key.Int16() {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
@@ -0,0 +1,391 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package kafka
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"sort"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/exp/slices"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	viper "github.com/apecloud/kubeblocks/pkg/viperx"
+)
+
+// The users are SCRAM credentials, and the roles are mapped to the ACLs on all resources.
+
+const (
+	scramSaltSize = 32
+	aclHost       = "*"
+)
+
+// scramMechanism is a SCRAM mechanism, whose id and iterations follow the Kafka defaults.
+type scramMechanism struct {
+	id         int8
+	iterations int32
+	hash       func() hash.Hash
+}
+
+var (
+	scramMechanisms = []scramMechanism{
+		{id: 1, iterations: 8192, hash: sha256.New}, // SCRAM-SHA-256
+		{id: 2, iterations: 4096, hash: sha512.New}, // SCRAM-SHA-512
+	}
+
+	kafkaPreDefinedUsers = []string{
+		"admin",
+		"kbadmin",
+		"kbdataprotection",
+		"kbmonitoring",
+		"kbprobe",
+		"kbreplicator",
+	}
+)
+
+// acl is an ACL allowing the operation on the literal resource.
+type acl struct {
+	resourceType kmsg.ACLResourceType
+	name         string
+	operation    kmsg.ACLOperation
+}
+
+func (a acl) String() string {
+	return strings.ToUpper(fmt.Sprintf("%s:%s:%s", a.resourceType, a.name, a.operation))
+}
+
+func roleACLs(roleName string) []acl {
+	topicACLs := func(operations ...kmsg.ACLOperation) []acl {
+		acls := make([]acl, 0, len(operations))
+		for _, op := range operations {
+			acls = append(acls, acl{resourceType: kmsg.ACLResourceTypeTopic, name: "*", operation: op})
+		}
+		return acls
+	}
+	groupRead := acl{resourceType: kmsg.ACLResourceTypeGroup, name: "*", operation: kmsg.ACLOperationRead}
+
+	switch models.String2RoleType(roleName) {
+	case models.SuperUserRole:
+		return []acl{
+			{resourceType: kmsg.ACLResourceTypeCluster, name: "kafka-cluster", operation: kmsg.ACLOperationAll},
+			{resourceType: kmsg.ACLResourceTypeTopic, name: "*", operation: kmsg.ACLOperationAll},
+			{resourceType: kmsg.ACLResourceTypeGroup, name: "*", operation: kmsg.ACLOperationAll},
+			{resourceType: kmsg.ACLResourceTypeTransactionalId, name: "*", operation: kmsg.ACLOperationAll},
+		}
+	case models.ReadWriteRole:
+		return append(topicACLs(kmsg.ACLOperationRead, kmsg.ACLOperationWrite, kmsg.ACLOperationDescribe, kmsg.ACLOperationCreate), groupRead)
+	case models.ReadOnlyRole:
+		return append(topicACLs(kmsg.ACLOperationRead, kmsg.ACLOperationDescribe), groupRead)
+	}
+	return nil
+}
+
+func acls2Role(acls []acl) models.RoleType {
+	if len(acls) == 0 {
+		return models.NoPrivileges
+	}
+	toKeys := func(acls []acl) []string {
+		keys := make([]string, 0, len(acls))
+		for _, a := range acls {
+			keys = append(keys, a.String())
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	keys := toKeys(acls)
+	for _, role := range []models.RoleType{models.SuperUserRole, models.ReadWriteRole, models.ReadOnlyRole} {
+		if slices.Equal(keys, toKeys(roleACLs(string(role)))) {
+			return role
+		}
+	}
+	return models.CustomizedRole
+}
+
+func principal(userName string) string {
+	return "User:" + userName
+}
+
+// listSCRAMUsers returns the users having SCRAM credentials, all users are listed if no user is specified.
+func (mgr *Manager) listSCRAMUsers(ctx context.Context, userNames ...string) ([]string, error) {
+	req := kmsg.NewPtrDescribeUserSCRAMCredentialsRequest()
+	for _, userName := range userNames {
+		user := kmsg.NewDescribeUserSCRAMCredentialsRequestUser()
+		user.Name = userName
+		req.Users = append(req.Users, user)
+	}
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	describeResp := resp.(*kmsg.DescribeUserSCRAMCredentialsResponse)
+	if err = newKafkaError(describeResp.ErrorCode, describeResp.ErrorMessage); err != nil {
+		return nil, err
+	}
+	var users []string
+	for _, result := range describeResp.Results {
+		err = newKafkaError(result.ErrorCode, result.ErrorMessage)
+		if isKafkaError(err, errCodeResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(users, result.User) {
+			users = append(users, result.User)
+		}
+	}
+	return users, nil
+}
+
+func (mgr *Manager) alterSCRAMCredentials(ctx context.Context, req *kmsg.AlterUserSCRAMCredentialsRequest) error {
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, result := range resp.(*kmsg.AlterUserSCRAMCredentialsResponse).Results {
+		err = newKafkaError(result.ErrorCode, result.ErrorMessage)
+		// the credential to delete doesn't exist
+		if err != nil && (len(req.Deletions) == 0 || !isKafkaError(err, errCodeResourceNotFound)) {
+			return err
+		}
+	}
+	return nil
+}
+
+// newSCRAMUpsertion salts the password at the client side, so the password never leaves lorry.
+func newSCRAMUpsertion(userName, password string, mechanism scramMechanism) (kmsg.AlterUserSCRAMCredentialsRequestUpsertion, error) {
+	upsertion := kmsg.NewAlterUserSCRAMCredentialsRequestUpsertion()
+	salt := make([]byte, scramSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return upsertion, err
+	}
+	upsertion.Name = userName
+	upsertion.Mechanism = mechanism.id
+	upsertion.Iterations = mechanism.iterations
+	upsertion.Salt = salt
+	upsertion.SaltedPassword = pbkdf2.Key([]byte(password), salt, int(mechanism.iterations), mechanism.hash().Size(), mechanism.hash)
+	return upsertion, nil
+}
+
+func (mgr *Manager) listACLs(ctx context.Context, userName string) ([]acl, error) {
+	req := kmsg.NewPtrDescribeACLsRequest()
+	req.ResourceType = kmsg.ACLResourceTypeAny
+	req.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral
+	req.Principal = kmsg.StringPtr(principal(userName))
+	req.Operation = kmsg.ACLOperationAny
+	req.PermissionType = kmsg.ACLPermissionTypeAllow
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	describeResp := resp.(*kmsg.DescribeACLsResponse)
+	if err = newKafkaError(describeResp.ErrorCode, describeResp.ErrorMessage); err != nil {
+		return nil, err
+	}
+	var acls []acl
+	for _, resource := range describeResp.Resources {
+		for _, entry := range resource.ACLs {
+			acls = append(acls, acl{resourceType: resource.ResourceType, name: resource.ResourceName, operation: entry.Operation})
+		}
+	}
+	return acls, nil
+}
+
+func (mgr *Manager) createACLs(ctx context.Context, userName string, acls []acl) error {
+	req := kmsg.NewPtrCreateACLsRequest()
+	for _, a := range acls {
+		creation := kmsg.NewCreateACLsRequestCreation()
+		creation.ResourceType = a.resourceType
+		creation.ResourceName = a.name
+		creation.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral
+		creation.Principal = principal(userName)
+		creation.Host = aclHost
+		creation.Operation = a.operation
+		creation.PermissionType = kmsg.ACLPermissionTypeAllow
+		req.Creations = append(req.Creations, creation)
+	}
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, result := range resp.(*kmsg.CreateACLsResponse).Results {
+		if err = newKafkaError(result.ErrorCode, result.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mgr *Manager) deleteACLs(ctx context.Context, userName string, acls []acl) error {
+	if len(acls) == 0 {
+		return nil
+	}
+	req := kmsg.NewPtrDeleteACLsRequest()
+	for _, a := range acls {
+		filter := kmsg.NewDeleteACLsRequestFilter()
+		filter.ResourceType = a.resourceType
+		filter.ResourceName = kmsg.StringPtr(a.name)
+		filter.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral
+		filter.Principal = kmsg.StringPtr(principal(userName))
+		filter.Host = kmsg.StringPtr(aclHost)
+		filter.Operation = a.operation
+		filter.PermissionType = kmsg.ACLPermissionTypeAllow
+		req.Filters = append(req.Filters, filter)
+	}
+	resp, err := mgr.brokerAdmin.Request(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, result := range resp.(*kmsg.DeleteACLsResponse).Results {
+		if err = newKafkaError(result.ErrorCode, result.ErrorMessage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSystemAccount(userName string) bool {
+	return slices.Contains(kafkaPreDefinedUsers, userName) || userName == viper.GetString(constant.KBEnvServiceUser)
+}
+
+func (mgr *Manager) ListUsers(ctx context.Context) ([]models.UserInfo, error) {
+	userNames, err := mgr.listSCRAMUsers(ctx)
+	if err != nil {
+		mgr.Logger.Error(err, "list users failed")
+		return nil, err
+	}
+	users := make([]models.UserInfo, 0)
+	for _, userName := range userNames {
+		if isSystemAccount(userName) {
+			continue
+		}
+		users = append(users, models.UserInfo{UserName: userName})
+	}
+	return users, nil
+}
+
+func (mgr *Manager) ListSystemAccounts(ctx context.Context) ([]models.UserInfo, error) {
+	userNames, err := mgr.listSCRAMUsers(ctx)
+	if err != nil {
+		mgr.Logger.Error(err, "list users failed")
+		return nil, err
+	}
+	users := make([]models.UserInfo, 0)
+	for _, userName := range userNames {
+		if !isSystemAccount(userName) {
+			continue
+		}
+		users = append(users, models.UserInfo{UserName: userName})
+	}
+	return users, nil
+}
+
+func (mgr *Manager) DescribeUser(ctx context.Context, userName string) (*models.UserInfo, error) {
+	userNames, err := mgr.listSCRAMUsers(ctx, userName)
+	if err != nil {
+		mgr.Logger.Error(err, "describe user failed", "user", userName)
+		return nil, err
+	}
+	if !slices.Contains(userNames, userName) {
+		return nil, nil
+	}
+
+	acls, err := mgr.listACLs(ctx, userName)
+	if err != nil {
+		mgr.Logger.Error(err, "list acls failed", "user", userName)
+		return nil, err
+	}
+	return &models.UserInfo{
+		UserName: userName,
+		RoleName: string(acls2Role(acls)),
+	}, nil
+}
+
+func (mgr *Manager) CreateUser(ctx context.Context, userName, password string) error {
+	req := kmsg.NewPtrAlterUserSCRAMCredentialsRequest()
+	for _, mechanism := range scramMechanisms {
+		upsertion, err := newSCRAMUpsertion(userName, password, mechanism)
+		if err != nil {
+			return err
+		}
+		req.Upsertions = append(req.Upsertions, upsertion)
+	}
+	if err := mgr.alterSCRAMCredentials(ctx, req); err != nil {
+		mgr.Logger.Info("create user failed", "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (mgr *Manager) DeleteUser(ctx context.Context, userName string) error {
+	acls, err := mgr.listACLs(ctx, userName)
+	if err != nil {
+		mgr.Logger.Error(err, "list acls failed", "user", userName)
+		return err
+	}
+	if err = mgr.deleteACLs(ctx, userName, acls); err != nil {
+		mgr.Logger.Error(err, "remove acls failed", "user", userName)
+		return err
+	}
+	req := kmsg.NewPtrAlterUserSCRAMCredentialsRequest()
+	for _, mechanism := range scramMechanisms {
+		deletion := kmsg.NewAlterUserSCRAMCredentialsRequestDeletion()
+		deletion.Name = userName
+		deletion.Mechanism = mechanism.id
+		req.Deletions = append(req.Deletions, deletion)
+	}
+	if err = mgr.alterSCRAMCredentials(ctx, req); err != nil {
+		mgr.Logger.Error(err, "delete user failed", "user", userName)
+		return err
+	}
+	return nil
+}
+
+func (mgr *Manager) GrantUserRole(ctx context.Context, userName, roleName string) error {
+	acls := roleACLs(roleName)
+	if len(acls) == 0 {
+		return models.ErrInvalidRoleName
+	}
+	if err := mgr.createACLs(ctx, userName, acls); err != nil {
+		mgr.Logger.Error(err, "grant role failed", "user", userName, "role", roleName)
+		return err
+	}
+	return nil
+}
+
+func (mgr *Manager) RevokeUserRole(ctx context.Context, userName, roleName string) error {
+	acls := roleACLs(roleName)
+	if len(acls) == 0 {
+		return models.ErrInvalidRoleName
+	}
+	if err := mgr.deleteACLs(ctx, userName, acls); err != nil {
+		mgr.Logger.Error(err, "revoke role failed", "user", userName, "role", roleName)
+		return err
+	}
+	return nil
+}
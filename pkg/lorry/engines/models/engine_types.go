@@ -38,5 +38,8 @@ const (
 	Oceanbase          EngineType = "oceanbase"
 	Oracle             EngineType = "oracle"
 	OpenGauss          EngineType = "opengauss"
+	Kafka              EngineType = "kafka"
+	Elasticsearch      EngineType = "elasticsearch"
+	OpenSearch         EngineType = "opensearch"
 	Custom             EngineType = "custom"
 )
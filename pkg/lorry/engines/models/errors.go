@@ -29,6 +29,7 @@ const (
 	errMsgInvalidRoleName = "invalid rolename, should be one of [superuser, readwrite, readonly]"
 	errMsgNoSuchUser      = "no such user"
 	errMsgNotImplemented  = "not implemented"
+	errMsgNoDualPassword  = "retaining the current password is not supported, unset the grace period of the password rotation"
 )
 
 var (
@@ -39,4 +40,5 @@ var (
 	ErrInvalidRoleName = fmt.Errorf(errMsgInvalidRoleName)
 	ErrNoSuchUser      = fmt.Errorf(errMsgNoSuchUser)
 	ErrNotImplemented  = fmt.Errorf(errMsgNotImplemented)
+	ErrNoDualPassword  = fmt.Errorf(errMsgNoDualPassword)
 )
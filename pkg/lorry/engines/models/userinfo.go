@@ -30,6 +30,10 @@ type UserInfo struct {
 	Expired  string        `json:"expired,omitempty"`
 	ExpireAt time.Duration `json:"expireAt,omitempty"`
 	RoleName string        `json:"roleName,omitempty"`
+
+	// RetainCurrentPassword keeps the current password valid as a secondary one when the password is updated,
+	// for the engines supporting dual passwords.
+	RetainCurrentPassword bool `json:"retainCurrentPassword,omitempty"`
 }
 
 func (user *UserInfo) UserNameValidator() error {
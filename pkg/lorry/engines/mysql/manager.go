@@ -327,6 +327,15 @@ func (mgr *Manager) GetSecondsBehindMaster(ctx context.Context) (int, error) {
 	return strconv.Atoi(secondsBehindMaster)
 }
 
+// GetLag returns the seconds that the current member lags behind its replication source.
+func (mgr *Manager) GetLag(ctx context.Context, _ *dcs.Cluster) (int64, error) {
+	secondsBehindMaster, err := mgr.GetSecondsBehindMaster(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(secondsBehindMaster), nil
+}
+
 func (mgr *Manager) WriteCheck(ctx context.Context, db *sql.DB) error {
 	writeSQL := fmt.Sprintf(`BEGIN;
 CREATE DATABASE IF NOT EXISTS kubeblocks;
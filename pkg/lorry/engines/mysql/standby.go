@@ -0,0 +1,62 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+var _ engines.StandbyManager = &Manager{}
+
+// FollowStandbySource makes the leader of a standby cluster replicate from the primary of the source cluster.
+// The replication account is the same as the local one, since the standby cluster is restored from the backup
+// of the source cluster.
+func (mgr *Manager) FollowStandbySource(ctx context.Context, cluster *dcs.Cluster, source *engines.StandbySource) error {
+	if mgr.globalState["super_read_only"] != "1" {
+		if err := mgr.Demote(ctx); err != nil {
+			return err
+		}
+	}
+
+	if !mgr.isRecoveryConfOutdated(source.Host) {
+		return nil
+	}
+
+	stopSlave := `stop slave;`
+	changeMaster := fmt.Sprintf(`change master to master_host='%s',master_user='%s',master_password='%s',master_port=%s,master_auto_position=1;`,
+		source.Host, config.Username, config.Password, source.Port)
+	mgr.Logger.Info("follow standby source", "host", source.Host, "port", source.Port)
+	startSlave := `start slave;`
+
+	_, err := mgr.DB.Exec(stopSlave + changeMaster + startSlave)
+	if err != nil {
+		mgr.Logger.Info("follow standby source failed", "error", err.Error())
+		return err
+	}
+
+	// fresh db state
+	mgr.GetDBState(ctx, cluster)
+	mgr.Logger.Info("successfully follow standby source", "host", source.Host)
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+func TestManager_FollowStandbySource(t *testing.T) {
+	ctx := context.TODO()
+	manager, mock, _ := mockDatabase(t)
+	_, _ = NewConfig(fakeProperties)
+	cluster := &dcs.Cluster{}
+	source := &engines.StandbySource{Host: "primary-mysql.default.svc", Port: "3306"}
+
+	t.Run("still follow the standby source", func(t *testing.T) {
+		manager.globalState = map[string]string{"super_read_only": "1"}
+		manager.slaveStatus = RowMap{
+			"Master_Host":       CellData{String: source.Host},
+			"Slave_IO_Running":  CellData{String: "Yes"},
+			"Slave_SQL_Running": CellData{String: "Yes"},
+		}
+
+		err := manager.FollowStandbySource(ctx, cluster, source)
+		assert.Nil(t, err)
+	})
+
+	manager.globalState = map[string]string{"super_read_only": "0"}
+	manager.slaveStatus = nil
+	t.Run("set read only failed", func(t *testing.T) {
+		mock.ExpectExec("set global read_only=on").
+			WillReturnError(fmt.Errorf("some error"))
+
+		err := manager.FollowStandbySource(ctx, cluster, source)
+		assert.NotNil(t, err)
+		assert.ErrorContains(t, err, "some error")
+	})
+
+	t.Run("follow the standby source", func(t *testing.T) {
+		mock.ExpectExec("set global read_only=on").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("stop slave;change master to master_host='primary-mysql.default.svc'").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := manager.FollowStandbySource(ctx, cluster, source)
+		assert.Nil(t, err)
+	})
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %v", err)
+	}
+}
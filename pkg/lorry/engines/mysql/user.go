@@ -21,9 +21,12 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"golang.org/x/exp/slices"
 
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
@@ -47,6 +50,9 @@ const (
 	grantSQL              = "GRANT %s TO '%s'@'%%';"
 	revokeSQL             = "REVOKE %s FROM '%s'@'%%';"
 	listSystemAccountsSQL = "SELECT user AS userName FROM mysql.user WHERE host = '%' and user like 'kb%';"
+	updatePasswordSQL     = "ALTER USER '%s'@'%%' IDENTIFIED BY '%s'%s;"
+	retainPasswordClause  = " RETAIN CURRENT PASSWORD"
+	discardPasswordSQL    = "ALTER USER '%s'@'%%' DISCARD OLD PASSWORD;"
 )
 
 func (mgr *Manager) ListUsers(ctx context.Context) ([]models.UserInfo, error) {
@@ -166,6 +172,65 @@ func (mgr *Manager) RevokeUserRole(ctx context.Context, userName, roleName strin
 	return nil
 }
 
+// UpdateUserPassword updates the password of the user, the current password is kept as a secondary one
+// if retainCurrent is true, which requires the dual password support of MySQL 8.0.14 or later.
+func (mgr *Manager) UpdateUserPassword(ctx context.Context, userName, password string, retainCurrent bool) error {
+	retainClause := ""
+	if retainCurrent {
+		// the retried request is skipped if the password has been updated, retaining the current password
+		// again would replace the original password with the new one
+		if mgr.canLogin(ctx, userName, password) {
+			mgr.Logger.Info("the password has been updated", "user", userName)
+			return nil
+		}
+		retainClause = retainPasswordClause
+	}
+	sql := fmt.Sprintf(updatePasswordSQL, userName, password, retainClause)
+	_, err := mgr.Exec(ctx, sql)
+	if err != nil {
+		mgr.Logger.Info("update user password failed", "user", userName, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DiscardOldUserPassword discards the secondary password retained by UpdateUserPassword.
+func (mgr *Manager) DiscardOldUserPassword(ctx context.Context, userName string) error {
+	sql := fmt.Sprintf(discardPasswordSQL, userName)
+	_, err := mgr.Exec(ctx, sql)
+	if err != nil {
+		mgr.Logger.Error(err, "execute sql failed", "sql", sql)
+		return err
+	}
+
+	return nil
+}
+
+// canLogin checks whether the user can log in with the password, either the primary or the secondary one.
+func (mgr *Manager) canLogin(ctx context.Context, userName, password string) bool {
+	if config == nil {
+		return false
+	}
+	mysqlConfig, err := mysql.ParseDSN(config.URL)
+	if err != nil {
+		return false
+	}
+	mysqlConfig.User = userName
+	mysqlConfig.Passwd = password
+	mysqlConfig.Timeout = time.Second * 5
+	if config.port != "" {
+		mysqlConfig.Addr = "127.0.0.1:" + config.port
+	}
+	// the connection isn't cached by GetDBConnection, as it's only used once
+	db, err := sql.Open(adminDatabase, mysqlConfig.FormatDSN())
+	if err != nil {
+		return false
+	}
+	defer db.Close()
+	return db.PingContext(ctx) == nil
+}
+
 func role2Priv(roleName string) (string, error) {
 	roleType := models.String2RoleType(roleName)
 	switch roleType {
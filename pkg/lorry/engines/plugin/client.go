@@ -0,0 +1,187 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package plugin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	pluginproto "github.com/apecloud/kubeblocks/pkg/lorry/engines/plugin/proto"
+)
+
+const (
+	// ServiceName is the fully qualified name of the engine service, defined in proto/engine.proto.
+	ServiceName = "kubeblocks.lorry.plugin.v1.EngineService"
+	// ProtocolVersion is the version of the contract, the plugin must report the same one.
+	ProtocolVersion = "v1"
+)
+
+// client calls the engine service with the stubs generated from proto/engine.proto.
+type client struct {
+	conn    *grpc.ClientConn
+	engine  pluginproto.EngineServiceClient
+	timeout time.Duration
+	cancel  context.CancelFunc
+
+	mu         sync.Mutex
+	pluginName string
+}
+
+func newClient(endpoint string, timeout time.Duration) (*client, error) {
+	// the dial is non-blocking, the plugin may start after lorry.
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &client{
+		conn:    conn,
+		engine:  pluginproto.NewEngineServiceClient(conn),
+		timeout: timeout,
+		cancel:  cancel,
+	}
+	go c.watchConnection(ctx)
+	return c, nil
+}
+
+func (c *client) close() error {
+	c.cancel()
+	return c.conn.Close()
+}
+
+// watchConnection resets the handshake once the connection to the plugin is lost, as the plugin may be
+// restarted with another version, which is checked again before the next call.
+func (c *client) watchConnection(ctx context.Context) {
+	state := c.conn.GetState()
+	for c.conn.WaitForStateChange(ctx, state) {
+		if state == connectivity.Ready {
+			c.mu.Lock()
+			c.pluginName = ""
+			c.mu.Unlock()
+		}
+		state = c.conn.GetState()
+	}
+}
+
+// call invokes the method of the engine service after the plugin info is checked.
+func call[Req, Resp any](ctx context.Context, c *client, method func(context.Context, Req, ...grpc.CallOption) (Resp, error), req Req) (Resp, error) {
+	if err := c.handshake(ctx); err != nil {
+		var resp Resp
+		return resp, err
+	}
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	resp, err := method(ctx, req)
+	return resp, convertError(err)
+}
+
+// handshake checks the protocol version reported by the plugin, it's done once after the plugin is reachable,
+// and again after the plugin reconnects.
+func (c *client) handshake(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pluginName != "" {
+		return nil
+	}
+
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	info, err := c.engine.GetPluginInfo(ctx, &pluginproto.GetPluginInfoRequest{})
+	if err != nil {
+		return errors.Wrap(convertError(err), "get plugin info failed")
+	}
+	if info.GetProtocolVersion() != ProtocolVersion {
+		return errors.Errorf("plugin %s speaks protocol %q, but %q is required", info.GetName(), info.GetProtocolVersion(), ProtocolVersion)
+	}
+	c.pluginName = info.GetName()
+	if c.pluginName == "" {
+		c.pluginName = "unknown"
+	}
+	return nil
+}
+
+func (c *client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
+	}
+	return ctx, func() {}
+}
+
+// convertError maps the status code returned by the plugin to the lorry errors.
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch s.Code() {
+	case codes.Unimplemented:
+		return errors.Wrap(models.ErrNotImplemented, s.Message())
+	case codes.NotFound:
+		return errors.Wrap(models.ErrNoSuchUser, s.Message())
+	default:
+		return errors.Errorf("%s: %s", s.Code().String(), s.Message())
+	}
+}
+
+func newCluster(cluster *dcs.Cluster) *pluginproto.Cluster {
+	if cluster == nil {
+		return nil
+	}
+	info := &pluginproto.Cluster{
+		Namespace: cluster.Namespace,
+		Replicas:  cluster.Replicas,
+	}
+	if cluster.Leader != nil {
+		info.Leader = cluster.Leader.Name
+	}
+	for _, member := range cluster.Members {
+		info.Members = append(info.Members, newMember(cluster, &member))
+	}
+	return info
+}
+
+func newMember(cluster *dcs.Cluster, member *dcs.Member) *pluginproto.Member {
+	if member == nil {
+		return nil
+	}
+	info := &pluginproto.Member{
+		Name:   member.Name,
+		Role:   member.Role,
+		PodIP:  member.PodIP,
+		DbPort: member.DBPort,
+	}
+	if cluster != nil {
+		info.Address = cluster.GetMemberAddr(*member)
+	}
+	return info
+}
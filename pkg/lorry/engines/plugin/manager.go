@@ -0,0 +1,373 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	pluginproto "github.com/apecloud/kubeblocks/pkg/lorry/engines/plugin/proto"
+)
+
+const (
+	// EndpointKey is the metadata of the engine config file to serve the engine by a plugin.
+	EndpointKey = "pluginEndpoint"
+	timeoutKey  = "pluginTimeout"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// Manager delegates the database management to an out-of-process plugin, speaking the contract
+// defined in proto/engine.proto. The methods the plugin doesn't implement behave as DBManagerBase.
+type Manager struct {
+	engines.DBManagerBase
+	client *client
+}
+
+var _ engines.DBManager = &Manager{}
+
+func NewManager(properties engines.Properties) (engines.DBManager, error) {
+	logger := ctrl.Log.WithName("plugin")
+
+	endpoint := properties[EndpointKey]
+	if endpoint == "" {
+		return nil, errors.Errorf("%s is not set", EndpointKey)
+	}
+	timeout := defaultTimeout
+	if val, ok := properties[timeoutKey]; ok && val != "" {
+		var err error
+		if timeout, err = time.ParseDuration(val); err != nil {
+			return nil, errors.Errorf("incorrect %s field from metadata", timeoutKey)
+		}
+	}
+
+	managerBase, err := engines.NewDBManagerBase(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(endpoint, timeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial plugin failed")
+	}
+
+	mgr := &Manager{
+		DBManagerBase: *managerBase,
+		client:        client,
+	}
+	return mgr, nil
+}
+
+func (mgr *Manager) newRequest(cluster *dcs.Cluster) *pluginproto.Request {
+	return &pluginproto.Request{
+		CurrentMember: mgr.CurrentMemberName,
+		Cluster:       newCluster(cluster),
+	}
+}
+
+func (mgr *Manager) newMemberRequest(cluster *dcs.Cluster, member *dcs.Member) *pluginproto.MemberRequest {
+	return &pluginproto.MemberRequest{
+		CurrentMember: mgr.CurrentMemberName,
+		Cluster:       newCluster(cluster),
+		Member:        newMember(cluster, member),
+	}
+}
+
+// fallback returns the result of DBManagerBase if the method is not implemented by the plugin, or failed otherwise.
+func (mgr *Manager) fallback(method string, err error, base, failed bool) bool {
+	if errors.Is(err, models.ErrNotImplemented) {
+		return base
+	}
+	mgr.logError(method, err)
+	return failed
+}
+
+func (mgr *Manager) logError(method string, err error) {
+	if !errors.Is(err, models.ErrNotImplemented) {
+		mgr.Logger.Info("call plugin failed", "method", method, "error", err.Error())
+	}
+}
+
+func (mgr *Manager) IsRunning() bool {
+	resp, err := call(context.Background(), mgr.client, mgr.client.engine.IsRunning, mgr.newRequest(nil))
+	if err != nil {
+		return mgr.fallback("IsRunning", err, false, false)
+	}
+	return resp.GetRunning()
+}
+
+func (mgr *Manager) IsDBStartupReady() bool {
+	if mgr.DBStartupReady {
+		return true
+	}
+	resp, err := call(context.Background(), mgr.client, mgr.client.engine.IsDBStartupReady, mgr.newRequest(nil))
+	if err != nil {
+		return mgr.fallback("IsDBStartupReady", err, false, false)
+	}
+	if resp.GetReady() {
+		mgr.DBStartupReady = true
+		mgr.Logger.Info("DB startup ready")
+	}
+	return resp.GetReady()
+}
+
+func (mgr *Manager) InitializeCluster(ctx context.Context, cluster *dcs.Cluster) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.InitializeCluster, mgr.newRequest(cluster))
+	return mgr.ignoreNotImplemented(err)
+}
+
+func (mgr *Manager) IsClusterInitialized(ctx context.Context, cluster *dcs.Cluster) (bool, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsClusterInitialized, mgr.newRequest(cluster))
+	if errors.Is(err, models.ErrNotImplemented) {
+		return mgr.DBManagerBase.IsClusterInitialized(ctx, cluster)
+	}
+	return resp.GetInitialized(), err
+}
+
+func (mgr *Manager) IsClusterHealthy(ctx context.Context, cluster *dcs.Cluster) bool {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsClusterHealthy, mgr.newRequest(cluster))
+	if err != nil {
+		return mgr.fallback("IsClusterHealthy", err, mgr.DBManagerBase.IsClusterHealthy(ctx, cluster), false)
+	}
+	return resp.GetHealthy()
+}
+
+func (mgr *Manager) IsCurrentMemberInCluster(ctx context.Context, cluster *dcs.Cluster) bool {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsCurrentMemberInCluster, mgr.newRequest(cluster))
+	if err != nil {
+		return mgr.fallback("IsCurrentMemberInCluster", err, mgr.DBManagerBase.IsCurrentMemberInCluster(ctx, cluster), true)
+	}
+	return resp.GetInCluster()
+}
+
+func (mgr *Manager) IsCurrentMemberHealthy(ctx context.Context, cluster *dcs.Cluster) bool {
+	member := cluster.GetMemberWithName(mgr.CurrentMemberName)
+	if member == nil {
+		member = &dcs.Member{Name: mgr.CurrentMemberName}
+	}
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsMemberHealthy, mgr.newMemberRequest(cluster, member))
+	if err != nil {
+		return mgr.fallback("IsMemberHealthy", err, mgr.DBManagerBase.IsCurrentMemberHealthy(ctx, cluster), false)
+	}
+	return resp.GetHealthy()
+}
+
+func (mgr *Manager) IsMemberHealthy(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) bool {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsMemberHealthy, mgr.newMemberRequest(cluster, member))
+	if err != nil {
+		return mgr.fallback("IsMemberHealthy", err, mgr.DBManagerBase.IsMemberHealthy(ctx, cluster, member), false)
+	}
+	return resp.GetHealthy()
+}
+
+func (mgr *Manager) IsRootCreated(ctx context.Context) (bool, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsRootCreated, mgr.newRequest(nil))
+	if errors.Is(err, models.ErrNotImplemented) {
+		return mgr.DBManagerBase.IsRootCreated(ctx)
+	}
+	return resp.GetCreated(), err
+}
+
+func (mgr *Manager) CreateRoot(ctx context.Context) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.CreateRoot, mgr.newRequest(nil))
+	return mgr.ignoreNotImplemented(err)
+}
+
+func (mgr *Manager) GetPort() (int, error) {
+	resp, err := call(context.Background(), mgr.client, mgr.client.engine.GetPort, mgr.newRequest(nil))
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.GetPort()), nil
+}
+
+func (mgr *Manager) GetReplicaRole(ctx context.Context, cluster *dcs.Cluster) (string, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.GetReplicaRole, mgr.newRequest(cluster))
+	if err != nil {
+		return "", err
+	}
+	return resp.GetRole(), nil
+}
+
+func (mgr *Manager) IsLeader(ctx context.Context, cluster *dcs.Cluster) (bool, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsLeader, mgr.newRequest(cluster))
+	return resp.GetLeader(), err
+}
+
+func (mgr *Manager) IsLeaderMember(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) (bool, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsLeaderMember, mgr.newMemberRequest(cluster, member))
+	return resp.GetLeader(), err
+}
+
+func (mgr *Manager) HasOtherHealthyLeader(ctx context.Context, cluster *dcs.Cluster) *dcs.Member {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.HasOtherHealthyLeader, mgr.newRequest(cluster))
+	if err != nil {
+		mgr.logError("HasOtherHealthyLeader", err)
+		return nil
+	}
+	if resp.GetLeader() == "" {
+		return nil
+	}
+	return cluster.GetMemberWithName(resp.GetLeader())
+}
+
+func (mgr *Manager) GetMemberAddrs(ctx context.Context, cluster *dcs.Cluster) []string {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.GetMemberAddrs, mgr.newRequest(cluster))
+	if err != nil {
+		mgr.logError("GetMemberAddrs", err)
+		return nil
+	}
+	return resp.GetAddrs()
+}
+
+func (mgr *Manager) GetDBState(ctx context.Context, cluster *dcs.Cluster) *dcs.DBState {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.GetDBState, mgr.newRequest(cluster))
+	if err != nil {
+		mgr.logError("GetDBState", err)
+		return nil
+	}
+	return &dcs.DBState{
+		OpTimestamp: resp.GetOpTimestamp(),
+		Extra:       resp.GetExtra(),
+	}
+}
+
+func (mgr *Manager) GetLag(ctx context.Context, cluster *dcs.Cluster) (int64, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.GetLag, mgr.newRequest(cluster))
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetLag(), nil
+}
+
+func (mgr *Manager) IsMemberLagging(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) (bool, int64) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsMemberLagging, mgr.newMemberRequest(cluster, member))
+	if err != nil {
+		return mgr.fallback("IsMemberLagging", err, false, true), 0
+	}
+	return resp.GetLagging(), resp.GetLag()
+}
+
+func (mgr *Manager) IsPromoted(ctx context.Context) bool {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.IsPromoted, mgr.newRequest(nil))
+	if err != nil {
+		return mgr.fallback("IsPromoted", err, mgr.DBManagerBase.IsPromoted(ctx), false)
+	}
+	return resp.GetPromoted()
+}
+
+func (mgr *Manager) Promote(ctx context.Context, cluster *dcs.Cluster) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.Promote, mgr.newRequest(cluster))
+	return err
+}
+
+func (mgr *Manager) Demote(ctx context.Context) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.Demote, mgr.newRequest(nil))
+	return err
+}
+
+func (mgr *Manager) Follow(ctx context.Context, cluster *dcs.Cluster) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.Follow, mgr.newRequest(cluster))
+	return err
+}
+
+func (mgr *Manager) Recover(ctx context.Context, cluster *dcs.Cluster) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.Recover, mgr.newRequest(cluster))
+	return mgr.ignoreNotImplemented(err)
+}
+
+func (mgr *Manager) JoinCurrentMemberToCluster(ctx context.Context, cluster *dcs.Cluster) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.JoinCurrentMemberToCluster, mgr.newRequest(cluster))
+	return mgr.ignoreNotImplemented(err)
+}
+
+func (mgr *Manager) LeaveMemberFromCluster(ctx context.Context, cluster *dcs.Cluster, memberName string) error {
+	req := &pluginproto.LeaveMemberRequest{
+		CurrentMember: mgr.CurrentMemberName,
+		Cluster:       newCluster(cluster),
+		MemberName:    memberName,
+	}
+	_, err := call(ctx, mgr.client, mgr.client.engine.LeaveMemberFromCluster, req)
+	return mgr.ignoreNotImplemented(err)
+}
+
+func (mgr *Manager) Lock(ctx context.Context, reason string) error {
+	req := &pluginproto.LockRequest{
+		CurrentMember: mgr.CurrentMemberName,
+		Reason:        reason,
+	}
+	if _, err := call(ctx, mgr.client, mgr.client.engine.Lock, req); err != nil {
+		return err
+	}
+	mgr.IsLocked = true
+	return nil
+}
+
+func (mgr *Manager) Unlock(ctx context.Context) error {
+	if _, err := call(ctx, mgr.client, mgr.client.engine.Unlock, mgr.newRequest(nil)); err != nil {
+		return err
+	}
+	mgr.IsLocked = false
+	return nil
+}
+
+func (mgr *Manager) Exec(ctx context.Context, sql string) (int64, error) {
+	req := &pluginproto.SQLRequest{
+		CurrentMember: mgr.CurrentMemberName,
+		Sql:           sql,
+	}
+	resp, err := call(ctx, mgr.client, mgr.client.engine.Exec, req)
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetCount(), nil
+}
+
+func (mgr *Manager) Query(ctx context.Context, sql string) ([]byte, error) {
+	req := &pluginproto.SQLRequest{
+		CurrentMember: mgr.CurrentMemberName,
+		Sql:           sql,
+	}
+	resp, err := call(ctx, mgr.client, mgr.client.engine.Query, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetResult(), nil
+}
+
+func (mgr *Manager) ShutDownWithWait() {
+	if err := mgr.client.close(); err != nil {
+		mgr.Logger.Info("close plugin connection failed", "error", err.Error())
+	}
+}
+
+// ignoreNotImplemented treats the method not implemented by the plugin as a no-op, as DBManagerBase does.
+func (mgr *Manager) ignoreNotImplemented(err error) error {
+	if errors.Is(err, models.ErrNotImplemented) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,228 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package plugin
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	pluginproto "github.com/apecloud/kubeblocks/pkg/lorry/engines/plugin/proto"
+)
+
+type mockEngineServer struct {
+	pluginproto.UnimplementedEngineServiceServer
+	protocolVersion string
+	lastRequest     any
+}
+
+func (s *mockEngineServer) GetPluginInfo(context.Context, *pluginproto.GetPluginInfoRequest) (*pluginproto.GetPluginInfoResponse, error) {
+	return &pluginproto.GetPluginInfoResponse{Name: "mydb", ProtocolVersion: s.protocolVersion}, nil
+}
+
+func (s *mockEngineServer) IsRunning(_ context.Context, req *pluginproto.Request) (*pluginproto.IsRunningResponse, error) {
+	s.lastRequest = req
+	return &pluginproto.IsRunningResponse{Running: true}, nil
+}
+
+func (s *mockEngineServer) GetReplicaRole(_ context.Context, req *pluginproto.Request) (*pluginproto.GetReplicaRoleResponse, error) {
+	s.lastRequest = req
+	return &pluginproto.GetReplicaRoleResponse{Role: "primary"}, nil
+}
+
+func (s *mockEngineServer) IsMemberHealthy(_ context.Context, req *pluginproto.MemberRequest) (*pluginproto.HealthResponse, error) {
+	s.lastRequest = req
+	return &pluginproto.HealthResponse{Healthy: true}, nil
+}
+
+func (s *mockEngineServer) IsMemberLagging(_ context.Context, req *pluginproto.MemberRequest) (*pluginproto.IsMemberLaggingResponse, error) {
+	s.lastRequest = req
+	return &pluginproto.IsMemberLaggingResponse{Lagging: true, Lag: 42}, nil
+}
+
+func (s *mockEngineServer) GetDBState(_ context.Context, req *pluginproto.Request) (*pluginproto.GetDBStateResponse, error) {
+	s.lastRequest = req
+	return &pluginproto.GetDBStateResponse{OpTimestamp: 100, Extra: map[string]string{"lsn": "0/100"}}, nil
+}
+
+func (s *mockEngineServer) HasOtherHealthyLeader(_ context.Context, req *pluginproto.Request) (*pluginproto.HasOtherHealthyLeaderResponse, error) {
+	s.lastRequest = req
+	return &pluginproto.HasOtherHealthyLeaderResponse{Leader: "mydb-1"}, nil
+}
+
+func (s *mockEngineServer) LeaveMemberFromCluster(_ context.Context, req *pluginproto.LeaveMemberRequest) (*emptypb.Empty, error) {
+	s.lastRequest = req
+	return &emptypb.Empty{}, nil
+}
+
+func (s *mockEngineServer) Promote(context.Context, *pluginproto.Request) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.FailedPrecondition, "replica is lagging")
+}
+
+func (s *mockEngineServer) DescribeUser(_ context.Context, req *pluginproto.UserRequest) (*pluginproto.DescribeUserResponse, error) {
+	if req.GetUserName() != "alice" {
+		return nil, status.Error(codes.NotFound, "no such user")
+	}
+	return &pluginproto.DescribeUserResponse{User: &pluginproto.User{UserName: "alice", RoleName: "readonly"}}, nil
+}
+
+func (s *mockEngineServer) ListUsers(context.Context, *pluginproto.Request) (*pluginproto.ListUsersResponse, error) {
+	return &pluginproto.ListUsersResponse{Users: []*pluginproto.User{{UserName: "alice"}}}, nil
+}
+
+func startMockEngineServer(t *testing.T, s *mockEngineServer, address string) string {
+	listener, err := net.Listen("tcp", address)
+	assert.Nil(t, err)
+	server := grpc.NewServer()
+	pluginproto.RegisterEngineServiceServer(server, s)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func newTestManager(t *testing.T, endpoint string) *Manager {
+	viper.Set(constant.KBEnvPodName, "mydb-0")
+	t.Cleanup(viper.Reset)
+	manager, err := NewManager(engines.Properties{EndpointKey: endpoint})
+	assert.Nil(t, err)
+	t.Cleanup(manager.ShutDownWithWait)
+	return manager.(*Manager)
+}
+
+func TestNewManager(t *testing.T) {
+	viper.Set(constant.KBEnvPodName, "mydb-0")
+	defer viper.Reset()
+
+	_, err := NewManager(engines.Properties{})
+	assert.ErrorContains(t, err, "pluginEndpoint is not set")
+
+	_, err = NewManager(engines.Properties{EndpointKey: "127.0.0.1:50051", timeoutKey: "1"})
+	assert.ErrorContains(t, err, "incorrect pluginTimeout")
+}
+
+func TestHandshake(t *testing.T) {
+	manager := newTestManager(t, startMockEngineServer(t, &mockEngineServer{protocolVersion: "v2"}, "127.0.0.1:0"))
+
+	_, err := manager.GetReplicaRole(context.Background(), nil)
+	assert.ErrorContains(t, err, `plugin mydb speaks protocol "v2", but "v1" is required`)
+}
+
+func TestHandshakeAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	server := grpc.NewServer()
+	pluginproto.RegisterEngineServiceServer(server, &mockEngineServer{protocolVersion: ProtocolVersion})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	manager := newTestManager(t, listener.Addr().String())
+	_, err = manager.GetReplicaRole(ctx, nil)
+	assert.Nil(t, err)
+
+	// the plugin is restarted with another version
+	server.Stop()
+	startMockEngineServer(t, &mockEngineServer{protocolVersion: "v2"}, listener.Addr().String())
+	assert.Eventually(t, func() bool {
+		_, err = manager.GetReplicaRole(ctx, nil)
+		return err != nil && err.Error() == `plugin mydb speaks protocol "v2", but "v1" is required`
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestManager(t *testing.T) {
+	ctx := context.Background()
+	server := &mockEngineServer{protocolVersion: ProtocolVersion}
+	manager := newTestManager(t, startMockEngineServer(t, server, "127.0.0.1:0"))
+	cluster := &dcs.Cluster{
+		Namespace: "default",
+		Replicas:  2,
+		Leader:    &dcs.Leader{Name: "mydb-0"},
+		Members: []dcs.Member{
+			{Name: "mydb-0", PodIP: "10.0.0.1", DBPort: "3306", UseIP: true},
+			{Name: "mydb-1", PodIP: "10.0.0.2", DBPort: "3306", UseIP: true},
+		},
+	}
+
+	t.Run("health", func(t *testing.T) {
+		assert.True(t, manager.IsRunning())
+		assert.True(t, manager.IsCurrentMemberHealthy(ctx, cluster))
+		req := server.lastRequest.(*pluginproto.MemberRequest)
+		assert.Equal(t, "mydb-0", req.GetMember().GetName())
+		assert.Equal(t, "10.0.0.1", req.GetMember().GetAddress())
+		assert.Equal(t, "mydb-0", req.GetCurrentMember())
+		assert.Equal(t, "mydb-0", req.GetCluster().GetLeader())
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		// falls back to DBManagerBase
+		assert.False(t, manager.IsDBStartupReady())
+		assert.True(t, manager.IsClusterHealthy(ctx, cluster))
+		assert.True(t, manager.IsCurrentMemberInCluster(ctx, cluster))
+		assert.Nil(t, manager.JoinCurrentMemberToCluster(ctx, cluster))
+		initialized, err := manager.IsClusterInitialized(ctx, cluster)
+		assert.Nil(t, err)
+		assert.True(t, initialized)
+		_, err = manager.GetLag(ctx, cluster)
+		assert.True(t, errors.Is(err, models.ErrNotImplemented))
+	})
+
+	t.Run("roles", func(t *testing.T) {
+		role, err := manager.GetReplicaRole(ctx, cluster)
+		assert.Nil(t, err)
+		assert.Equal(t, "primary", role)
+		assert.Equal(t, "mydb-1", manager.HasOtherHealthyLeader(ctx, cluster).Name)
+		lagging, lag := manager.IsMemberLagging(ctx, cluster, &cluster.Members[1])
+		assert.True(t, lagging)
+		assert.Equal(t, int64(42), lag)
+		assert.Equal(t, &dcs.DBState{OpTimestamp: 100, Extra: map[string]string{"lsn": "0/100"}}, manager.GetDBState(ctx, cluster))
+		assert.ErrorContains(t, manager.Promote(ctx, cluster), "FailedPrecondition: replica is lagging")
+	})
+
+	t.Run("membership", func(t *testing.T) {
+		assert.Nil(t, manager.LeaveMemberFromCluster(ctx, cluster, "mydb-1"))
+		assert.Equal(t, "mydb-1", server.lastRequest.(*pluginproto.LeaveMemberRequest).GetMemberName())
+	})
+
+	t.Run("users", func(t *testing.T) {
+		user, err := manager.DescribeUser(ctx, "alice")
+		assert.Nil(t, err)
+		assert.Equal(t, &models.UserInfo{UserName: "alice", RoleName: "readonly"}, user)
+		_, err = manager.DescribeUser(ctx, "bob")
+		assert.True(t, errors.Is(err, models.ErrNoSuchUser))
+		users, err := manager.ListUsers(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.UserInfo{{UserName: "alice"}}, users)
+	})
+}
@@ -0,0 +1,1479 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.9
+// source: engine.proto
+
+package proto
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EngineServiceClient is the client API for EngineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EngineServiceClient interface {
+	// GetPluginInfo returns the protocol version, which must be "v1".
+	GetPluginInfo(ctx context.Context, in *GetPluginInfoRequest, opts ...grpc.CallOption) (*GetPluginInfoResponse, error)
+	IsRunning(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsRunningResponse, error)
+	IsDBStartupReady(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsDBStartupReadyResponse, error)
+	IsClusterInitialized(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsClusterInitializedResponse, error)
+	InitializeCluster(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsClusterHealthy(ctx context.Context, in *Request, opts ...grpc.CallOption) (*HealthResponse, error)
+	IsMemberHealthy(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	IsCurrentMemberInCluster(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsCurrentMemberInClusterResponse, error)
+	IsRootCreated(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsRootCreatedResponse, error)
+	CreateRoot(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPort(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetPortResponse, error)
+	GetReplicaRole(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetReplicaRoleResponse, error)
+	IsLeader(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsLeaderResponse, error)
+	IsLeaderMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*IsLeaderResponse, error)
+	HasOtherHealthyLeader(ctx context.Context, in *Request, opts ...grpc.CallOption) (*HasOtherHealthyLeaderResponse, error)
+	GetMemberAddrs(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetMemberAddrsResponse, error)
+	GetDBState(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetDBStateResponse, error)
+	GetLag(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetLagResponse, error)
+	IsMemberLagging(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*IsMemberLaggingResponse, error)
+	IsPromoted(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsPromotedResponse, error)
+	Promote(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Demote(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Follow(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Recover(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	JoinCurrentMemberToCluster(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaveMemberFromCluster(ctx context.Context, in *LeaveMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lock makes the database read-only when the disk is nearly full.
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Unlock(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	ListSystemAccounts(ctx context.Context, in *Request, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DescribeUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*DescribeUserResponse, error)
+	GrantUserRole(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeUserRole(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateUserPassword(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiscardOldUserPassword(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Exec(ctx context.Context, in *SQLRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	Query(ctx context.Context, in *SQLRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+}
+
+type engineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEngineServiceClient(cc grpc.ClientConnInterface) EngineServiceClient {
+	return &engineServiceClient{cc}
+}
+
+func (c *engineServiceClient) GetPluginInfo(ctx context.Context, in *GetPluginInfoRequest, opts ...grpc.CallOption) (*GetPluginInfoResponse, error) {
+	out := new(GetPluginInfoResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GetPluginInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsRunning(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsRunningResponse, error) {
+	out := new(IsRunningResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsRunning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsDBStartupReady(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsDBStartupReadyResponse, error) {
+	out := new(IsDBStartupReadyResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsDBStartupReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsClusterInitialized(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsClusterInitializedResponse, error) {
+	out := new(IsClusterInitializedResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsClusterInitialized", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) InitializeCluster(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/InitializeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsClusterHealthy(ctx context.Context, in *Request, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsClusterHealthy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsMemberHealthy(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsMemberHealthy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsCurrentMemberInCluster(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsCurrentMemberInClusterResponse, error) {
+	out := new(IsCurrentMemberInClusterResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsCurrentMemberInCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsRootCreated(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsRootCreatedResponse, error) {
+	out := new(IsRootCreatedResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsRootCreated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) CreateRoot(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/CreateRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetPort(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetPortResponse, error) {
+	out := new(GetPortResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GetPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetReplicaRole(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetReplicaRoleResponse, error) {
+	out := new(GetReplicaRoleResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GetReplicaRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsLeader(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsLeaderResponse, error) {
+	out := new(IsLeaderResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsLeaderMember(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*IsLeaderResponse, error) {
+	out := new(IsLeaderResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsLeaderMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) HasOtherHealthyLeader(ctx context.Context, in *Request, opts ...grpc.CallOption) (*HasOtherHealthyLeaderResponse, error) {
+	out := new(HasOtherHealthyLeaderResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/HasOtherHealthyLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetMemberAddrs(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetMemberAddrsResponse, error) {
+	out := new(GetMemberAddrsResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GetMemberAddrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetDBState(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetDBStateResponse, error) {
+	out := new(GetDBStateResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GetDBState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetLag(ctx context.Context, in *Request, opts ...grpc.CallOption) (*GetLagResponse, error) {
+	out := new(GetLagResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GetLag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsMemberLagging(ctx context.Context, in *MemberRequest, opts ...grpc.CallOption) (*IsMemberLaggingResponse, error) {
+	out := new(IsMemberLaggingResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsMemberLagging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) IsPromoted(ctx context.Context, in *Request, opts ...grpc.CallOption) (*IsPromotedResponse, error) {
+	out := new(IsPromotedResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/IsPromoted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Promote(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Demote(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Demote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Follow(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Follow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Recover(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Recover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) JoinCurrentMemberToCluster(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/JoinCurrentMemberToCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) LeaveMemberFromCluster(ctx context.Context, in *LeaveMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/LeaveMemberFromCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Unlock(ctx context.Context, in *Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ListUsers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ListSystemAccounts(ctx context.Context, in *Request, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/ListSystemAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) CreateUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) DeleteUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) DescribeUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*DescribeUserResponse, error) {
+	out := new(DescribeUserResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/DescribeUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GrantUserRole(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/GrantUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) RevokeUserRole(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/RevokeUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) UpdateUserPassword(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/UpdateUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) DiscardOldUserPassword(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/DiscardOldUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Exec(ctx context.Context, in *SQLRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
+	out := new(ExecResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Query(ctx context.Context, in *SQLRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/kubeblocks.lorry.plugin.v1.EngineService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EngineServiceServer is the server API for EngineService service.
+// All implementations must embed UnimplementedEngineServiceServer
+// for forward compatibility
+type EngineServiceServer interface {
+	// GetPluginInfo returns the protocol version, which must be "v1".
+	GetPluginInfo(context.Context, *GetPluginInfoRequest) (*GetPluginInfoResponse, error)
+	IsRunning(context.Context, *Request) (*IsRunningResponse, error)
+	IsDBStartupReady(context.Context, *Request) (*IsDBStartupReadyResponse, error)
+	IsClusterInitialized(context.Context, *Request) (*IsClusterInitializedResponse, error)
+	InitializeCluster(context.Context, *Request) (*emptypb.Empty, error)
+	IsClusterHealthy(context.Context, *Request) (*HealthResponse, error)
+	IsMemberHealthy(context.Context, *MemberRequest) (*HealthResponse, error)
+	IsCurrentMemberInCluster(context.Context, *Request) (*IsCurrentMemberInClusterResponse, error)
+	IsRootCreated(context.Context, *Request) (*IsRootCreatedResponse, error)
+	CreateRoot(context.Context, *Request) (*emptypb.Empty, error)
+	GetPort(context.Context, *Request) (*GetPortResponse, error)
+	GetReplicaRole(context.Context, *Request) (*GetReplicaRoleResponse, error)
+	IsLeader(context.Context, *Request) (*IsLeaderResponse, error)
+	IsLeaderMember(context.Context, *MemberRequest) (*IsLeaderResponse, error)
+	HasOtherHealthyLeader(context.Context, *Request) (*HasOtherHealthyLeaderResponse, error)
+	GetMemberAddrs(context.Context, *Request) (*GetMemberAddrsResponse, error)
+	GetDBState(context.Context, *Request) (*GetDBStateResponse, error)
+	GetLag(context.Context, *Request) (*GetLagResponse, error)
+	IsMemberLagging(context.Context, *MemberRequest) (*IsMemberLaggingResponse, error)
+	IsPromoted(context.Context, *Request) (*IsPromotedResponse, error)
+	Promote(context.Context, *Request) (*emptypb.Empty, error)
+	Demote(context.Context, *Request) (*emptypb.Empty, error)
+	Follow(context.Context, *Request) (*emptypb.Empty, error)
+	Recover(context.Context, *Request) (*emptypb.Empty, error)
+	JoinCurrentMemberToCluster(context.Context, *Request) (*emptypb.Empty, error)
+	LeaveMemberFromCluster(context.Context, *LeaveMemberRequest) (*emptypb.Empty, error)
+	// Lock makes the database read-only when the disk is nearly full.
+	Lock(context.Context, *LockRequest) (*emptypb.Empty, error)
+	Unlock(context.Context, *Request) (*emptypb.Empty, error)
+	ListUsers(context.Context, *Request) (*ListUsersResponse, error)
+	ListSystemAccounts(context.Context, *Request) (*ListUsersResponse, error)
+	CreateUser(context.Context, *UserRequest) (*emptypb.Empty, error)
+	DeleteUser(context.Context, *UserRequest) (*emptypb.Empty, error)
+	DescribeUser(context.Context, *UserRequest) (*DescribeUserResponse, error)
+	GrantUserRole(context.Context, *UserRequest) (*emptypb.Empty, error)
+	RevokeUserRole(context.Context, *UserRequest) (*emptypb.Empty, error)
+	UpdateUserPassword(context.Context, *UserRequest) (*emptypb.Empty, error)
+	DiscardOldUserPassword(context.Context, *UserRequest) (*emptypb.Empty, error)
+	Exec(context.Context, *SQLRequest) (*ExecResponse, error)
+	Query(context.Context, *SQLRequest) (*QueryResponse, error)
+	mustEmbedUnimplementedEngineServiceServer()
+}
+
+// UnimplementedEngineServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEngineServiceServer struct {
+}
+
+func (UnimplementedEngineServiceServer) GetPluginInfo(context.Context, *GetPluginInfoRequest) (*GetPluginInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginInfo not implemented")
+}
+func (UnimplementedEngineServiceServer) IsRunning(context.Context, *Request) (*IsRunningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsRunning not implemented")
+}
+func (UnimplementedEngineServiceServer) IsDBStartupReady(context.Context, *Request) (*IsDBStartupReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsDBStartupReady not implemented")
+}
+func (UnimplementedEngineServiceServer) IsClusterInitialized(context.Context, *Request) (*IsClusterInitializedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsClusterInitialized not implemented")
+}
+func (UnimplementedEngineServiceServer) InitializeCluster(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitializeCluster not implemented")
+}
+func (UnimplementedEngineServiceServer) IsClusterHealthy(context.Context, *Request) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsClusterHealthy not implemented")
+}
+func (UnimplementedEngineServiceServer) IsMemberHealthy(context.Context, *MemberRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMemberHealthy not implemented")
+}
+func (UnimplementedEngineServiceServer) IsCurrentMemberInCluster(context.Context, *Request) (*IsCurrentMemberInClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsCurrentMemberInCluster not implemented")
+}
+func (UnimplementedEngineServiceServer) IsRootCreated(context.Context, *Request) (*IsRootCreatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsRootCreated not implemented")
+}
+func (UnimplementedEngineServiceServer) CreateRoot(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoot not implemented")
+}
+func (UnimplementedEngineServiceServer) GetPort(context.Context, *Request) (*GetPortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPort not implemented")
+}
+func (UnimplementedEngineServiceServer) GetReplicaRole(context.Context, *Request) (*GetReplicaRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicaRole not implemented")
+}
+func (UnimplementedEngineServiceServer) IsLeader(context.Context, *Request) (*IsLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsLeader not implemented")
+}
+func (UnimplementedEngineServiceServer) IsLeaderMember(context.Context, *MemberRequest) (*IsLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsLeaderMember not implemented")
+}
+func (UnimplementedEngineServiceServer) HasOtherHealthyLeader(context.Context, *Request) (*HasOtherHealthyLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasOtherHealthyLeader not implemented")
+}
+func (UnimplementedEngineServiceServer) GetMemberAddrs(context.Context, *Request) (*GetMemberAddrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberAddrs not implemented")
+}
+func (UnimplementedEngineServiceServer) GetDBState(context.Context, *Request) (*GetDBStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDBState not implemented")
+}
+func (UnimplementedEngineServiceServer) GetLag(context.Context, *Request) (*GetLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLag not implemented")
+}
+func (UnimplementedEngineServiceServer) IsMemberLagging(context.Context, *MemberRequest) (*IsMemberLaggingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMemberLagging not implemented")
+}
+func (UnimplementedEngineServiceServer) IsPromoted(context.Context, *Request) (*IsPromotedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPromoted not implemented")
+}
+func (UnimplementedEngineServiceServer) Promote(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (UnimplementedEngineServiceServer) Demote(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Demote not implemented")
+}
+func (UnimplementedEngineServiceServer) Follow(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedEngineServiceServer) Recover(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recover not implemented")
+}
+func (UnimplementedEngineServiceServer) JoinCurrentMemberToCluster(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCurrentMemberToCluster not implemented")
+}
+func (UnimplementedEngineServiceServer) LeaveMemberFromCluster(context.Context, *LeaveMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveMemberFromCluster not implemented")
+}
+func (UnimplementedEngineServiceServer) Lock(context.Context, *LockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedEngineServiceServer) Unlock(context.Context, *Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedEngineServiceServer) ListUsers(context.Context, *Request) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedEngineServiceServer) ListSystemAccounts(context.Context, *Request) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSystemAccounts not implemented")
+}
+func (UnimplementedEngineServiceServer) CreateUser(context.Context, *UserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedEngineServiceServer) DeleteUser(context.Context, *UserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedEngineServiceServer) DescribeUser(context.Context, *UserRequest) (*DescribeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeUser not implemented")
+}
+func (UnimplementedEngineServiceServer) GrantUserRole(context.Context, *UserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantUserRole not implemented")
+}
+func (UnimplementedEngineServiceServer) RevokeUserRole(context.Context, *UserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserRole not implemented")
+}
+func (UnimplementedEngineServiceServer) UpdateUserPassword(context.Context, *UserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+}
+func (UnimplementedEngineServiceServer) DiscardOldUserPassword(context.Context, *UserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardOldUserPassword not implemented")
+}
+func (UnimplementedEngineServiceServer) Exec(context.Context, *SQLRequest) (*ExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedEngineServiceServer) Query(context.Context, *SQLRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedEngineServiceServer) mustEmbedUnimplementedEngineServiceServer() {}
+
+// UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EngineServiceServer will
+// result in compilation errors.
+type UnsafeEngineServiceServer interface {
+	mustEmbedUnimplementedEngineServiceServer()
+}
+
+func RegisterEngineServiceServer(s grpc.ServiceRegistrar, srv EngineServiceServer) {
+	s.RegisterService(&EngineService_ServiceDesc, srv)
+}
+
+func _EngineService_GetPluginInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetPluginInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GetPluginInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetPluginInfo(ctx, req.(*GetPluginInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsRunning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsRunning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsRunning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsRunning(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsDBStartupReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsDBStartupReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsDBStartupReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsDBStartupReady(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsClusterInitialized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsClusterInitialized(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsClusterInitialized",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsClusterInitialized(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_InitializeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).InitializeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/InitializeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).InitializeCluster(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsClusterHealthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsClusterHealthy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsClusterHealthy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsClusterHealthy(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsMemberHealthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsMemberHealthy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsMemberHealthy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsMemberHealthy(ctx, req.(*MemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsCurrentMemberInCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsCurrentMemberInCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsCurrentMemberInCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsCurrentMemberInCluster(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsRootCreated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsRootCreated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsRootCreated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsRootCreated(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_CreateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CreateRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/CreateRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CreateRoot(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GetPort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetPort(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetReplicaRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetReplicaRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GetReplicaRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetReplicaRole(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsLeader(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsLeaderMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsLeaderMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsLeaderMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsLeaderMember(ctx, req.(*MemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_HasOtherHealthyLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).HasOtherHealthyLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/HasOtherHealthyLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).HasOtherHealthyLeader(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetMemberAddrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetMemberAddrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GetMemberAddrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetMemberAddrs(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetDBState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetDBState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GetDBState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetDBState(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GetLag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetLag(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsMemberLagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsMemberLagging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsMemberLagging",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsMemberLagging(ctx, req.(*MemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_IsPromoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).IsPromoted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/IsPromoted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).IsPromoted(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Promote(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Demote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Demote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Demote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Demote(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Follow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Follow(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Recover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Recover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Recover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Recover(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_JoinCurrentMemberToCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).JoinCurrentMemberToCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/JoinCurrentMemberToCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).JoinCurrentMemberToCluster(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_LeaveMemberFromCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).LeaveMemberFromCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/LeaveMemberFromCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).LeaveMemberFromCluster(ctx, req.(*LeaveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Unlock(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ListUsers(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ListSystemAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ListSystemAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/ListSystemAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ListSystemAccounts(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CreateUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).DeleteUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_DescribeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).DescribeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/DescribeUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).DescribeUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GrantUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GrantUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/GrantUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GrantUserRole(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_RevokeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).RevokeUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/RevokeUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).RevokeUserRole(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).UpdateUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/UpdateUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).UpdateUserPassword(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_DiscardOldUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).DiscardOldUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/DiscardOldUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).DiscardOldUserPassword(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Exec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Exec(ctx, req.(*SQLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SQLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubeblocks.lorry.plugin.v1.EngineService/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Query(ctx, req.(*SQLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EngineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kubeblocks.lorry.plugin.v1.EngineService",
+	HandlerType: (*EngineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPluginInfo",
+			Handler:    _EngineService_GetPluginInfo_Handler,
+		},
+		{
+			MethodName: "IsRunning",
+			Handler:    _EngineService_IsRunning_Handler,
+		},
+		{
+			MethodName: "IsDBStartupReady",
+			Handler:    _EngineService_IsDBStartupReady_Handler,
+		},
+		{
+			MethodName: "IsClusterInitialized",
+			Handler:    _EngineService_IsClusterInitialized_Handler,
+		},
+		{
+			MethodName: "InitializeCluster",
+			Handler:    _EngineService_InitializeCluster_Handler,
+		},
+		{
+			MethodName: "IsClusterHealthy",
+			Handler:    _EngineService_IsClusterHealthy_Handler,
+		},
+		{
+			MethodName: "IsMemberHealthy",
+			Handler:    _EngineService_IsMemberHealthy_Handler,
+		},
+		{
+			MethodName: "IsCurrentMemberInCluster",
+			Handler:    _EngineService_IsCurrentMemberInCluster_Handler,
+		},
+		{
+			MethodName: "IsRootCreated",
+			Handler:    _EngineService_IsRootCreated_Handler,
+		},
+		{
+			MethodName: "CreateRoot",
+			Handler:    _EngineService_CreateRoot_Handler,
+		},
+		{
+			MethodName: "GetPort",
+			Handler:    _EngineService_GetPort_Handler,
+		},
+		{
+			MethodName: "GetReplicaRole",
+			Handler:    _EngineService_GetReplicaRole_Handler,
+		},
+		{
+			MethodName: "IsLeader",
+			Handler:    _EngineService_IsLeader_Handler,
+		},
+		{
+			MethodName: "IsLeaderMember",
+			Handler:    _EngineService_IsLeaderMember_Handler,
+		},
+		{
+			MethodName: "HasOtherHealthyLeader",
+			Handler:    _EngineService_HasOtherHealthyLeader_Handler,
+		},
+		{
+			MethodName: "GetMemberAddrs",
+			Handler:    _EngineService_GetMemberAddrs_Handler,
+		},
+		{
+			MethodName: "GetDBState",
+			Handler:    _EngineService_GetDBState_Handler,
+		},
+		{
+			MethodName: "GetLag",
+			Handler:    _EngineService_GetLag_Handler,
+		},
+		{
+			MethodName: "IsMemberLagging",
+			Handler:    _EngineService_IsMemberLagging_Handler,
+		},
+		{
+			MethodName: "IsPromoted",
+			Handler:    _EngineService_IsPromoted_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _EngineService_Promote_Handler,
+		},
+		{
+			MethodName: "Demote",
+			Handler:    _EngineService_Demote_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _EngineService_Follow_Handler,
+		},
+		{
+			MethodName: "Recover",
+			Handler:    _EngineService_Recover_Handler,
+		},
+		{
+			MethodName: "JoinCurrentMemberToCluster",
+			Handler:    _EngineService_JoinCurrentMemberToCluster_Handler,
+		},
+		{
+			MethodName: "LeaveMemberFromCluster",
+			Handler:    _EngineService_LeaveMemberFromCluster_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _EngineService_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _EngineService_Unlock_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _EngineService_ListUsers_Handler,
+		},
+		{
+			MethodName: "ListSystemAccounts",
+			Handler:    _EngineService_ListSystemAccounts_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _EngineService_CreateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _EngineService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "DescribeUser",
+			Handler:    _EngineService_DescribeUser_Handler,
+		},
+		{
+			MethodName: "GrantUserRole",
+			Handler:    _EngineService_GrantUserRole_Handler,
+		},
+		{
+			MethodName: "RevokeUserRole",
+			Handler:    _EngineService_RevokeUserRole_Handler,
+		},
+		{
+			MethodName: "UpdateUserPassword",
+			Handler:    _EngineService_UpdateUserPassword_Handler,
+		},
+		{
+			MethodName: "DiscardOldUserPassword",
+			Handler:    _EngineService_DiscardOldUserPassword_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _EngineService_Exec_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _EngineService_Query_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "engine.proto",
+}
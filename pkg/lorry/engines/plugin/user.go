@@ -0,0 +1,117 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	pluginproto "github.com/apecloud/kubeblocks/pkg/lorry/engines/plugin/proto"
+)
+
+func (mgr *Manager) newUserRequest(userName string) *pluginproto.UserRequest {
+	return &pluginproto.UserRequest{
+		CurrentMember: mgr.CurrentMemberName,
+		UserName:      userName,
+	}
+}
+
+func (mgr *Manager) ListUsers(ctx context.Context) ([]models.UserInfo, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.ListUsers, mgr.newRequest(nil))
+	if err != nil {
+		return nil, err
+	}
+	return newUserInfos(resp.GetUsers()), nil
+}
+
+func (mgr *Manager) ListSystemAccounts(ctx context.Context) ([]models.UserInfo, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.ListSystemAccounts, mgr.newRequest(nil))
+	if err != nil {
+		return nil, err
+	}
+	return newUserInfos(resp.GetUsers()), nil
+}
+
+func (mgr *Manager) DescribeUser(ctx context.Context, userName string) (*models.UserInfo, error) {
+	resp, err := call(ctx, mgr.client, mgr.client.engine.DescribeUser, mgr.newUserRequest(userName))
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetUser() == nil {
+		return nil, models.ErrNoSuchUser
+	}
+	user := newUserInfo(resp.GetUser())
+	return &user, nil
+}
+
+func (mgr *Manager) CreateUser(ctx context.Context, userName, password string) error {
+	req := mgr.newUserRequest(userName)
+	req.Password = password
+	_, err := call(ctx, mgr.client, mgr.client.engine.CreateUser, req)
+	return err
+}
+
+func (mgr *Manager) DeleteUser(ctx context.Context, userName string) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.DeleteUser, mgr.newUserRequest(userName))
+	return err
+}
+
+func (mgr *Manager) GrantUserRole(ctx context.Context, userName, roleName string) error {
+	req := mgr.newUserRequest(userName)
+	req.RoleName = roleName
+	_, err := call(ctx, mgr.client, mgr.client.engine.GrantUserRole, req)
+	return err
+}
+
+func (mgr *Manager) RevokeUserRole(ctx context.Context, userName, roleName string) error {
+	req := mgr.newUserRequest(userName)
+	req.RoleName = roleName
+	_, err := call(ctx, mgr.client, mgr.client.engine.RevokeUserRole, req)
+	return err
+}
+
+func (mgr *Manager) UpdateUserPassword(ctx context.Context, userName, password string, retainCurrent bool) error {
+	req := mgr.newUserRequest(userName)
+	req.Password = password
+	req.RetainCurrentPassword = retainCurrent
+	_, err := call(ctx, mgr.client, mgr.client.engine.UpdateUserPassword, req)
+	return err
+}
+
+func (mgr *Manager) DiscardOldUserPassword(ctx context.Context, userName string) error {
+	_, err := call(ctx, mgr.client, mgr.client.engine.DiscardOldUserPassword, mgr.newUserRequest(userName))
+	return err
+}
+
+func newUserInfo(user *pluginproto.User) models.UserInfo {
+	return models.UserInfo{
+		UserName: user.GetUserName(),
+		RoleName: user.GetRoleName(),
+		Expired:  user.GetExpired(),
+	}
+}
+
+func newUserInfos(users []*pluginproto.User) []models.UserInfo {
+	infos := make([]models.UserInfo, 0, len(users))
+	for _, user := range users {
+		infos = append(infos, newUserInfo(user))
+	}
+	return infos
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
 	viper "github.com/apecloud/kubeblocks/pkg/viperx"
 )
 
@@ -341,3 +342,26 @@ func TestUnlock(t *testing.T) {
 		t.Errorf("there were unfulfilled expectations: %v", err)
 	}
 }
+
+func TestUpdateUserPassword(t *testing.T) {
+	ctx := context.TODO()
+	manager, mock, _ := MockDatabase(t)
+	defer mock.Close()
+
+	t.Run("update password success", func(t *testing.T) {
+		mock.ExpectExec("ALTER USER test WITH PASSWORD").
+			WillReturnResult(pgxmock.NewResult("ALTER", 1))
+
+		err := manager.UpdateUserPassword(ctx, "test", "pwd", false)
+		assert.Nil(t, err)
+	})
+
+	t.Run("retain current password rejected", func(t *testing.T) {
+		err := manager.UpdateUserPassword(ctx, "test", "pwd", true)
+		assert.Equal(t, models.ErrNoDualPassword, err)
+	})
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %v", err)
+	}
+}
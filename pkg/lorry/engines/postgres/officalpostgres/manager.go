@@ -878,8 +878,25 @@ func (mgr *Manager) follow(ctx context.Context, needRestart bool, cluster *dcs.C
 		return nil
 	}
 
+	err := mgr.writePrimaryConnInfo(cluster.GetMemberAddr(*leaderMember), leaderMember.DBPort)
+	if err != nil {
+		return err
+	}
+
+	if !needRestart {
+		if err = mgr.PgReload(ctx); err != nil {
+			mgr.Logger.Error(err, "reload conf failed")
+			return err
+		}
+		return nil
+	}
+
+	return mgr.DBManagerBase.Start(ctx, cluster)
+}
+
+func (mgr *Manager) writePrimaryConnInfo(host, port string) error {
 	primaryInfo := fmt.Sprintf("\nprimary_conninfo = 'host=%s port=%s user=%s password=%s application_name=%s'",
-		cluster.GetMemberAddr(*leaderMember), leaderMember.DBPort, mgr.Config.Username, mgr.Config.Password, mgr.CurrentMemberName)
+		host, port, mgr.Config.Username, mgr.Config.Password, mgr.CurrentMemberName)
 
 	pgConf, err := fs.OpenFile("/kubeblocks/conf/postgresql.conf", os.O_APPEND|os.O_RDWR, 0644)
 	if err != nil {
@@ -902,16 +919,7 @@ func (mgr *Manager) follow(ctx context.Context, needRestart bool, cluster *dcs.C
 		mgr.Logger.Error(err, "writer flush failed")
 		return err
 	}
-
-	if !needRestart {
-		if err = mgr.PgReload(ctx); err != nil {
-			mgr.Logger.Error(err, "reload conf failed")
-			return err
-		}
-		return nil
-	}
-
-	return mgr.DBManagerBase.Start(ctx, cluster)
+	return nil
 }
 
 // Start for postgresql replication, not only means the start of a database instance
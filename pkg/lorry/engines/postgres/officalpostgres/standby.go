@@ -0,0 +1,95 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package officalpostgres
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/spf13/cast"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/postgres"
+)
+
+var _ engines.StandbyManager = &Manager{}
+
+// FollowStandbySource makes the leader of a standby cluster stream from the primary of the source cluster.
+// The replication account is the same as the local one, since the standby cluster is restored from the backup
+// of the source cluster.
+func (mgr *Manager) FollowStandbySource(ctx context.Context, cluster *dcs.Cluster, source *engines.StandbySource) error {
+	needChange, needRestart := mgr.checkRecoveryConf(ctx, source.Host)
+	if !needChange {
+		return nil
+	}
+
+	if needRestart {
+		// the restored database starts as a primary, turn it into a standby and restart it.
+		if mgr.MajorVersion >= 12 {
+			_, err := fs.Stat(mgr.DataDir + "/standby.signal")
+			if errors.Is(err, afero.ErrFileNotFound) {
+				if _, err = fs.Create(mgr.DataDir + "/standby.signal"); err != nil {
+					mgr.Logger.Error(err, "create standby.signal failed")
+					return err
+				}
+			}
+		}
+		if err := mgr.Demote(ctx); err != nil {
+			return err
+		}
+	}
+
+	mgr.Logger.Info("follow standby source", "host", source.Host, "port", source.Port)
+	if err := mgr.writePrimaryConnInfo(source.Host, source.Port); err != nil {
+		return err
+	}
+
+	if !needRestart {
+		if err := mgr.PgReload(ctx); err != nil {
+			mgr.Logger.Error(err, "reload conf failed")
+			return err
+		}
+		return nil
+	}
+	return mgr.DBManagerBase.Start(ctx, cluster)
+}
+
+// GetLag returns the seconds that the current member lags behind the primary it streams from.
+func (mgr *Manager) GetLag(ctx context.Context, _ *dcs.Cluster) (int64, error) {
+	sql := `SELECT CASE WHEN pg_catalog.pg_is_in_recovery() ` +
+		`THEN COALESCE(EXTRACT(EPOCH FROM now() - pg_catalog.pg_last_xact_replay_timestamp()), 0)::bigint ` +
+		`ELSE 0 END AS lag;`
+	resp, err := mgr.Query(ctx, sql)
+	if err != nil {
+		mgr.Logger.Error(err, "get lag failed")
+		return 0, err
+	}
+
+	resMap, err := postgres.ParseQuery(string(resp))
+	if err != nil {
+		return 0, err
+	}
+	if len(resMap) == 0 {
+		return 0, nil
+	}
+	return cast.ToInt64(resMap[0]["lag"]), nil
+}
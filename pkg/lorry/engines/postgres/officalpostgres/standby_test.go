@@ -0,0 +1,101 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package officalpostgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+func TestFollowStandbySource(t *testing.T) {
+	fs = afero.NewMemMapFs()
+	ctx := context.TODO()
+	manager, mock, _ := MockDatabase(t)
+	defer mock.Close()
+	cluster := &dcs.Cluster{}
+	source := &engines.StandbySource{Host: "primary-postgresql.default.svc", Port: "5432"}
+
+	_, err := fs.Create(manager.DataDir + "/standby.signal")
+	assert.Nil(t, err)
+
+	t.Run("still follow the standby source", func(t *testing.T) {
+		mock.ExpectQuery("pg_catalog.pg_settings").
+			WillReturnRows(pgxmock.NewRows([]string{"name", "setting", "context"}).
+				AddRow("primary_conninfo", "host=primary-postgresql.default.svc port=5432 application_name=test-pod-0", "sighup"))
+
+		err := manager.FollowStandbySource(ctx, cluster, source)
+		assert.Nil(t, err)
+	})
+
+	t.Run("follow the standby source with reload", func(t *testing.T) {
+		_, _ = fs.Create("/kubeblocks/conf/postgresql.conf")
+		mock.ExpectQuery("pg_catalog.pg_settings").
+			WillReturnRows(pgxmock.NewRows([]string{"name", "setting", "context"}).
+				AddRow("primary_conninfo", "host=test-pod-1.test-headless port=5432 application_name=test-pod-0", "sighup"))
+		mock.ExpectExec("select pg_reload_conf()").
+			WillReturnResult(pgxmock.NewResult("select", 1))
+
+		err := manager.FollowStandbySource(ctx, cluster, source)
+		assert.Nil(t, err)
+
+		conf, err := afero.ReadFile(fs, "/kubeblocks/conf/postgresql.conf")
+		assert.Nil(t, err)
+		assert.Contains(t, string(conf), "host=primary-postgresql.default.svc port=5432")
+	})
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %v", err)
+	}
+}
+
+func TestGetLag(t *testing.T) {
+	ctx := context.TODO()
+	manager, mock, _ := MockDatabase(t)
+	defer mock.Close()
+
+	t.Run("query failed", func(t *testing.T) {
+		mock.ExpectQuery("pg_last_xact_replay_timestamp").
+			WillReturnError(fmt.Errorf("some error"))
+
+		_, err := manager.GetLag(ctx, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("get lag successfully", func(t *testing.T) {
+		mock.ExpectQuery("pg_last_xact_replay_timestamp").
+			WillReturnRows(pgxmock.NewRows([]string{"lag"}).AddRow(int64(5)))
+
+		lag, err := manager.GetLag(ctx, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), lag)
+	})
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %v", err)
+	}
+}
@@ -63,6 +63,7 @@ const (
 	dropUserTpl           = "DROP USER IF EXISTS %s;"
 	grantTpl              = "GRANT %s TO %s;"
 	revokeTpl             = "REVOKE %s FROM %s;"
+	updatePasswordTpl     = "ALTER USER %s WITH PASSWORD '%s';"
 	listSystemAccountsTpl = "SELECT rolname FROM pg_catalog.pg_roles WHERE pg_roles.rolname LIKE 'kb%'"
 )
 
@@ -181,6 +182,27 @@ func (mgr *Manager) RevokeUserRole(ctx context.Context, userName, roleName strin
 	return nil
 }
 
+// UpdateUserPassword updates the password of the user.
+// A PostgreSQL role has only one password, so the request to retain the current password is rejected.
+func (mgr *Manager) UpdateUserPassword(ctx context.Context, userName, password string, retainCurrent bool) error {
+	if retainCurrent {
+		return models.ErrNoDualPassword
+	}
+	sql := fmt.Sprintf(updatePasswordTpl, userName, password)
+	_, err := mgr.Exec(ctx, sql)
+	if err != nil {
+		mgr.Logger.Info("update user password failed", "user", userName, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// DiscardOldUserPassword does nothing, as the current password is never retained.
+func (mgr *Manager) DiscardOldUserPassword(ctx context.Context, userName string) error {
+	return nil
+}
+
 // post-processing
 func pgUserRolesProcessor(data interface{}) ([]models.UserInfo, error) {
 	type pgUserInfo struct {
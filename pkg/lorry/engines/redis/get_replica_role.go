@@ -34,31 +34,16 @@ func (mgr *Manager) GetReplicaRole(ctx context.Context, _ *dcs.Cluster) (string,
 		return mgr.role, nil
 	}
 
+	if mgr.sentinelClient == nil {
+		// the replication is managed by lorry itself, redis is the source of truth.
+		return mgr.getReplicaRoleFromRedis(ctx)
+	}
+
 	// We use the role obtained from Sentinel as the sole source of truth.
 	masterAddr, err := mgr.sentinelClient.GetMasterAddrByName(ctx, mgr.ClusterCompName).Result()
 	if err != nil {
 		// when we can't get role from sentinel, we query redis instead
-		var role string
-		result, err := mgr.client.Info(ctx, "Replication").Result()
-		if err != nil {
-			mgr.Logger.Info("Role query failed", "error", err.Error())
-			return role, err
-		} else {
-			// split the result into lines
-			lines := strings.Split(result, "\r\n")
-			// find the line with role
-			for _, line := range lines {
-				if strings.HasPrefix(line, "role:") {
-					role = strings.Split(line, ":")[1]
-					break
-				}
-			}
-		}
-		if role == models.MASTER {
-			return models.PRIMARY, nil
-		} else {
-			return models.SECONDARY, nil
-		}
+		return mgr.getReplicaRoleFromRedis(ctx)
 	}
 
 	masterName := strings.Split(masterAddr[0], ".")[0]
@@ -69,6 +54,18 @@ func (mgr *Manager) GetReplicaRole(ctx context.Context, _ *dcs.Cluster) (string,
 	return models.PRIMARY, nil
 }
 
+func (mgr *Manager) getReplicaRoleFromRedis(ctx context.Context) (string, error) {
+	info, err := getReplicationInfo(ctx, mgr.client)
+	if err != nil {
+		mgr.Logger.Info("Role query failed", "error", err.Error())
+		return "", err
+	}
+	if info["role"] == models.MASTER {
+		return models.PRIMARY, nil
+	}
+	return models.SECONDARY, nil
+}
+
 func (mgr *Manager) SubscribeRoleChange(ctx context.Context) {
 	pubSub := mgr.sentinelClient.Subscribe(ctx, "+switch-master")
 
@@ -0,0 +1,393 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+)
+
+// The primary and replicas are managed by the HA loop of lorry with the DCS lease when there is no Sentinel,
+// the lease holder runs as the primary, and the others replicate from it.
+
+const (
+	// demoteFenceTimeout is how long the writes are paused on a demoted primary. The HA loop demotes the member
+	// again while it runs as a primary without the lease, which renews the pause, and following the new primary
+	// or being promoted lifts it. The pause expires by itself if nothing renews it, e.g. lorry is restarted.
+	demoteFenceTimeout = 30 * time.Second
+	masterLinkUp       = "up"
+
+	// healthCheckKey is the reserved key written to check the primary accepts writes, it expires shortly.
+	healthCheckKey = "kubeblocks:lorry:health-check"
+	healthCheckTTL = 30 * time.Second
+)
+
+var errManagedBySentinel = errors.New("the replication is managed by Sentinel")
+
+// managedBySentinel returns true if the replication is managed by Sentinel instead of the HA loop of lorry.
+func (mgr *Manager) managedBySentinel() bool {
+	return mgr.sentinelClient != nil
+}
+
+func (mgr *Manager) IsRunning() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	if _, err := mgr.client.Ping(ctx).Result(); err != nil {
+		mgr.Logger.Info("ping redis failed", "error", err.Error())
+		return false
+	}
+	return true
+}
+
+func (mgr *Manager) GetDBState(ctx context.Context, cluster *dcs.Cluster) *dcs.DBState {
+	mgr.DBState = nil
+
+	info, err := getReplicationInfo(ctx, mgr.client)
+	if err != nil {
+		mgr.Logger.Info("get replication info failed", "error", err.Error())
+		return nil
+	}
+	dbState := &dcs.DBState{
+		// the replication offset takes the place of the op timestamp to compare the progress of members
+		OpTimestamp: info.offset(),
+		Extra: map[string]string{
+			"role":               info["role"],
+			"master_replid":      info["master_replid"],
+			"master_repl_offset": info["master_repl_offset"],
+		},
+	}
+	if info["role"] != models.MASTER {
+		dbState.Extra["master_host"] = info["master_host"]
+		dbState.Extra["master_port"] = info["master_port"]
+		dbState.Extra["master_link_status"] = info["master_link_status"]
+	}
+	mgr.replicationInfo = info
+	mgr.DBState = dbState
+	return dbState
+}
+
+func (mgr *Manager) IsLeader(ctx context.Context, _ *dcs.Cluster) (bool, error) {
+	info, err := getReplicationInfo(ctx, mgr.client)
+	if err != nil {
+		return false, err
+	}
+	return info["role"] == models.MASTER, nil
+}
+
+func (mgr *Manager) IsLeaderMember(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) (bool, error) {
+	if member == nil {
+		return false, nil
+	}
+	client, release := mgr.getMemberClient(cluster, member)
+	defer release()
+	info, err := getReplicationInfo(ctx, client)
+	if err != nil {
+		return false, err
+	}
+	return info["role"] == models.MASTER, nil
+}
+
+func (mgr *Manager) GetMemberAddrs(_ context.Context, cluster *dcs.Cluster) []string {
+	return cluster.GetMemberAddrs()
+}
+
+func (mgr *Manager) IsCurrentMemberHealthy(ctx context.Context, cluster *dcs.Cluster) bool {
+	member := cluster.GetMemberWithName(mgr.CurrentMemberName)
+	return mgr.IsMemberHealthy(ctx, cluster, member)
+}
+
+// IsMemberHealthy checks the leader runs as the primary and accepts writes, and the others are able to serve reads.
+// The write probe fails if redis refuses the writes, e.g. the last background save failed with stop-writes-on-bgsave-error,
+// there are fewer good replicas than min-replicas-to-write, or the memory is used up.
+func (mgr *Manager) IsMemberHealthy(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) bool {
+	if member == nil {
+		return false
+	}
+	client, release := mgr.getMemberClient(cluster, member)
+	defer release()
+
+	if cluster.Leader != nil && cluster.Leader.Name == member.Name {
+		info, err := getReplicationInfo(ctx, client)
+		if err != nil {
+			mgr.Logger.Info("get replication info failed", "member", member.Name, "error", err.Error())
+			return false
+		}
+		if info["role"] != models.MASTER {
+			mgr.Logger.Info("the leader is not running as the primary", "member", member.Name, "role", info["role"])
+			return false
+		}
+		if err = client.Set(ctx, healthCheckKey, mgr.CurrentMemberName, healthCheckTTL).Err(); err != nil {
+			mgr.Logger.Info("write check failed", "member", member.Name, "error", err.Error())
+			return false
+		}
+		return true
+	}
+	if err := client.Ping(ctx).Err(); err != nil {
+		mgr.Logger.Info("read check failed", "member", member.Name, "error", err.Error())
+		return false
+	}
+	return true
+}
+
+// IsMemberLagging compares the replication offset of the member with the one of the leader, the lag is in bytes.
+func (mgr *Manager) IsMemberLagging(ctx context.Context, cluster *dcs.Cluster, member *dcs.Member) (bool, int64) {
+	if cluster.Leader == nil || cluster.Leader.DBState == nil {
+		// In the event of leader initialization failure, there is no available database state information,
+		// just returning false allows other replicas to acquire the lease.
+		mgr.Logger.Info("No leader DBState info")
+		return false, 0
+	}
+	client, release := mgr.getMemberClient(cluster, member)
+	defer release()
+	info, err := getReplicationInfo(ctx, client)
+	if err != nil {
+		mgr.Logger.Info("get replication info failed", "member", member.Name, "error", err.Error())
+		return true, 0
+	}
+	lag := cluster.Leader.DBState.OpTimestamp - info.offset()
+	if lag <= cluster.HaConfig.GetMaxLagOnSwitchover() {
+		return false, lag
+	}
+	mgr.Logger.Info(fmt.Sprintf("The member %s has lag: %d", member.Name, lag))
+	return true, lag
+}
+
+// GetLag returns the bytes that the current member lags behind its primary.
+func (mgr *Manager) GetLag(ctx context.Context, cluster *dcs.Cluster) (int64, error) {
+	info, err := getReplicationInfo(ctx, mgr.client)
+	if err != nil {
+		return 0, err
+	}
+	if info["role"] == models.MASTER {
+		return 0, nil
+	}
+
+	var leaderOffset int64
+	switch {
+	case cluster != nil && cluster.Leader != nil && cluster.Leader.DBState != nil:
+		leaderOffset = cluster.Leader.DBState.OpTimestamp
+	case info["master_host"] != "":
+		client := newClient(mgr.memberSettings(net.JoinHostPort(info["master_host"], info["master_port"])))
+		defer client.Close()
+		leaderInfo, err := getReplicationInfo(ctx, client)
+		if err != nil {
+			return 0, err
+		}
+		leaderOffset = leaderInfo.offset()
+	default:
+		return 0, errors.New("no primary to replicate from")
+	}
+	lag := leaderOffset - info.offset()
+	if lag < 0 {
+		lag = 0
+	}
+	return lag, nil
+}
+
+func (mgr *Manager) HasOtherHealthyMembers(ctx context.Context, cluster *dcs.Cluster, leader string) []*dcs.Member {
+	members := make([]*dcs.Member, 0)
+	for i := range cluster.Members {
+		member := &cluster.Members[i]
+		if member.Name == leader {
+			continue
+		}
+		if !mgr.IsMemberHealthy(ctx, cluster, member) {
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// Promote turns the current member into the primary with REPLICAOF NO ONE.
+func (mgr *Manager) Promote(ctx context.Context, cluster *dcs.Cluster) error {
+	if mgr.managedBySentinel() {
+		return errManagedBySentinel
+	}
+	info, err := getReplicationInfo(ctx, mgr.client)
+	if err != nil {
+		return err
+	}
+	if info["role"] == models.MASTER {
+		// lift the pause of a former demotion
+		return mgr.unpauseWrites(ctx)
+	}
+
+	if err = mgr.client.Do(ctx, "REPLICAOF", "NO", "ONE").Err(); err != nil {
+		mgr.Logger.Info("promote failed", "error", err.Error())
+		return err
+	}
+	if err = mgr.unpauseWrites(ctx); err != nil {
+		return err
+	}
+
+	// fresh db state
+	mgr.GetDBState(ctx, cluster)
+	mgr.Logger.Info("promote success")
+	return nil
+}
+
+// Demote fences the current member if it's the primary, by pausing the writes for a while, see demoteFenceTimeout.
+func (mgr *Manager) Demote(ctx context.Context) error {
+	if mgr.managedBySentinel() {
+		return errManagedBySentinel
+	}
+	isLeader, err := mgr.IsLeader(ctx, nil)
+	if err != nil || !isLeader {
+		return err
+	}
+	timeout := strconv.FormatInt(demoteFenceTimeout.Milliseconds(), 10)
+	if err = mgr.client.Do(ctx, "CLIENT", "PAUSE", timeout, "WRITE").Err(); err != nil {
+		mgr.Logger.Info("demote failed", "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+// Follow replicates the current member from the leader with REPLICAOF host port.
+func (mgr *Manager) Follow(ctx context.Context, cluster *dcs.Cluster) error {
+	if mgr.managedBySentinel() {
+		return errManagedBySentinel
+	}
+	leaderMember := cluster.GetLeaderMember()
+	if leaderMember == nil {
+		return fmt.Errorf("cluster has no leader")
+	}
+	if mgr.CurrentMemberName == cluster.Leader.Name {
+		mgr.Logger.Info("i get the leader key, don't need to follow")
+		return nil
+	}
+
+	host := cluster.GetMemberAddr(*leaderMember)
+	port := leaderMember.DBPort
+	if !mgr.isReplicationOutdated(host, port) {
+		return nil
+	}
+
+	if redisPasswd != "" {
+		if err := mgr.client.ConfigSet(ctx, "masterauth", redisPasswd).Err(); err != nil {
+			return err
+		}
+		if err := mgr.client.ConfigSet(ctx, "masteruser", redisUser).Err(); err != nil {
+			return err
+		}
+	}
+	if err := mgr.client.Do(ctx, "REPLICAOF", host, port).Err(); err != nil {
+		mgr.Logger.Info("Follow leader failed", "error", err.Error())
+		return err
+	}
+	if err := mgr.unpauseWrites(ctx); err != nil {
+		return err
+	}
+
+	// fresh db state
+	mgr.GetDBState(ctx, cluster)
+	mgr.Logger.Info("successfully follow new leader", "leader-name", leaderMember.Name)
+	return nil
+}
+
+// isReplicationOutdated checks whether the current member doesn't replicate from the leader yet,
+// it's based on the replication info fetched in GetDBState.
+func (mgr *Manager) isReplicationOutdated(host, port string) bool {
+	info := mgr.replicationInfo
+	if len(info) == 0 || info["role"] == models.MASTER {
+		return true
+	}
+	if info["master_host"] != host || info["master_port"] != port {
+		return true
+	}
+	// the link is reestablished by redis itself, but a broken link may be caused by a stale address
+	return info["master_link_status"] != masterLinkUp && info["master_sync_in_progress"] != "1"
+}
+
+func (mgr *Manager) unpauseWrites(ctx context.Context) error {
+	if err := mgr.client.Do(ctx, "CLIENT", "UNPAUSE").Err(); err != nil && !isUnknownCommand(err) {
+		return err
+	}
+	return nil
+}
+
+// getMemberClient returns the client to access the member, the release func should be called when it's done.
+func (mgr *Manager) getMemberClient(cluster *dcs.Cluster, member *dcs.Member) (redis.UniversalClient, func()) {
+	if member.Name == mgr.CurrentMemberName {
+		return mgr.client, func() {}
+	}
+	client := newClient(mgr.memberSettings(cluster.GetMemberAddrWithPort(*member)))
+	return client, func() { _ = client.Close() }
+}
+
+// memberSettings returns the settings to access another member, which shares the account of the current member.
+func (mgr *Manager) memberSettings(addr string) *Settings {
+	settings := *mgr.clientSettings
+	settings.Host = addr
+	settings.RedisType = NodeType
+	return &settings
+}
+
+func isUnknownCommand(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "unknown")
+}
+
+// replicationInfo is the section of INFO replication.
+type replicationInfo map[string]string
+
+func getReplicationInfo(ctx context.Context, client redis.UniversalClient) (replicationInfo, error) {
+	result, err := client.Info(ctx, "Replication").Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseInfo(result), nil
+}
+
+// offset returns the replication offset processed by the member.
+func (info replicationInfo) offset() int64 {
+	key := "master_repl_offset"
+	if info["role"] != models.MASTER && info["slave_repl_offset"] != "" {
+		key = "slave_repl_offset"
+	}
+	offset, _ := strconv.ParseInt(info[key], 10, 64)
+	return offset
+}
+
+// parseInfo parses the output of INFO into fields.
+func parseInfo(result string) map[string]string {
+	fields := map[string]string{}
+	for _, line := range strings.Split(result, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if k, v, ok := strings.Cut(line, ":"); ok {
+			fields[k] = v
+		}
+	}
+	return fields
+}
@@ -0,0 +1,191 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
+)
+
+const (
+	primaryReplicationInfo = "# Replication\r\nrole:master\r\nconnected_slaves:1\r\n" +
+		"slave0:ip=10.0.0.2,port=6379,state=online,offset=1200,lag=0\r\n" +
+		"master_replid:8f3c1b\r\nmaster_repl_offset:1500\r\n"
+	replicaReplicationInfo = "# Replication\r\nrole:slave\r\nmaster_host:redis-0.redis-headless\r\nmaster_port:6379\r\n" +
+		"master_link_status:up\r\nmaster_sync_in_progress:0\r\nslave_repl_offset:1200\r\n" +
+		"master_replid:8f3c1b\r\nmaster_repl_offset:1200\r\n"
+)
+
+var _ = Describe("Redis HA", func() {
+	Context("parse replication info", func() {
+		It("of the primary", func() {
+			info := replicationInfo(parseInfo(primaryReplicationInfo))
+			Expect(info["role"]).Should(Equal("master"))
+			Expect(info["slave0"]).Should(Equal("ip=10.0.0.2,port=6379,state=online,offset=1200,lag=0"))
+			Expect(info.offset()).Should(Equal(int64(1500)))
+		})
+
+		It("of the replica", func() {
+			info := replicationInfo(parseInfo(replicaReplicationInfo))
+			Expect(info["role"]).Should(Equal("slave"))
+			Expect(info["master_host"]).Should(Equal("redis-0.redis-headless"))
+			Expect(info.offset()).Should(Equal(int64(1200)))
+		})
+	})
+
+	Context("check the replication", func() {
+		It("is outdated without the replication info", func() {
+			mgr := &Manager{}
+			Expect(mgr.isReplicationOutdated("redis-0.redis-headless", "6379")).Should(BeTrue())
+		})
+
+		It("is outdated if replicating from another primary", func() {
+			mgr := &Manager{replicationInfo: parseInfo(replicaReplicationInfo)}
+			Expect(mgr.isReplicationOutdated("redis-0.redis-headless", "6379")).Should(BeFalse())
+			Expect(mgr.isReplicationOutdated("redis-1.redis-headless", "6379")).Should(BeTrue())
+		})
+
+		It("is outdated if the link is down", func() {
+			info := parseInfo(replicaReplicationInfo)
+			info["master_link_status"] = "down"
+			mgr := &Manager{replicationInfo: info}
+			Expect(mgr.isReplicationOutdated("redis-0.redis-headless", "6379")).Should(BeTrue())
+		})
+
+		It("is outdated on a primary", func() {
+			mgr := &Manager{replicationInfo: parseInfo(primaryReplicationInfo)}
+			Expect(mgr.isReplicationOutdated("redis-0.redis-headless", "6379")).Should(BeTrue())
+		})
+	})
+
+	Context("managed by Sentinel", func() {
+		It("leaves the replication to Sentinel", func() {
+			mgr := &Manager{sentinelClient: redis.NewSentinelClient(&redis.Options{Addr: "127.0.0.1:26379"})}
+			defer mgr.sentinelClient.Close()
+			Expect(mgr.Promote(context.Background(), &dcs.Cluster{})).Should(MatchError(errManagedBySentinel))
+			Expect(mgr.Demote(context.Background())).Should(MatchError(errManagedBySentinel))
+			Expect(mgr.Follow(context.Background(), &dcs.Cluster{})).Should(MatchError(errManagedBySentinel))
+		})
+	})
+
+	Context("switch the roles", func() {
+		var (
+			mgr  *Manager
+			mock redismock.ClientMock
+		)
+
+		BeforeEach(func() {
+			var client *redis.Client
+			client, mock = redismock.NewClientMock()
+			mgr = &Manager{
+				DBManagerBase: engines.DBManagerBase{CurrentMemberName: "redis-1"},
+				client:        client,
+			}
+			DeferCleanup(func() {
+				Expect(mock.ExpectationsWereMet()).Should(Succeed())
+			})
+		})
+
+		It("promotes the replica", func() {
+			mock.ExpectInfo("Replication").SetVal(replicaReplicationInfo)
+			mock.ExpectDo("REPLICAOF", "NO", "ONE").SetVal("OK")
+			mock.ExpectDo("CLIENT", "UNPAUSE").SetVal("OK")
+			mock.ExpectInfo("Replication").SetVal(primaryReplicationInfo)
+			Expect(mgr.Promote(context.Background(), &dcs.Cluster{})).Should(Succeed())
+			Expect(mgr.DBState.Extra["role"]).Should(Equal("master"))
+		})
+
+		It("only lifts the fence of the primary on promotion", func() {
+			mock.ExpectInfo("Replication").SetVal(primaryReplicationInfo)
+			mock.ExpectDo("CLIENT", "UNPAUSE").SetVal("OK")
+			Expect(mgr.Promote(context.Background(), &dcs.Cluster{})).Should(Succeed())
+		})
+
+		It("fences the primary for a while, until it's demoted again or follows the new one", func() {
+			timeout := strconv.FormatInt(demoteFenceTimeout.Milliseconds(), 10)
+			mock.ExpectInfo("Replication").SetVal(primaryReplicationInfo)
+			mock.ExpectDo("CLIENT", "PAUSE", timeout, "WRITE").SetVal("OK")
+			Expect(mgr.Demote(context.Background())).Should(Succeed())
+		})
+
+		It("doesn't fence a replica", func() {
+			mock.ExpectInfo("Replication").SetVal(replicaReplicationInfo)
+			Expect(mgr.Demote(context.Background())).Should(Succeed())
+		})
+
+		It("follows the leader and lifts the fence", func() {
+			defer func(user, passwd string) {
+				redisUser, redisPasswd = user, passwd
+			}(redisUser, redisPasswd)
+			redisUser, redisPasswd = "default", "passwd"
+
+			cluster := &dcs.Cluster{
+				Leader:  &dcs.Leader{Name: "redis-0"},
+				Members: []dcs.Member{{Name: "redis-0", PodIP: "10.0.0.1", DBPort: "6379", UseIP: true}, {Name: "redis-1"}},
+			}
+			mgr.replicationInfo = parseInfo(primaryReplicationInfo)
+			mock.ExpectConfigSet("masterauth", "passwd").SetVal("OK")
+			mock.ExpectConfigSet("masteruser", "default").SetVal("OK")
+			mock.ExpectDo("REPLICAOF", "10.0.0.1", "6379").SetVal("OK")
+			mock.ExpectDo("CLIENT", "UNPAUSE").SetVal("OK")
+			mock.ExpectInfo("Replication").SetVal(replicaReplicationInfo)
+			Expect(mgr.Follow(context.Background(), cluster)).Should(Succeed())
+			Expect(mgr.DBState.Extra["role"]).Should(Equal("slave"))
+		})
+
+		It("doesn't follow the leader again", func() {
+			cluster := &dcs.Cluster{
+				Leader:  &dcs.Leader{Name: "redis-0"},
+				Members: []dcs.Member{{Name: "redis-0", PodIP: "redis-0.redis-headless", DBPort: "6379", UseIP: true}, {Name: "redis-1"}},
+			}
+			mgr.replicationInfo = parseInfo(replicaReplicationInfo)
+			Expect(mgr.Follow(context.Background(), cluster)).Should(Succeed())
+		})
+
+		It("checks the leader runs as the primary and accepts writes", func() {
+			cluster := &dcs.Cluster{
+				Leader:  &dcs.Leader{Name: "redis-1"},
+				Members: []dcs.Member{{Name: "redis-1"}},
+			}
+			mock.ExpectInfo("Replication").SetVal(primaryReplicationInfo)
+			mock.ExpectSet(healthCheckKey, "redis-1", healthCheckTTL).SetVal("OK")
+			Expect(mgr.IsCurrentMemberHealthy(context.Background(), cluster)).Should(BeTrue())
+
+			By("the writes are refused")
+			mock.ExpectInfo("Replication").SetVal(primaryReplicationInfo)
+			mock.ExpectSet(healthCheckKey, "redis-1", healthCheckTTL).SetErr(errors.New("NOREPLICAS Not enough good replicas to write."))
+			Expect(mgr.IsCurrentMemberHealthy(context.Background(), cluster)).Should(BeFalse())
+
+			By("the leader runs as a replica")
+			mock.ExpectInfo("Replication").SetVal(replicaReplicationInfo)
+			Expect(mgr.IsCurrentMemberHealthy(context.Background(), cluster)).Should(BeFalse())
+		})
+	})
+})
@@ -41,7 +41,10 @@ type Manager struct {
 	engines.DBManagerBase
 	client         redis.UniversalClient
 	clientSettings *Settings
+	// sentinelClient is nil if there is no Sentinel, the replication is managed by the HA loop of lorry then.
 	sentinelClient *redis.SentinelClient
+	// replicationInfo is fetched in each HA cycle by GetDBState
+	replicationInfo replicationInfo
 
 	ctx                     context.Context
 	cancel                  context.CancelFunc
@@ -88,7 +91,9 @@ func NewManager(properties engines.Properties) (engines.DBManager, error) {
 
 	mgr.ctx, mgr.cancel = context.WithCancel(context.Background())
 
-	go mgr.SubscribeRoleChange(mgr.ctx)
+	if mgr.sentinelClient != nil {
+		go mgr.SubscribeRoleChange(mgr.ctx)
+	}
 	return mgr, nil
 }
 
@@ -144,11 +144,15 @@ func newClient(s *Settings) redis.UniversalClient {
 	return redis.NewClient(options)
 }
 
+// newSentinelClient returns the client of the Sentinel of the component, it returns nil if there is no Sentinel.
 func newSentinelClient(s *Settings, clusterCompName string) *redis.SentinelClient {
 	// TODO: use headless service directly
 	sentinelEnv := fmt.Sprintf("%s_SENTINEL_SERVICE", strings.ToUpper(strings.Join(strings.Split(clusterCompName, "-"), "_")))
 	sentinelHost := viper.GetString(fmt.Sprintf("%s_HOST", sentinelEnv))
 	sentinelPort := viper.GetString(fmt.Sprintf("%s_PORT", sentinelEnv))
+	if sentinelHost == "" {
+		return nil
+	}
 
 	opt := &redis.Options{
 		DB:              s.DB,
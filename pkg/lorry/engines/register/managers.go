@@ -31,8 +31,10 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/custom"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/elasticsearch"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/etcd"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/foxlake"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/kafka"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/mongodb"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/mysql"
@@ -40,6 +42,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/oceanbase"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/opengauss"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/oracle"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/plugin"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/polardbx"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/postgres"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/postgres/apecloudpostgres"
@@ -64,7 +67,10 @@ func init() {
 	RegisterEngine(models.MySQL, "consensus", wesql.NewManager, mysql.NewCommands)
 	RegisterEngine(models.MySQL, "replication", mysql.NewManager, mysql.NewCommands)
 	RegisterEngine(models.Redis, "replication", redis.NewManager, redis.NewCommands)
-	RegisterEngine(models.ETCD, "consensus", etcd.NewManager, nil)
+	RegisterEngine(models.ETCD, "consensus", etcd.NewManager, etcd.NewCommands)
+	RegisterEngine(models.Kafka, "consensus", kafka.NewManager, nil)
+	RegisterEngine(models.Elasticsearch, "consensus", elasticsearch.NewManager, nil)
+	RegisterEngine(models.OpenSearch, "consensus", elasticsearch.NewManager, nil)
 	RegisterEngine(models.MongoDB, "consensus", mongodb.NewManager, mongodb.NewCommands)
 	RegisterEngine(models.PolarDBX, "consensus", polardbx.NewManager, mysql.NewCommands)
 	RegisterEngine(models.PostgreSQL, "replication", officalpostgres.NewManager, postgres.NewCommands)
@@ -81,7 +87,10 @@ func init() {
 	RegisterEngine(models.WeSQL, "", wesql.NewManager, mysql.NewCommands)
 	RegisterEngine(models.MySQL, "", mysql.NewManager, mysql.NewCommands)
 	RegisterEngine(models.Redis, "", redis.NewManager, redis.NewCommands)
-	RegisterEngine(models.ETCD, "", etcd.NewManager, nil)
+	RegisterEngine(models.ETCD, "", etcd.NewManager, etcd.NewCommands)
+	RegisterEngine(models.Kafka, "", kafka.NewManager, nil)
+	RegisterEngine(models.Elasticsearch, "", elasticsearch.NewManager, nil)
+	RegisterEngine(models.OpenSearch, "", elasticsearch.NewManager, nil)
 	RegisterEngine(models.MongoDB, "", mongodb.NewManager, mongodb.NewCommands)
 	RegisterEngine(models.PolarDBX, "", polardbx.NewManager, mysql.NewCommands)
 	RegisterEngine(models.PostgreSQL, "", officalpostgres.NewManager, postgres.NewCommands)
@@ -161,6 +170,11 @@ func InitDBManager(configDir string) error {
 
 	properties := GetProperties(characterType)
 	newFunc := GetManagerNewFunc(characterType, workloadType)
+	if properties[plugin.EndpointKey] != "" {
+		// the engine served by a plugin takes precedence over the builtin one
+		ctrl.Log.Info("Use the engine plugin", "endpoint", properties[plugin.EndpointKey])
+		newFunc = plugin.NewManager
+	}
 	if newFunc == nil {
 		return errors.Errorf("no db manager for characterType %s and workloadType %s", characterType, workloadType)
 	}
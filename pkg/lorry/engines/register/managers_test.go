@@ -31,6 +31,7 @@ import (
 	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines"
 	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/plugin"
 )
 
 const (
@@ -216,3 +217,45 @@ func TestInitDBManager(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestInitPluginDBManager(t *testing.T) {
+	fs = afero.NewMemMapFs()
+	viper.SetFs(fs)
+	realDBManager := dbManager
+	realCustomManager := customManager
+	defer func() {
+		fs = afero.NewOsFs()
+		viper.Reset()
+		dbManager = realDBManager
+		customManager = realCustomManager
+	}()
+	dbManager = nil
+
+	err := fs.Mkdir(fakeConfigDir, os.ModeDir)
+	assert.Nil(t, err)
+	file, err := fs.Create(fakeConfigDir + fakeConfigFile)
+	assert.Nil(t, err)
+	_, err = file.WriteString(`
+name: plugin-db
+spec:
+  version: v1
+  metadata:
+    - name: pluginEndpoint
+      value: "127.0.0.1:50051"`)
+	assert.Nil(t, err)
+	_ = file.Close()
+
+	viper.Set(constant.KBEnvBuiltinHandler, "plugin-db")
+	viper.Set(constant.KBEnvPodName, "plugin-db-0")
+	RegisterEngine(models.Custom, "", func(engines.Properties) (engines.DBManager, error) {
+		return &engines.MockManager{}, nil
+	}, nil)
+
+	err = InitDBManager(fakeConfigDir)
+	assert.Nil(t, err)
+	manager, err := GetDBManager(nil)
+	assert.Nil(t, err)
+	if assert.IsType(t, &plugin.Manager{}, manager) {
+		manager.ShutDownWithWait()
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package engines
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/dcs"
+)
+
+// StandbySource is the primary of another cluster which the leader of a standby cluster replicates from.
+type StandbySource struct {
+	Host string
+	Port string
+}
+
+// GetStandbySource returns the standby source of the current cluster, nil if it's not a standby cluster.
+func GetStandbySource() *StandbySource {
+	host := viper.GetString(constant.KBEnvStandbySourceHost)
+	if host == "" {
+		return nil
+	}
+	return &StandbySource{
+		Host: host,
+		Port: viper.GetString(constant.KBEnvStandbySourcePort),
+	}
+}
+
+// StandbyManager is implemented by the DB managers which support standby clusters.
+type StandbyManager interface {
+	// FollowStandbySource makes the leader of a standby cluster replicate from the external primary, keeping
+	// itself read-only, the other members still follow the leader as usual.
+	FollowStandbySource(context.Context, *dcs.Cluster, *StandbySource) error
+}
@@ -132,7 +132,7 @@ func (ha *Ha) RunCycle() {
 			break
 		}
 
-		err := ha.dbManager.Promote(ha.ctx, cluster)
+		err := ha.promote(cluster)
 		if err != nil {
 			ha.logger.Error(err, "Take the leader failed")
 			_ = ha.dcs.ReleaseLease()
@@ -172,7 +172,7 @@ func (ha *Ha) RunCycle() {
 			_ = ha.dcs.ReleaseLease()
 			break
 		}
-		err = ha.dbManager.Promote(ha.ctx, cluster)
+		err = ha.promote(cluster)
 		if err != nil {
 			ha.logger.Error(err, "promote failed")
 			break
@@ -205,6 +205,18 @@ func (ha *Ha) RunCycle() {
 	}
 }
 
+// promote makes the current member act as the leader. The leader of a standby cluster keeps following
+// the primary of the source cluster instead of accepting writes.
+func (ha *Ha) promote(cluster *dcs3.Cluster) error {
+	if source := engines.GetStandbySource(); source != nil {
+		if standbyManager, ok := ha.dbManager.(engines.StandbyManager); ok {
+			return standbyManager.FollowStandbySource(ha.ctx, cluster, source)
+		}
+		ha.logger.Info("standby cluster is not supported by the engine, promote it as a primary")
+	}
+	return ha.dbManager.Promote(ha.ctx, cluster)
+}
+
 func (ha *Ha) Start() {
 	ha.logger.Info("HA starting")
 	cluster, err := ha.dcs.GetCluster()
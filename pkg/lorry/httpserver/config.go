@@ -22,6 +22,8 @@ package httpserver
 import (
 	"github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/common"
 )
 
 type Config struct {
@@ -31,6 +33,7 @@ type Config struct {
 	UnixDomainSocket   string
 	ReadBufferSize     int
 	APILogging         bool
+	SecureAccess       common.SecureAccessConfig
 }
 
 var config Config
@@ -40,4 +43,9 @@ func init() {
 	pflag.IntVar(&config.Port, "port", 3501, "The HTTP Server listen port for Lorry service.")
 	pflag.StringVar(&config.Address, "address", "0.0.0.0", "The HTTP Server listen address for Lorry service.")
 	pflag.BoolVar(&config.APILogging, "api-logging", true, "Enable api logging for Lorry request.")
+	pflag.StringVar(&config.SecureAccess.CertFile, "tls-cert-file", "", "The TLS certificate file, the HTTP Server serves HTTPS for Lorry service if it's set.")
+	pflag.StringVar(&config.SecureAccess.KeyFile, "tls-key-file", "", "The TLS private key file matching the TLS certificate.")
+	pflag.StringVar(&config.SecureAccess.ClientCAFile, "client-ca-file", "", "The CA bundle to verify the client certificates, the requests with a verified client certificate are authenticated.")
+	pflag.StringVar(&config.SecureAccess.TokenFile, "auth-token-file", "", "The bearer token file, the requests with the token are authenticated.")
+	pflag.StringSliceVar(&config.SecureAccess.ServiceAccounts, "authorized-service-accounts", nil, "The service accounts in the form of namespace:name, the requests with their tokens are authenticated.")
 }
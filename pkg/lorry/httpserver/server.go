@@ -20,6 +20,7 @@ along with this program.  If not, see <http://www.gnu.org/licenses/>.
 package httpserver
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -29,7 +30,10 @@ import (
 	fasthttprouter "github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
 
+	"github.com/apecloud/kubeblocks/pkg/common"
+	"github.com/apecloud/kubeblocks/pkg/constant"
 	"github.com/apecloud/kubeblocks/pkg/lorry/operations"
+	"github.com/apecloud/kubeblocks/pkg/lorry/util/kubernetes"
 )
 
 // Server is an interface for the Lorry HTTP server.
@@ -58,7 +62,20 @@ func NewServer(ops map[string]operations.Operation) Server {
 // StartNonBlocking starts a new server in a goroutine.
 func (s *server) StartNonBlocking() error {
 	logger.Info("Starting HTTP Server")
+	if len(s.config.SecureAccess.ServiceAccounts) != 0 {
+		// the service account tokens are reviewed by the API server
+		clientSet, err := kubernetes.GetClientSet()
+		if err != nil {
+			return err
+		}
+		s.config.SecureAccess.TokenReviewer = clientSet.AuthenticationV1().TokenReviews()
+	}
+	if err := s.config.SecureAccess.Validate(); err != nil {
+		return err
+	}
 	handler := s.Router()
+	// the probe paths are left anonymous for the kubelet.
+	handler = common.NewAuthenticationHandler(s.config.SecureAccess, handler, constant.LorryRoleProbePath, constant.LorryVolumeProtectPath)
 
 	APILogging := s.config.APILogging
 	if APILogging {
@@ -79,6 +96,9 @@ func (s *server) StartNonBlocking() error {
 		if err != nil {
 			logger.Error(err, "listen address", apiListenAddress, "port", s.config.Port)
 		} else {
+			if s.config.SecureAccess.TLSEnabled() {
+				l = tls.NewListener(l, common.NewServerTLSConfig(s.config.SecureAccess))
+			}
 			listeners = append(listeners, l)
 		}
 	}
@@ -0,0 +1,89 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/viper"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/apecloud/kubeblocks/pkg/constant"
+	"github.com/apecloud/kubeblocks/pkg/lorry/engines/models"
+	"github.com/apecloud/kubeblocks/pkg/lorry/operations"
+	"github.com/apecloud/kubeblocks/pkg/lorry/util"
+)
+
+// Reconfigure runs the reconfigure action of the component to reload the configuration,
+// e.g. the TLS certificates renewed in place.
+type Reconfigure struct {
+	operations.Base
+	logger  logr.Logger
+	Command []string
+}
+
+var reconfigure operations.Operation = &Reconfigure{}
+
+func init() {
+	err := operations.Register(strings.ToLower(string(util.ReconfigureOperation)), reconfigure)
+	if err != nil {
+		panic(err.Error())
+	}
+}
+
+func (s *Reconfigure) Init(_ context.Context) error {
+	s.logger = ctrl.Log.WithName("Reconfigure")
+	actionJSON := viper.GetString(constant.KBEnvActionCommands)
+	if actionJSON != "" {
+		actionCommands := map[string][]string{}
+		err := json.Unmarshal([]byte(actionJSON), &actionCommands)
+		if err != nil {
+			s.logger.Info("get action commands failed", "error", err.Error())
+			return err
+		}
+		cmd, ok := actionCommands[constant.ReconfigureAction]
+		if ok && len(cmd) > 0 {
+			s.Command = cmd
+		}
+	}
+	return nil
+}
+
+func (s *Reconfigure) PreCheck(ctx context.Context, req *operations.OpsRequest) error {
+	return nil
+}
+
+func (s *Reconfigure) Do(ctx context.Context, req *operations.OpsRequest) (*operations.OpsResponse, error) {
+	if len(s.Command) == 0 {
+		return nil, models.ErrNotImplemented
+	}
+	envs, err := util.GetGlobalSharedEnvs()
+	if err != nil {
+		return nil, err
+	}
+	output, err := util.ExecCommand(ctx, s.Command, envs)
+	if output != "" {
+		s.logger.Info("reconfigure", "output", output)
+	}
+	return nil, err
+}
@@ -65,21 +65,15 @@ func (s *GetLag) Init(context.Context) error {
 }
 
 func (s *GetLag) IsReadonly(context.Context) bool {
-	return false
+	return true
 }
 
-func (s *GetLag) Do(ctx context.Context, req *operations.OpsRequest) (*operations.OpsResponse, error) {
-	sql := req.GetString("sql")
-	if sql == "" {
-		return nil, errors.New("no sql provided")
-	}
-
+func (s *GetLag) Do(ctx context.Context, _ *operations.OpsRequest) (*operations.OpsResponse, error) {
 	resp := &operations.OpsResponse{
 		Data: map[string]any{},
 	}
-	resp.Data["operation"] = util.ExecOperation
-	k8sStore := s.dcsStore.(*dcs.KubernetesStore)
-	cluster := k8sStore.GetClusterFromCache()
+	resp.Data["operation"] = util.GetLagOperation
+	cluster := s.dcsStore.GetClusterFromCache()
 
 	lag, err := s.dbManager.GetLag(ctx, cluster)
 	if err != nil {
@@ -108,8 +108,7 @@ func (s *CheckStatus) Do(ctx context.Context, req *operations.OpsRequest) (*oper
 	}
 	resp.Data["operation"] = util.HealthyCheckOperation
 
-	k8sStore := s.dcsStore.(*dcs.KubernetesStore)
-	cluster := k8sStore.GetClusterFromCache()
+	cluster := s.dcsStore.GetClusterFromCache()
 	err := s.dbManager.CurrentMemberHealthyCheck(ctx, cluster)
 	if err != nil {
 		return s.handlerError(ctx, err)
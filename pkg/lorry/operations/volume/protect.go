@@ -28,6 +28,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -56,6 +57,10 @@ const (
 
 	reasonLock   = "HighVolumeWatermark"
 	reasonUnlock = "LowVolumeWatermark" // TODO
+
+	// the capacity reported by kubelet is the size of the filesystem, which is a bit smaller than the size
+	// of the volume, take the volume as expanded to the max size if the gap is within the tolerance.
+	maxSizeTolerancePercent = 5
 )
 
 type volumeStatsRequester interface {
@@ -75,6 +80,7 @@ func init() {
 type volumeExt struct {
 	Name          string
 	HighWatermark int
+	Autoscaling   *appsv1alpha1.VolumeAutoscaling
 	Stats         statsv1alpha1.VolumeStats
 }
 
@@ -173,6 +179,33 @@ func (p *Protection) initVolumes() error {
 			},
 		}
 	}
+	return p.initVolumeAutoscalings()
+}
+
+func (p *Protection) initVolumeAutoscalings() error {
+	raw := viper.GetString(constant.KBEnvVolumeAutoscalingSpec)
+	if raw == "" {
+		return nil
+	}
+	autoscalings := map[string]appsv1alpha1.VolumeAutoscaling{}
+	if err := json.Unmarshal([]byte(raw), &autoscalings); err != nil {
+		p.Logger.Error(err, "unmarshal volume autoscaling spec error", "raw spec", raw)
+		return err
+	}
+	for name := range autoscalings {
+		autoscaling := autoscalings[name]
+		v, ok := p.Volumes[name]
+		if !ok {
+			v = volumeExt{
+				Name: name,
+				Stats: statsv1alpha1.VolumeStats{
+					Name: name,
+				},
+			}
+		}
+		v.Autoscaling = &autoscaling
+		p.Volumes[name] = v
+	}
 	return nil
 }
 
@@ -186,6 +219,9 @@ func (p *Protection) disabled() bool {
 		if v.HighWatermark > 0 && v.HighWatermark <= 100 {
 			return false
 		}
+		if v.Autoscaling != nil {
+			return false
+		}
 	}
 	return true
 }
@@ -215,7 +251,11 @@ func (p *Protection) updateVolumeStats(payload []byte) error {
 func (p *Protection) checkUsage(ctx context.Context) (map[string]any, error) {
 	lower := make([]string, 0)
 	higher := make([]string, 0)
+	expanding := make([]string, 0)
 	for name, v := range p.Volumes {
+		if p.checkVolumeAutoscaling(v) != 0 {
+			expanding = append(expanding, name)
+		}
 		ret := p.checkVolumeWatermark(v)
 		if ret == 0 {
 			lower = append(lower, name)
@@ -225,6 +265,13 @@ func (p *Protection) checkUsage(ctx context.Context) (map[string]any, error) {
 	}
 
 	volumeUsages := p.buildVolumesMsg()
+	// there have volume(s) over the autoscaling threshold and can still be expanded.
+	if len(expanding) > 0 {
+		sort.Strings(expanding)
+		if err := p.expandVolumes(ctx, volumeUsages, expanding); err != nil {
+			return volumeUsages, err
+		}
+	}
 	readonly := p.Readonly
 	// the instance is running normally and there have volume(s) over the space usage threshold.
 	if !readonly && len(higher) > 0 {
@@ -252,6 +299,10 @@ func (p *Protection) checkVolumeWatermark(v volumeExt) int {
 	if v.Stats.CapacityBytes == nil || v.Stats.UsedBytes == nil {
 		return 0
 	}
+	// the volume will be expanded rather than protected until it reaches the max size.
+	if v.Autoscaling != nil && !maxSizeReached(v) {
+		return 0
+	}
 	thresholdBytes := *v.Stats.CapacityBytes / 100 * uint64(v.HighWatermark)
 	if *v.Stats.UsedBytes < thresholdBytes {
 		return 0
@@ -259,6 +310,52 @@ func (p *Protection) checkVolumeWatermark(v volumeExt) int {
 	return 1
 }
 
+// checkVolumeAutoscaling checks whether the volume should be expanded.
+//
+//	returns 0 if the volume is not autoscaling, its space usage is under the threshold or it has reached the max size
+//	returns non-zero if the volume space usage is over the threshold and it can still be expanded
+func (p *Protection) checkVolumeAutoscaling(v volumeExt) int {
+	if v.Autoscaling == nil {
+		return 0
+	}
+	if v.Stats.CapacityBytes == nil || v.Stats.UsedBytes == nil {
+		return 0
+	}
+	threshold := normalizeVolumeWatermark(&v.Autoscaling.Threshold, 0)
+	if threshold == 0 {
+		return 0
+	}
+	thresholdBytes := *v.Stats.CapacityBytes / 100 * uint64(threshold)
+	if *v.Stats.UsedBytes < thresholdBytes || maxSizeReached(v) {
+		return 0
+	}
+	return 1
+}
+
+func maxSizeReached(v volumeExt) bool {
+	if v.Stats.CapacityBytes == nil {
+		return false
+	}
+	maxBytes := v.Autoscaling.MaxSize.Value()
+	return *v.Stats.CapacityBytes >= uint64(maxBytes)/100*(100-maxSizeTolerancePercent)
+}
+
+// expandVolumes requests to expand the volumes by sending an event, the controller will create
+// the VolumeExpansion OpsRequest for them.
+func (p *Protection) expandVolumes(ctx context.Context, volumeUsages map[string]any, volumes []string) error {
+	data := map[string]any{}
+	for k, v := range volumeUsages {
+		data[k] = v
+	}
+	data[util.VolumeAutoscalingEventDataKey] = volumes
+	p.Logger.Info("request to expand volumes", "volumes", volumes, "msg", volumeUsages)
+	if err := p.sendEvent(ctx, util.VolumeAutoscalingEventReason, data); err != nil {
+		p.Logger.Error(err, "send volume autoscaling event error", "volumes", volumes)
+		return err
+	}
+	return nil
+}
+
 func (p *Protection) highWatermark(ctx context.Context, volumeUsages map[string]any) error {
 	if p.Readonly { // double check
 		return nil
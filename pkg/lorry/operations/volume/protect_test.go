@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/rand"
 	statsv1alpha1 "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
@@ -421,4 +422,93 @@ var _ = Describe("Volume Protection Operation", func() {
 			Expect(obj.Readonly).Should(BeTrue()) // unchanged
 		})
 	})
+
+	Context("Volume Autoscaling", func() {
+		resetVolumeAutoscalingSpecEnv := func(maxSize string) {
+			autoscalings := map[string]appsv1alpha1.VolumeAutoscaling{
+				volumeName: {
+					Threshold: defaultThreshold - 10,
+					Step:      resource.MustParse("5Gi"),
+					MaxSize:   resource.MustParse(maxSize),
+				},
+			}
+			raw, _ := json.Marshal(autoscalings)
+			viper.SetDefault(constant.KBEnvVolumeAutoscalingSpec, string(raw))
+		}
+
+		newStatsSummary := func(usedBytes *uint64) []byte {
+			stats := statsv1alpha1.Summary{
+				Pods: []statsv1alpha1.PodStats{
+					{
+						PodRef: statsv1alpha1.PodReference{
+							Name: podName,
+						},
+						VolumeStats: []statsv1alpha1.VolumeStats{
+							{
+								Name: volumeName,
+								FsStats: statsv1alpha1.FsStats{
+									CapacityBytes: &capacityBytes,
+									UsedBytes:     usedBytes,
+								},
+							},
+						},
+					},
+				},
+			}
+			summary, _ := json.Marshal(stats)
+			return summary
+		}
+
+		AfterEach(func() {
+			viper.SetDefault(constant.KBEnvVolumeAutoscalingSpec, "")
+		})
+
+		It("init - autoscaling volumes", func() {
+			resetVolumeAutoscalingSpecEnv("20Gi")
+			resetVolumeProtectionSpecEnv(appsv1alpha1.VolumeProtectionSpec{})
+			obj := newProtection()
+			Expect(obj.disabled()).Should(BeFalse())
+			Expect(obj.Volumes).Should(HaveKey(volumeName))
+			Expect(obj.Volumes[volumeName].HighWatermark).Should(Equal(0))
+			Expect(obj.Volumes[volumeName].Autoscaling).ShouldNot(BeNil())
+			resetVolumeProtectionSpecEnv(*volumeProtectionSpec)
+		})
+
+		It("volume over the threshold is expanded rather than locked", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDBManager := engines.NewMockDBManager(ctrl)
+			register.SetDBManager(mockDBManager)
+			resetVolumeAutoscalingSpecEnv("20Gi")
+
+			obj := newProtection()
+			mock := obj.Requester.(*mockVolumeStatsRequester)
+			mock.summary = newStatsSummary(&usedBytesUnderThreshold)
+			_, err := obj.Do(context.Background(), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(obj.checkVolumeAutoscaling(obj.Volumes[volumeName])).ShouldNot(Equal(0))
+			Expect(obj.Readonly).Should(BeFalse())
+
+			// the usage is over the high watermark, but the volume can still be expanded
+			mock.summary = newStatsSummary(&usedBytesOverThreshold)
+			_, err = obj.Do(context.Background(), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(obj.Readonly).Should(BeFalse())
+		})
+
+		It("volume reached the max size falls back to lock", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockDBManager := engines.NewMockDBManager(ctrl)
+			mockDBManager.EXPECT().Lock(gomock.Any(), gomock.Any()).Return(nil)
+			register.SetDBManager(mockDBManager)
+			resetVolumeAutoscalingSpecEnv("10Gi")
+
+			obj := newProtection()
+			mock := obj.Requester.(*mockVolumeStatsRequester)
+			mock.summary = newStatsSummary(&usedBytesOverThreshold)
+			_, err := obj.Do(context.Background(), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(obj.checkVolumeAutoscaling(obj.Volumes[volumeName])).Should(Equal(0))
+			Expect(obj.Readonly).Should(BeTrue())
+		})
+	})
 })
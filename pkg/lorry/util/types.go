@@ -55,6 +55,7 @@ const (
 	// for component
 	PostProvisionOperation OperationKind = "postProvision"
 	PreTerminateOperation  OperationKind = "preTerminate"
+	ReconfigureOperation   OperationKind = "reconfigure"
 
 	// actions for cluster accounts management
 	ListUsersOp          OperationKind = "listUsers"
@@ -65,6 +66,9 @@ const (
 	RevokeUserRoleOp     OperationKind = "revokeUserRole"
 	ListSystemAccountsOp OperationKind = "listSystemAccounts"
 
+	UpdateUserPasswordOp     OperationKind = "updateUserPassword"
+	DiscardOldUserPasswordOp OperationKind = "discardOldUserPassword"
+
 	JoinMemberOperation  OperationKind = "joinMember"
 	LeaveMemberOperation OperationKind = "leaveMember"
 
@@ -80,6 +84,11 @@ const (
 	LegacyEventFieldPath = "spec.containers{kb-checkrole}"
 	LorryEventFieldPath  = "spec.containers{lorry}"
 
+	// VolumeAutoscalingEventReason is the reason of the event sent by lorry to request to expand volumes,
+	// and the names of the volumes are set in the event message with the key VolumeAutoscalingEventDataKey.
+	VolumeAutoscalingEventReason  = "HighVolumeUsage"
+	VolumeAutoscalingEventDataKey = "autoscaling"
+
 	// this is a general script template, which can be used for all kinds of exec request to databases.
 	DataScriptRequestTpl string = `
 		response=$(curl -s -X POST -H 'Content-Type: application/json' http://%s:3501/v1.0/bindings/%s -d '%s')
@@ -0,0 +1,36 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import "time"
+
+// RecordBackupFinished records a backup reaching the final phase, its duration and the size of the backup data.
+// The size is ignored if it is negative.
+func RecordBackupFinished(namespace, method, phase string, duration time.Duration, size int64) {
+	backupDuration.WithLabelValues(namespace, method, phase).Observe(duration.Seconds())
+	if size >= 0 {
+		backupSize.WithLabelValues(namespace, method).Observe(float64(size))
+	}
+}
+
+// RecordRestoreFinished records a restore reaching the final phase and its duration.
+func RecordRestoreFinished(namespace, phase string, duration time.Duration) {
+	restoreDuration.WithLabelValues(namespace, phase).Observe(duration.Seconds())
+}
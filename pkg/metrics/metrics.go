@@ -0,0 +1,118 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The domain metrics are recorded by the controllers and registered to the controller-runtime registry,
+// which is served by the manager on the `/metrics` endpoint.
+
+const namespace = "kubeblocks"
+
+var (
+	clusterPhases = newPhaseCollector(
+		prometheus.BuildFQName(namespace, "cluster", "phase_count"),
+		"Number of clusters in each phase, by namespace and cluster definition.",
+		"cluster_definition")
+
+	componentPhases = newPhaseCollector(
+		prometheus.BuildFQName(namespace, "component", "phase_count"),
+		"Number of components in each phase, by namespace and component definition.",
+		"component_definition")
+
+	opsRequestStarted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "opsrequest",
+		Name:      "started_total",
+		Help:      "Total number of OpsRequests started, by namespace and type.",
+	}, []string{"namespace", "type"})
+
+	opsRequestCompleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "opsrequest",
+		Name:      "completed_total",
+		Help:      "Total number of OpsRequests completed, by namespace, type and the final phase.",
+	}, []string{"namespace", "type", "phase"})
+
+	opsRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "opsrequest",
+		Name:      "duration_seconds",
+		Help:      "Duration of the completed OpsRequests from start to completion, by type and the final phase.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 16),
+	}, []string{"type", "phase"})
+
+	transformerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "reconcile",
+		Name:      "transformer_duration_seconds",
+		Help:      "Duration of each transformer in the plan builders, by controller and transformer.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+	}, []string{"controller", "transformer"})
+
+	transformerErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "reconcile",
+		Name:      "transformer_errors_total",
+		Help:      "Total number of errors returned by each transformer in the plan builders, by controller and transformer.",
+	}, []string{"controller", "transformer"})
+
+	backupDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "backup",
+		Name:      "duration_seconds",
+		Help:      "Duration of the finished backups, by namespace, backup method and the final phase.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 18),
+	}, []string{"namespace", "method", "phase"})
+
+	backupSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "backup",
+		Name:      "size_bytes",
+		Help:      "Total size of the completed backups, by namespace and backup method.",
+		Buckets:   prometheus.ExponentialBuckets(1<<20, 4, 12),
+	}, []string{"namespace", "method"})
+
+	restoreDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "restore",
+		Name:      "duration_seconds",
+		Help:      "Duration of the finished restores, by namespace and the final phase.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 18),
+	}, []string{"namespace", "phase"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		clusterPhases,
+		componentPhases,
+		opsRequestStarted,
+		opsRequestCompleted,
+		opsRequestDuration,
+		transformerDuration,
+		transformerErrors,
+		backupDuration,
+		backupSize,
+		restoreDuration,
+	)
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import "time"
+
+// RecordOpsRequestStarted records an OpsRequest starting to run.
+func RecordOpsRequestStarted(namespace, opsType string) {
+	opsRequestStarted.WithLabelValues(namespace, opsType).Inc()
+}
+
+// RecordOpsRequestCompleted records an OpsRequest reaching the final phase and how long it took.
+func RecordOpsRequestCompleted(namespace, opsType, phase string, duration time.Duration) {
+	opsRequestCompleted.WithLabelValues(namespace, opsType, phase).Inc()
+	opsRequestDuration.WithLabelValues(opsType, phase).Observe(duration.Seconds())
+}
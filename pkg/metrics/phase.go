@@ -0,0 +1,105 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type phaseRecord struct {
+	definition string
+	phase      string
+}
+
+type phaseKey struct {
+	namespace  string
+	definition string
+	phase      string
+}
+
+// phaseCollector keeps the latest phase of each object reported by the controller,
+// and exports the number of objects in each phase when it is collected.
+type phaseCollector struct {
+	desc *prometheus.Desc
+
+	mu      sync.RWMutex
+	records map[types.NamespacedName]phaseRecord
+}
+
+var _ prometheus.Collector = &phaseCollector{}
+
+func newPhaseCollector(name, help, definitionLabel string) *phaseCollector {
+	return &phaseCollector{
+		desc:    prometheus.NewDesc(name, help, []string{"namespace", definitionLabel, "phase"}, nil),
+		records: map[types.NamespacedName]phaseRecord{},
+	}
+}
+
+func (c *phaseCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *phaseCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	counts := map[phaseKey]int{}
+	for name, record := range c.records {
+		counts[phaseKey{namespace: name.Namespace, definition: record.definition, phase: record.phase}]++
+	}
+	c.mu.RUnlock()
+
+	for key, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), key.namespace, key.definition, key.phase)
+	}
+}
+
+func (c *phaseCollector) record(namespace, name, definition, phase string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.records[types.NamespacedName{Namespace: namespace, Name: name}] = phaseRecord{definition: definition, phase: phase}
+}
+
+func (c *phaseCollector) forget(namespace, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.records, types.NamespacedName{Namespace: namespace, Name: name})
+}
+
+// RecordClusterPhase records the current phase of a cluster.
+func RecordClusterPhase(namespace, name, clusterDefinition, phase string) {
+	clusterPhases.record(namespace, name, clusterDefinition, phase)
+}
+
+// ForgetCluster removes a deleted cluster from the phase metrics.
+func ForgetCluster(namespace, name string) {
+	clusterPhases.forget(namespace, name)
+}
+
+// RecordComponentPhase records the current phase of a component.
+func RecordComponentPhase(namespace, name, componentDefinition, phase string) {
+	componentPhases.record(namespace, name, componentDefinition, phase)
+}
+
+// ForgetComponent removes a deleted component from the phase metrics.
+func ForgetComponent(namespace, name string) {
+	componentPhases.forget(namespace, name)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestPhaseCollector(t *testing.T) {
+	c := newPhaseCollector("kubeblocks_test_phase_count", "test", "definition")
+	c.record("default", "c1", "mysql", "Running")
+	c.record("default", "c2", "mysql", "Running")
+	c.record("default", "c3", "mysql", "Creating")
+	c.record("ns1", "c1", "redis", "Running")
+	// the phase of c3 changes
+	c.record("default", "c3", "mysql", "Running")
+	c.record("default", "c4", "mysql", "Failed")
+	c.forget("default", "c4")
+
+	expected := `
+# HELP kubeblocks_test_phase_count test
+# TYPE kubeblocks_test_phase_count gauge
+kubeblocks_test_phase_count{definition="mysql",namespace="default",phase="Running"} 3
+kubeblocks_test_phase_count{definition="redis",namespace="ns1",phase="Running"} 1
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
+
+type testTransformer struct{}
+
+func TestTransformerName(t *testing.T) {
+	if name := TransformerName(&testTransformer{}); name != "testTransformer" {
+		t.Errorf("unexpected transformer name: %s", name)
+	}
+}
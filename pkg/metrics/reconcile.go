@@ -0,0 +1,45 @@
+/*
+Copyright (C) 2022-2024 ApeCloud Co., Ltd
+
+This file is part of KubeBlocks project
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RecordTransformer records the duration of a transformer in the plan builder of the controller.
+func RecordTransformer(controller, transformer string, duration time.Duration, err error) {
+	transformerDuration.WithLabelValues(controller, transformer).Observe(duration.Seconds())
+	if err != nil {
+		transformerErrors.WithLabelValues(controller, transformer).Inc()
+	}
+}
+
+// TransformerName returns the type name of a transformer without the package and pointer prefix,
+// e.g. "clusterDeletionTransformer".
+func TransformerName(transformer any) string {
+	name := fmt.Sprintf("%T", transformer)
+	name = strings.TrimPrefix(name, "*")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}